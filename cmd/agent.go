@@ -5,6 +5,8 @@ import (
 	"flag"
 	"net"
 	"os"
+	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -12,9 +14,11 @@ import (
 	"github.com/vesoft-inc/nebula-agent/v3/internal/clients"
 	"github.com/vesoft-inc/nebula-agent/v3/internal/limiter"
 	_ "github.com/vesoft-inc/nebula-agent/v3/internal/log"
+	"github.com/vesoft-inc/nebula-agent/v3/internal/metrics"
 	"github.com/vesoft-inc/nebula-agent/v3/internal/server"
 	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
 	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+	"github.com/vesoft-inc/nebula-agent/v3/pkg/storage"
 )
 
 const (
@@ -39,6 +43,16 @@ var (
 	enableSSL          = flag.Bool("enable_ssl", false, "Enable SSL for agent")
 	insecureSkipVerify = flag.Bool("insecure_skip_verify", false, "Verify the server's certificate chain and host name")
 	serverName         = flag.String("server_name", "", "The subject alternative name (SAN) of the peer server to verify")
+	supervisor         = flag.String("supervisor", clients.SupervisorScript, "How to start/stop services, script: call scripts/nebula.service, native: launch the nebula binaries directly")
+	stopTimeout        = flag.Int("stop_timeout", 30, "Seconds to wait after SIGTERM before killing the service in native supervisor")
+	stateDir           = flag.String("state_dir", "state", "Dir to keep the agent states which should survive restart, such as the write bans")
+	watchdog           = flag.Bool("watchdog", false, "Restart the crashed local services automatically, need --meta to learn the services")
+	watchdogInterval   = flag.Int("watchdog_interval", 10, "Interval of watchdog checking the services, in seconds")
+	logRetention       = flag.String("log_retention", "", "The json file of log retention policies of local services, enable the log janitor if set, need --meta to learn the services")
+	playbackTimeout    = flag.Int("playback_timeout", 0, "Seconds to wait db_playback before killing it, if not given in the request, no limit if 0")
+	allowedRoots       = flag.String("allowed_roots", "", "Comma separated install roots of nebula which the agent could run commands in, the root dirs of the local services learned from meta if empty")
+	commandNofile      = flag.Uint64("command_nofile", 0, "The open files limit of the services and tools started by agent, inherited from agent if 0")
+	metricsAddr        = flag.String("metrics_addr", "", "The http address to export prometheus /metrics, disabled if empty")
 )
 
 func main() {
@@ -62,14 +76,28 @@ func main() {
 		keyPath = stringPtr(os.Getenv(ClientKeyPathEnv))
 	}
 
+	if err := clients.InitSupervisor(*supervisor, time.Duration(*stopTimeout)*time.Second); err != nil {
+		log.WithError(err).Fatalf("Failed to init supervisor.")
+	}
+
+	if err := clients.InitCommandRunner(strings.Split(*allowedRoots, ","), *commandNofile); err != nil {
+		log.WithError(err).Fatalf("Failed to init command runner.")
+	}
+
 	// set db_playback tls config
 	clients.InitPlayBackTLSConfig(*caPath, *certPath, *keyPath, *serverName, *enableSSL)
+	clients.DefaultPlayBackTimeout = time.Duration(*playbackTimeout) * time.Second
 
 	lis, err := net.Listen("tcp", *agent)
 	if err != nil {
 		log.WithError(err).Fatalf("Failed to listen: %v.", *agent)
 	}
 	var opts []grpc.ServerOption
+	if *metricsAddr != "" {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor),
+			grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor))
+	}
 	grpcServer := grpc.NewServer(opts...)
 
 	// graceful stop
@@ -99,14 +127,39 @@ func main() {
 		if err != nil {
 			log.WithError(err).Fatalf("Failed to create meta config.")
 		}
-		agentServer, err = server.NewAgent(metaCfg)
+		var watchdogCfg *clients.WatchdogConfig
+		if *watchdog {
+			watchdogCfg = clients.DefaultWatchdogConfig()
+			watchdogCfg.Interval = time.Duration(*watchdogInterval) * time.Second
+		}
+		var janitorCfg *pb.LogRetentionConfig
+		if *logRetention != "" {
+			if janitorCfg, err = clients.LoadLogRetentionConfig(*logRetention); err != nil {
+				log.WithError(err).Fatalf("Failed to load log retention config.")
+			}
+		}
+		agentServer, err = server.NewAgent(metaCfg, watchdogCfg, janitorCfg, *stateDir)
 		if err != nil {
 			log.WithError(err).Fatalf("Failed to create agent server.")
 		}
 	}
 
 	pb.RegisterAgentServiceServer(grpcServer, agentServer)
-	pb.RegisterStorageServiceServer(grpcServer, server.NewStorage())
+	storageServer := server.NewStorage(*agent, GitInfoSHA)
+	pb.RegisterStorageServiceServer(grpcServer, storageServer)
+	pb.RegisterJobServiceServer(grpcServer, server.NewJob())
+
+	if *metricsAddr != "" {
+		storage.TransferObserver = metrics.ObserveTransfer
+		limiter.WaitObserver = metrics.ObserveLimiterWait
+		metrics.RegisterGauge("storage", "sessions", "Cached storage sessions.", func() float64 {
+			return float64(storageServer.Sessions())
+		})
+		if agentServer != nil {
+			agentServer.RegisterMetrics()
+		}
+		go metrics.Serve(*metricsAddr)
+	}
 	grpcServer.Serve(lis)
 }
 
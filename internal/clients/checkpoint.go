@@ -0,0 +1,169 @@
+package clients
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const checkpointsDir = "checkpoints"
+
+// Checkpoints manage the checkpoints in the storaged data paths, which are in:
+//
+//	{data}/nebula/{spaceId}/checkpoints/{name}
+//	{data}/nebula/{spaceId}/{partId}/checkpoints/{name}
+type Checkpoints struct {
+	// the dirs containing the space dirs, i.e. {data}/nebula
+	dataPaths []string
+}
+
+func NewCheckpoints(dataPaths []string) *Checkpoints {
+	return &Checkpoints{dataPaths: dataPaths}
+}
+
+// List return the checkpoints of the space, or of all spaces if spaceId is 0
+func (c *Checkpoints) List(spaceId int64) ([]*pb.CheckpointInfo, error) {
+	infos := make([]*pb.CheckpointInfo, 0)
+	for _, p := range c.dataPaths {
+		found, err := c.find(filepath.Clean(p), spaceId, "")
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, found...)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].DataPath != infos[j].DataPath {
+			return infos[i].DataPath < infos[j].DataPath
+		}
+		if infos[i].SpaceId != infos[j].SpaceId {
+			return infos[i].SpaceId < infos[j].SpaceId
+		}
+		return infos[i].Name < infos[j].Name
+	})
+	return infos, nil
+}
+
+// Drop remove the checkpoint of the name in the space, or in all spaces if spaceId is 0,
+// return the dropped ones
+func (c *Checkpoints) Drop(name string, spaceId int64) ([]*pb.CheckpointInfo, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return nil, fmt.Errorf("invalid checkpoint name: %q", name)
+	}
+
+	dropped := make([]*pb.CheckpointInfo, 0)
+	for _, p := range c.dataPaths {
+		found, err := c.find(filepath.Clean(p), spaceId, name)
+		if err != nil {
+			return dropped, err
+		}
+		for _, info := range found {
+			for _, path := range info.Paths {
+				log.WithField("path", path).Info("Drop checkpoint.")
+				if err := os.RemoveAll(path); err != nil {
+					return dropped, fmt.Errorf("drop checkpoint %s failed: %w", path, err)
+				}
+			}
+			dropped = append(dropped, info)
+		}
+	}
+	return dropped, nil
+}
+
+// find the checkpoints in the data path, filtered by space and name if not empty
+func (c *Checkpoints) find(dataPath string, spaceId int64, name string) ([]*pb.CheckpointInfo, error) {
+	spaces, err := os.ReadDir(dataPath)
+	if err != nil {
+		return nil, fmt.Errorf("read data path %s failed: %w", dataPath, err)
+	}
+
+	infos := make([]*pb.CheckpointInfo, 0)
+	for _, sd := range spaces {
+		id, err := strconv.ParseInt(sd.Name(), 10, 64)
+		if err != nil || id <= 0 || !sd.IsDir() || (spaceId != 0 && id != spaceId) {
+			continue
+		}
+		spaceDir := filepath.Join(dataPath, sd.Name())
+
+		parents := []string{filepath.Join(spaceDir, checkpointsDir)}
+		parts, err := os.ReadDir(spaceDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, pd := range parts {
+			if _, err := strconv.ParseInt(pd.Name(), 10, 64); err == nil && pd.IsDir() {
+				parents = append(parents, filepath.Join(spaceDir, pd.Name(), checkpointsDir))
+			}
+		}
+
+		byName := make(map[string]*pb.CheckpointInfo)
+		for _, parent := range parents {
+			// the symlinks are not followed, so nothing outside the data path is touched
+			if fi, err := os.Lstat(parent); err != nil || !fi.IsDir() {
+				continue
+			}
+			entries, err := os.ReadDir(parent)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range entries {
+				if !e.IsDir() || (name != "" && e.Name() != name) {
+					continue
+				}
+				info, ok := byName[e.Name()]
+				if !ok {
+					info = &pb.CheckpointInfo{Name: e.Name(), SpaceId: id, DataPath: dataPath}
+					byName[e.Name()] = info
+					infos = append(infos, info)
+				}
+				path := filepath.Join(parent, e.Name())
+				info.Paths = append(info.Paths, path)
+				if err := sumCheckpoint(path, info); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return infos, nil
+}
+
+// sumCheckpoint add the sizes and latest mtime of files in the checkpoint dir to the info
+func sumCheckpoint(dir string, info *pb.CheckpointInfo) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		fi, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			if t := fi.ModTime().Unix(); t > info.CreateTime {
+				info.CreateTime = t
+			}
+			return nil
+		}
+
+		info.TotalBytes += fi.Size()
+		// the files hard linked to data or wal are not freed when dropped
+		if st, ok := fi.Sys().(*syscall.Stat_t); !ok || st.Nlink <= 1 {
+			info.ExclusiveBytes += fi.Size()
+		}
+		return nil
+	})
+}
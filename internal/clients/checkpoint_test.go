@@ -0,0 +1,55 @@
+package clients
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpoints(t *testing.T) {
+	assert := assert.New(t)
+	dataPath := t.TempDir()
+	outside := t.TempDir()
+	write := func(name string, n int) {
+		path := filepath.Join(dataPath, name)
+		assert.Nil(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(os.WriteFile(path, make([]byte, n), 0644))
+	}
+	write("1/data/000001.sst", 100)
+	write("1/checkpoints/snap1/data/000002.sst", 10)
+	write("1/checkpoints/snap1/wal/1/0000001.wal", 20)
+	write("1/1/checkpoints/snap1/wal/0000002.wal", 30)
+	write("1/checkpoints/snap2/data/000003.sst", 1)
+	write("2/checkpoints/snap1/data/000004.sst", 2)
+	assert.Nil(os.Link(filepath.Join(dataPath, "1/data/000001.sst"), filepath.Join(dataPath, "1/checkpoints/snap1/data/000001.sst")))
+	// the checkpoints linked outside are ignored
+	assert.Nil(os.MkdirAll(filepath.Join(outside, "snap1"), 0755))
+	assert.Nil(os.MkdirAll(filepath.Join(dataPath, "3"), 0755))
+	assert.Nil(os.Symlink(outside, filepath.Join(dataPath, "3/checkpoints")))
+
+	c := NewCheckpoints([]string{dataPath})
+	infos, err := c.List(1)
+	assert.Nil(err)
+	assert.Len(infos, 2)
+	assert.Equal("snap1", infos[0].Name)
+	assert.Len(infos[0].Paths, 2)
+	assert.Equal(int64(160), infos[0].TotalBytes)
+	assert.Equal(int64(60), infos[0].ExclusiveBytes)
+	assert.NotZero(infos[0].CreateTime)
+
+	_, err = c.Drop("../1", 0)
+	assert.NotNil(err)
+	dropped, err := c.Drop("snap1", 0)
+	assert.Nil(err)
+	assert.Len(dropped, 2)
+	infos, err = c.List(0)
+	assert.Nil(err)
+	assert.Len(infos, 1)
+	assert.Equal("snap2", infos[0].Name)
+	_, err = os.Stat(filepath.Join(dataPath, "1/data/000001.sst"))
+	assert.Nil(err)
+	_, err = os.Stat(filepath.Join(outside, "snap1"))
+	assert.Nil(err)
+}
@@ -0,0 +1,251 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// the max bytes of stdout and stderr captured in CommandResult
+const maxCapturedOutput = 1 << 20
+
+var (
+	// the dirs which the commands could run in, the learned roots are used if empty
+	allowedRoots []string
+	// return the root dirs of the local services learned from meta
+	learnedRoots func() []string
+	// the open files limit of the commands, inherited from agent if 0
+	commandNofile uint64
+)
+
+// the environment variables of agent passed to the commands, the others are dropped
+var inheritedEnv = []string{"PATH", "HOME", "USER", "LANG", "LC_ALL", "TZ", "LD_LIBRARY_PATH"}
+
+// InitCommandRunner set the allowed install roots and the open files limit of the commands started by agent
+func InitCommandRunner(roots []string, nofile uint64) error {
+	allowedRoots = make([]string, 0, len(roots))
+	for _, r := range roots {
+		if r == "" {
+			continue
+		}
+		if !filepath.IsAbs(r) {
+			return fmt.Errorf("allowed root %s is not absolute", r)
+		}
+		allowedRoots = append(allowedRoots, resolvePath(r))
+	}
+	commandNofile = nofile
+	return nil
+}
+
+// InitLearnedRoots set the root dirs of the local services learned from meta,
+// which are allowed when no roots are given to InitCommandRunner
+func InitLearnedRoots(roots func() []string) {
+	learnedRoots = roots
+}
+
+func resolvePath(path string) string {
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		return p
+	}
+	return filepath.Clean(path)
+}
+
+func validRoots() []string {
+	if len(allowedRoots) != 0 || learnedRoots == nil {
+		return allowedRoots
+	}
+	roots := make([]string, 0)
+	for _, r := range learnedRoots() {
+		if filepath.IsAbs(r) {
+			roots = append(roots, resolvePath(r))
+		}
+	}
+	return roots
+}
+
+// ValidatePath check the path is absolute and in the allowed roots after resolving symlinks,
+// nothing is allowed if there are neither allowed roots nor the roots learned from meta
+func ValidatePath(path string) error {
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("path %s is not absolute", path)
+	}
+	roots := validRoots()
+	if len(roots) == 0 {
+		return fmt.Errorf("path %s is not allowed, no allowed roots given and no local services learned from meta", path)
+	}
+	resolved := resolvePath(path)
+	for _, r := range roots {
+		if resolved == r || strings.HasPrefix(resolved, r+"/") {
+			return nil
+		}
+	}
+	return fmt.Errorf("path %s is not in the allowed roots %v", path, roots)
+}
+
+// Command is an executable run directly with argv, never through shell
+type Command struct {
+	// the binary relative to Dir, or absolute
+	Binary string
+	Args   []string
+	Dir    string
+	// the extra environment variables in key=value
+	Env []string
+	// both stdout and stderr are written to the file if set, otherwise captured in the result
+	Output *os.File
+	// run in a new session, so it survives the agent
+	Setsid bool
+	// run in a new process group, so it could be killed together with its children
+	Setpgid bool
+}
+
+// CommandResult is the result of a finished command
+type CommandResult struct {
+	ExitCode int
+	Stdout   string
+	Stderr   string
+	Duration time.Duration
+}
+
+func (c *Command) String() string {
+	return strings.Join(append([]string{c.binary()}, c.Args...), " ")
+}
+
+func (c *Command) binary() string {
+	if filepath.IsAbs(c.Binary) {
+		return filepath.Clean(c.Binary)
+	}
+	return filepath.Join(c.Dir, c.Binary)
+}
+
+func (c *Command) validate() error {
+	if err := ValidatePath(c.Dir); err != nil {
+		return err
+	}
+	if err := ValidatePath(c.binary()); err != nil {
+		return err
+	}
+	for _, a := range append([]string{c.Binary}, c.Args...) {
+		if strings.ContainsRune(a, 0) {
+			return fmt.Errorf("invalid argument %q", a)
+		}
+	}
+	for _, e := range c.Env {
+		if k, _, ok := strings.Cut(e, "="); !ok || k == "" {
+			return fmt.Errorf("invalid environment variable %q", e)
+		}
+	}
+	return nil
+}
+
+func (c *Command) cmd(ctx context.Context) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, c.binary(), c.Args...)
+	cmd.Dir = c.Dir
+	for _, k := range inheritedEnv {
+		if v, ok := os.LookupEnv(k); ok {
+			cmd.Env = append(cmd.Env, k+"="+v)
+		}
+	}
+	cmd.Env = append(cmd.Env, c.Env...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: c.Setsid, Setpgid: c.Setpgid && !c.Setsid}
+	return cmd
+}
+
+// setLimits change the resource limits of the process right after started, which are inherited by its children
+func setLimits(pid int) {
+	if commandNofile == 0 {
+		return
+	}
+	rlim := &unix.Rlimit{Cur: commandNofile, Max: commandNofile}
+	if err := unix.Prlimit(pid, unix.RLIMIT_NOFILE, rlim, nil); err != nil {
+		log.WithError(err).WithField("pid", pid).Warn("Set open files limit failed.")
+	}
+}
+
+// Start the command in background, the caller should wait the returned cmd
+func (c *Command) Start() (*exec.Cmd, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	cmd := c.cmd(context.Background())
+	if c.Output != nil {
+		cmd.Stdout = c.Output
+		cmd.Stderr = c.Output
+	}
+
+	log.WithField("cmd", c.String()).WithField("dir", c.Dir).Debug("Start command.")
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("start %s failed: %w", c.Binary, err)
+	}
+	setLimits(cmd.Process.Pid)
+	return cmd, nil
+}
+
+// Run the command until it exits or the ctx is done, an error is returned if it exits with non-zero code
+func (c *Command) Run(ctx context.Context) (*CommandResult, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	cmd := c.cmd(ctx)
+	stdout, stderr := &cappedBuffer{}, &cappedBuffer{}
+	if c.Output != nil {
+		cmd.Stdout = c.Output
+		cmd.Stderr = c.Output
+	} else {
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+	}
+
+	log.WithField("cmd", c.String()).WithField("dir", c.Dir).Debug("Run command.")
+	start := time.Now()
+	err := cmd.Start()
+	if err == nil {
+		setLimits(cmd.Process.Pid)
+		err = cmd.Wait()
+	}
+	res := &CommandResult{
+		ExitCode: cmd.ProcessState.ExitCode(),
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+		Duration: time.Since(start),
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return res, nil
+	case errors.As(err, &exitErr):
+		msg := strings.TrimSpace(res.Stderr)
+		if i := strings.LastIndex(msg, "\n"); i >= 0 {
+			msg = msg[i+1:]
+		}
+		return res, fmt.Errorf("%s exited with %d: %s: %w", c.Binary, res.ExitCode, msg, err)
+	default:
+		return res, fmt.Errorf("run %s failed: %w", c.Binary, err)
+	}
+}
+
+// cappedBuffer keeps the first maxCapturedOutput bytes and drops the rest
+type cappedBuffer struct {
+	bytes.Buffer
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if n := maxCapturedOutput - b.Len(); n > 0 {
+		if len(p) > n {
+			b.Buffer.Write(p[:n])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,85 @@
+package clients
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// allowRoots make the paths in the roots pass ValidatePath in the test
+func allowRoots(t *testing.T, roots ...string) {
+	prev := allowedRoots
+	assert.Nil(t, InitCommandRunner(append(append([]string{}, prev...), roots...), 0))
+	t.Cleanup(func() { allowedRoots = prev })
+}
+
+func TestCommand(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	outside := t.TempDir()
+	assert.Nil(InitCommandRunner([]string{root}, 0))
+	defer InitCommandRunner(nil, 0)
+
+	script := "#!/bin/sh\necho \"$1\"\necho \"secret=$SECRET foo=$FOO\"\nsleep 0.1\nulimit -n\necho oops >&2\nexit $2\n"
+	assert.Nil(os.MkdirAll(filepath.Join(root, "bin"), 0755))
+	assert.Nil(os.WriteFile(filepath.Join(root, "bin/tool"), []byte(script), 0755))
+	assert.Nil(os.WriteFile(filepath.Join(outside, "tool"), []byte(script), 0755))
+	assert.Nil(os.Symlink(outside, filepath.Join(root, "escape")))
+	os.Setenv("SECRET", "x")
+	defer os.Unsetenv("SECRET")
+
+	// the arguments are never interpreted by shell
+	c := &Command{Binary: "bin/tool", Args: []string{"; touch pwned", "0"}, Dir: root, Env: []string{"FOO=bar"}}
+	res, err := c.Run(context.Background())
+	assert.Nil(err)
+	assert.Equal(0, res.ExitCode)
+	lines := []string{"; touch pwned", "secret= foo=bar"}
+	assert.Equal(lines[0]+"\n"+lines[1], res.Stdout[:len(lines[0])+len(lines[1])+1])
+	assert.Equal("oops\n", res.Stderr)
+	_, err = os.Stat(filepath.Join(root, "pwned"))
+	assert.True(os.IsNotExist(err))
+
+	c.Args = []string{"", "3"}
+	res, err = c.Run(context.Background())
+	assert.ErrorContains(err, "exited with 3: oops")
+	assert.Equal(3, res.ExitCode)
+
+	for _, bad := range []*Command{
+		{Binary: "tool", Dir: outside},
+		{Binary: "escape/tool", Dir: root},
+		{Binary: "../" + filepath.Base(outside) + "/tool", Dir: root},
+		{Binary: "bin/tool", Dir: "relative"},
+		{Binary: "bin/tool", Dir: root, Env: []string{"=x"}},
+	} {
+		_, err = bad.Run(context.Background())
+		assert.NotNil(err, bad.String())
+	}
+
+	assert.Nil(InitCommandRunner([]string{root}, 1000))
+	c.Args = []string{"", "0"}
+	res, err = c.Run(context.Background())
+	assert.Nil(err)
+	assert.Contains(res.Stdout, "\n1000\n")
+}
+
+func TestValidatePath(t *testing.T) {
+	assert := assert.New(t)
+	root := t.TempDir()
+	defer InitLearnedRoots(nil)
+
+	// nothing is allowed without roots
+	assert.NotNil(ValidatePath(root))
+	InitLearnedRoots(func() []string { return nil })
+	assert.NotNil(ValidatePath(root))
+
+	// the roots learned from meta are used if no roots given
+	InitLearnedRoots(func() []string { return []string{root, "relative"} })
+	assert.Nil(ValidatePath(filepath.Join(root, "etc")))
+	assert.NotNil(ValidatePath("/etc"))
+
+	allowRoots(t, t.TempDir())
+	assert.NotNil(ValidatePath(root))
+}
@@ -0,0 +1,301 @@
+package clients
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const (
+	configBackupDirName = "backup"
+	maxConfigBackups    = 20
+)
+
+// the flags could be changed at runtime through the http /flags of nebula,
+// changing other flags in config file needs restarting the service
+var mutableFlags = map[string]bool{
+	"v":                                 true,
+	"minloglevel":                       true,
+	"wal_ttl":                           true,
+	"rocksdb_db_options":                true,
+	"rocksdb_column_family_options":     true,
+	"rocksdb_block_based_table_options": true,
+	"max_allowed_connections":           true,
+	"session_idle_timeout_secs":         true,
+	"client_idle_timeout_secs":          true,
+	"slow_query_threshold_us":           true,
+}
+
+// the flags of paths, which are relative to the root dir, data_path could be a comma separated list
+var pathFlags = map[string]bool{
+	"log_dir":        true,
+	"pid_file":       true,
+	"data_path":      true,
+	"meta_data_path": true,
+	"flagfile":       true,
+}
+
+// the flags of file names in the log dir
+var logFileFlags = map[string]bool{
+	"stdout_log_file": true,
+	"stderr_log_file": true,
+}
+
+// configMu serializes the modifications of config files in agent machine
+var configMu sync.Mutex
+
+// ServiceConfig manages the config file of the service in etc dir,
+// and keeps the versioned backups in etc/backup when modified
+type ServiceConfig struct {
+	s *Service
+}
+
+func NewServiceConfig(s *Service) *ServiceConfig {
+	return &ServiceConfig{s: s}
+}
+
+func (c *ServiceConfig) backupDir() string {
+	return filepath.Join(c.s.dir, "etc", configBackupDirName)
+}
+
+func (c *ServiceConfig) backupPath(version int64) string {
+	return filepath.Join(c.backupDir(), fmt.Sprintf("%s.conf.%d", c.s.instanceName(), version))
+}
+
+// Versions return the backup versions in ascending order
+func (c *ServiceConfig) Versions() ([]int64, error) {
+	entries, err := os.ReadDir(c.backupDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []int64{}, nil
+		}
+		return nil, err
+	}
+
+	prefix := c.s.instanceName() + ".conf."
+	versions := make([]int64, 0)
+	for _, e := range entries {
+		if !strings.HasPrefix(e.Name(), prefix) {
+			continue
+		}
+		if v, err := strconv.ParseInt(strings.TrimPrefix(e.Name(), prefix), 10, 64); err == nil {
+			versions = append(versions, v)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+// backup copy the current config file to a new version, and remove the oldest ones
+func (c *ServiceConfig) backup() (int64, error) {
+	data, err := os.ReadFile(c.s.flagFile())
+	if err != nil {
+		return 0, err
+	}
+	versions, err := c.Versions()
+	if err != nil {
+		return 0, err
+	}
+
+	version := time.Now().UnixMilli()
+	if n := len(versions); n > 0 && versions[n-1] >= version {
+		version = versions[n-1] + 1
+	}
+	if err = os.MkdirAll(c.backupDir(), 0755); err != nil {
+		return 0, err
+	}
+	if err = utils.WriteFileAtomic(c.backupPath(version), data, 0644); err != nil {
+		return 0, fmt.Errorf("backup config of %s failed: %w", c.s.name, err)
+	}
+
+	versions = append(versions, version)
+	for len(versions) > maxConfigBackups {
+		if err = os.Remove(c.backupPath(versions[0])); err != nil {
+			log.WithError(err).WithField("version", versions[0]).Warn("Remove config backup failed.")
+		}
+		versions = versions[1:]
+	}
+	return version, nil
+}
+
+func (c *ServiceConfig) Get(version int64) (*pb.GetServiceConfigResponse, error) {
+	path := c.s.flagFile()
+	if version != 0 {
+		path = c.backupPath(version)
+	}
+	f, err := utils.LoadFlagFile(path)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := c.Versions()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetServiceConfigResponse{
+		Flags:    f.Flags(),
+		Content:  string(f.Bytes()),
+		Versions: versions,
+	}, nil
+}
+
+// isBool tell whether the flag value is a bool literal, the numbers like 0 and 1 are integers in gflags
+func isBool(v string) bool {
+	return v == "true" || v == "false"
+}
+
+// validateValue check the new value has the same type with the old one
+func validateValue(k, old, v string) error {
+	if isBool(old) {
+		if !isBool(v) {
+			return fmt.Errorf("flag %s should be bool, got %s", k, v)
+		}
+		return nil
+	}
+	if _, err := strconv.ParseInt(old, 10, 64); err == nil {
+		if _, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return fmt.Errorf("flag %s should be integer, got %s", k, v)
+		}
+		return nil
+	}
+	if _, err := strconv.ParseFloat(old, 64); err == nil {
+		if _, err = strconv.ParseFloat(v, 64); err != nil {
+			return fmt.Errorf("flag %s should be number, got %s", k, v)
+		}
+	}
+	return nil
+}
+
+// validatePath check the path flag stays in the root dir and the allowed roots after resolving symlinks,
+// since the agent reads, writes and removes files by these flags
+func (c *ServiceConfig) validatePath(k, v string) error {
+	if logFileFlags[k] {
+		if v != filepath.Base(v) || v == "." || v == ".." {
+			return fmt.Errorf("flag %s should be a file name in log dir, got %s", k, v)
+		}
+		return nil
+	}
+	if !pathFlags[k] {
+		return nil
+	}
+
+	root := resolvePath(c.s.dir)
+	for _, p := range strings.Split(v, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(c.s.dir, p)
+		}
+		if err := ValidatePath(p); err != nil {
+			return fmt.Errorf("flag %s is not allowed: %w", k, err)
+		}
+		if resolved := resolvePath(p); resolved != root && !strings.HasPrefix(resolved, root+"/") {
+			return fmt.Errorf("flag %s should be in the root dir %s, got %s", k, c.s.dir, v)
+		}
+	}
+	return nil
+}
+
+// restartFlags return the changed flags which need restarting the service
+func restartFlags(old, cur map[string]string) []string {
+	names := make([]string, 0)
+	for k, v := range cur {
+		if ov, ok := old[k]; (!ok || ov != v) && !mutableFlags[k] {
+			names = append(names, k)
+		}
+	}
+	for k := range old {
+		if _, ok := cur[k]; !ok && !mutableFlags[k] {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Update apply the changes to the config file after backing up the current one
+func (c *ServiceConfig) Update(set map[string]string, remove []string, dryRun bool) (*pb.UpdateServiceConfigResponse, error) {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	f, err := utils.LoadFlagFile(c.s.flagFile())
+	if err != nil {
+		return nil, err
+	}
+	old := f.Flags()
+
+	for k, v := range set {
+		if ov, ok := old[k]; ok {
+			if err = validateValue(k, ov, v); err != nil {
+				return nil, err
+			}
+		}
+		if err = c.validatePath(k, v); err != nil {
+			return nil, err
+		}
+		if err = f.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+	for _, k := range remove {
+		f.Remove(k)
+	}
+
+	resp := &pb.UpdateServiceConfigResponse{
+		RestartFlags: restartFlags(old, f.Flags()),
+	}
+	resp.RestartRequired = len(resp.RestartFlags) != 0
+	if dryRun {
+		return resp, nil
+	}
+
+	if resp.BackupVersion, err = c.backup(); err != nil {
+		return nil, err
+	}
+	if err = f.Save(c.s.flagFile()); err != nil {
+		return nil, fmt.Errorf("write config of %s failed: %w", c.s.name, err)
+	}
+	log.WithField("backup_version", resp.BackupVersion).WithField("restart_flags", resp.RestartFlags).
+		Infof("Update config of %s.", c.s.name)
+	return resp, nil
+}
+
+// Rollback replace the config file with the backup version, the current one is also backed up
+func (c *ServiceConfig) Rollback(version int64) (*pb.RollbackServiceConfigResponse, error) {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	target, err := utils.LoadFlagFile(c.backupPath(version))
+	if err != nil {
+		return nil, fmt.Errorf("load config backup %d of %s failed: %w", version, c.s.name, err)
+	}
+	cur, err := utils.LoadFlagFile(c.s.flagFile())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.RollbackServiceConfigResponse{
+		RestartFlags: restartFlags(cur.Flags(), target.Flags()),
+	}
+	resp.RestartRequired = len(resp.RestartFlags) != 0
+	if resp.BackupVersion, err = c.backup(); err != nil {
+		return nil, err
+	}
+	if err = target.Save(c.s.flagFile()); err != nil {
+		return nil, fmt.Errorf("write config of %s failed: %w", c.s.name, err)
+	}
+	log.WithField("version", version).WithField("backup_version", resp.BackupVersion).
+		Infof("Rollback config of %s.", c.s.name)
+	return resp, nil
+}
@@ -0,0 +1,87 @@
+package clients
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	s := fakeService(t, "")
+	c := NewServiceConfig(s)
+	resp, err := c.Get(0)
+	assert.Nil(err)
+	assert.Equal("true", resp.Flags["daemonize"])
+	assert.Empty(resp.Versions)
+
+	// type mismatch
+	_, err = c.Update(map[string]string{"daemonize": "yes"}, nil, false)
+	assert.NotNil(err)
+
+	// the paths out of the root dir
+	for k, v := range map[string]string{
+		"log_dir":         "/",
+		"pid_file":        "/etc/passwd",
+		"data_path":       "data/storage,../storage",
+		"stdout_log_file": "../../etc/passwd",
+	} {
+		_, err = c.Update(map[string]string{k: v}, nil, true)
+		assert.NotNil(err, k)
+	}
+	assert.Nil(os.Symlink("/etc", filepath.Join(s.dir, "escape")))
+	_, err = c.Update(map[string]string{"log_dir": "escape"}, nil, true)
+	assert.NotNil(err)
+	_, err = c.Update(map[string]string{"log_dir": "logs2", "data_path": "data/a, data/b", "stdout_log_file": "out.log"}, nil, true)
+	assert.Nil(err)
+
+	// dry run does not change the file
+	uresp, err := c.Update(map[string]string{"v": "3"}, nil, true)
+	assert.Nil(err)
+	assert.False(uresp.RestartRequired)
+	resp, err = c.Get(0)
+	assert.Nil(err)
+	assert.NotContains(resp.Flags, "v")
+
+	uresp, err = c.Update(map[string]string{"v": "3", "port": "9670"}, []string{"daemonize"}, false)
+	assert.Nil(err)
+	assert.True(uresp.RestartRequired)
+	assert.Equal([]string{"daemonize", "port"}, uresp.RestartFlags)
+	resp, err = c.Get(0)
+	assert.Nil(err)
+	assert.Equal("3", resp.Flags["v"])
+	assert.NotContains(resp.Flags, "daemonize")
+	assert.Equal([]int64{uresp.BackupVersion}, resp.Versions)
+
+	// rollback to the config before updating
+	rresp, err := c.Rollback(uresp.BackupVersion)
+	assert.Nil(err)
+	assert.Equal([]string{"daemonize", "port"}, rresp.RestartFlags)
+	resp, err = c.Get(0)
+	assert.Nil(err)
+	assert.Equal("true", resp.Flags["daemonize"])
+	assert.Equal(2, len(resp.Versions))
+
+	// old backups are removed
+	for i := 0; i < maxConfigBackups; i++ {
+		_, err = c.Update(map[string]string{"v": "1"}, nil, false)
+		assert.Nil(err)
+	}
+	entries, err := os.ReadDir(filepath.Join(s.dir, "etc", configBackupDirName))
+	assert.Nil(err)
+	assert.Equal(maxConfigBackups, len(entries))
+}
+
+func TestValidateValue(t *testing.T) {
+	assert := assert.New(t)
+	assert.Nil(validateValue("v", "0", "3"))
+	assert.Nil(validateValue("minloglevel", "1", "2"))
+	assert.NotNil(validateValue("v", "0", "true"))
+	assert.Nil(validateValue("daemonize", "true", "false"))
+	assert.NotNil(validateValue("daemonize", "true", "1"))
+	assert.Nil(validateValue("ratio", "0.5", "1"))
+	assert.NotNil(validateValue("ratio", "0.5", "high"))
+}
@@ -1,11 +1,17 @@
 package clients
 
 import (
+	"context"
 	"fmt"
-	"os/exec"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
 	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
 )
 
@@ -32,90 +38,357 @@ func toName(r pb.ServiceRole) ServiceName {
 	return ServiceName_Unknown
 }
 
+func toRole(n ServiceName) pb.ServiceRole {
+	switch n {
+	case ServiceName_Metad:
+		return pb.ServiceRole_META
+	case ServiceName_Storaged:
+		return pb.ServiceRole_STORAGE
+	case ServiceName_Graphd:
+		return pb.ServiceRole_GRAPH
+	default:
+		return pb.ServiceRole_UNKNOWN_ROLE
+	}
+}
+
 // Service keeps the metad/storaged/graphd's role name and root dir which
 // should have scripts to start/stop service
 type Service struct {
 	name ServiceName
 	dir  string
+	// conf is the config file of the instance, etc/nebula-{role}.conf in dir if empty
+	conf string
+	// addr is the service address in meta, only set for the services learned from heartbeat
+	addr string
+}
+
+// NewService return the service instance in the root dir, the instance is the rpc port
+// or the config file of it, the default instance if empty
+func NewService(role pb.ServiceRole, dir, instance string) (*Service, error) {
+	if err := ValidatePath(dir); err != nil {
+		return nil, err
+	}
+	s := &Service{
+		name: toName(role),
+		dir:  dir,
+	}
+	if instance == "" {
+		return s, nil
+	}
+
+	if _, err := strconv.Atoi(instance); err == nil {
+		conf, err := s.findConf(instance)
+		if err != nil {
+			return nil, err
+		}
+		s.conf = conf
+		return s, nil
+	}
+
+	conf := filepath.Clean(instance)
+	if !filepath.IsAbs(conf) {
+		conf = filepath.Join(dir, conf)
+	}
+	if filepath.Dir(conf) != filepath.Join(filepath.Clean(dir), "etc") || filepath.Ext(conf) != ".conf" {
+		return nil, fmt.Errorf("instance %s of %s is not a config file in %s", instance, s.name, filepath.Join(dir, "etc"))
+	}
+	if err := ValidatePath(conf); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(conf); err != nil {
+		return nil, fmt.Errorf("config file of %s instance %s not found: %w", s.name, instance, err)
+	}
+	s.conf = conf
+	return s, nil
+}
+
+// confFiles return the config files of the role in etc dir, one for each instance
+func (s *Service) confFiles() ([]string, error) {
+	return filepath.Glob(filepath.Join(s.dir, "etc", s.processName()+"*.conf"))
+}
+
+// findConf return the config file in etc dir whose rpc port is the given one
+func (s *Service) findConf(port string) (string, error) {
+	files, err := s.confFiles()
+	if err != nil {
+		return "", err
+	}
+
+	found := make([]string, 0)
+	for _, f := range files {
+		flags, err := utils.ParseFlagFile(f)
+		if err != nil {
+			log.WithError(err).WithField("file", f).Debug("Parse flag file failed.")
+			continue
+		}
+		if flagOr(flags, "port", defaultPorts[s.name][0]) == port {
+			found = append(found, f)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("no config file of %s with port %s found in %s", s.name, port, s.dir)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("%d config files of %s with port %s found: %v", len(found), s.name, port, found)
+	}
 }
 
-func FromStartReq(req *pb.StartServiceRequest) *Service {
-	return &Service{
-		name: toName(req.GetRole()),
-		dir:  req.GetDir(),
+// matchAddr set the config file of the instance listening on the addr learned from heartbeat,
+// the default one is kept if not found
+func (s *Service) matchAddr(addr string) {
+	s.addr = addr
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return
+	}
+	conf, err := s.findConf(port)
+	if err != nil {
+		log.WithError(err).WithField("addr", addr).Debug("Match config file of service failed.")
+		return
+	}
+	if conf != s.flagFile() {
+		s.conf = conf
 	}
 }
 
-func FromStopReq(req *pb.StopServiceRequest) *Service {
-	return &Service{
-		name: toName(req.GetRole()),
-		dir:  req.GetDir(),
+// the order to start services, and they are stopped in reverse order
+var startOrder = []pb.ServiceRole{pb.ServiceRole_META, pb.ServiceRole_STORAGE, pb.ServiceRole_GRAPH}
+
+// ExpandRoles sort the roles in start order, and ALL is expanded to the services installed in the dir
+func ExpandRoles(dir string, roles []pb.ServiceRole) ([]pb.ServiceRole, error) {
+	if err := ValidatePath(dir); err != nil {
+		return nil, err
 	}
+	want := make(map[pb.ServiceRole]bool)
+	for _, r := range roles {
+		switch r {
+		case pb.ServiceRole_ALL:
+			for _, o := range startOrder {
+				if (&Service{name: toName(o), dir: dir}).installed() {
+					want[o] = true
+				}
+			}
+		case pb.ServiceRole_META, pb.ServiceRole_STORAGE, pb.ServiceRole_GRAPH:
+			want[r] = true
+		default:
+			return nil, fmt.Errorf("bad service role: %s", r)
+		}
+	}
+
+	res := make([]pb.ServiceRole, 0, len(want))
+	for _, o := range startOrder {
+		if want[o] {
+			res = append(res, o)
+		}
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no service found in %s", dir)
+	}
+	return res, nil
 }
 
-func FromStatusReq(req *pb.ServiceStatusRequest) *Service {
-	return &Service{
-		name: toName(req.GetRole()),
-		dir:  req.GetDir(),
+// installed check if both the binary and config file of the service exist
+func (s *Service) installed() bool {
+	for _, p := range []string{s.binary(), s.flagFile()} {
+		if _, err := os.Stat(p); err != nil {
+			return false
+		}
 	}
+	return true
 }
 
-// ServiceDaemon will start/stop metad/storaged/graphd in the service machine
-// through scripts providing by the nebula
-type ServiceDaemon struct {
-	s *Service
+func (s *Service) processName() string {
+	return "nebula-" + string(s.name)
 }
 
-func NewDaemon(s *Service) (*ServiceDaemon, error) {
+func (s *Service) binary() string {
+	return filepath.Join(s.dir, "bin", s.processName())
+}
+
+func (s *Service) flagFile() string {
+	if s.conf != "" {
+		return s.conf
+	}
+	return filepath.Join(s.dir, "etc", s.processName()+".conf")
+}
+
+// instanceName is the name of the config file without ext, which is used to name the pid and output
+// files of the instance, the same as the process name for the default instance
+func (s *Service) instanceName() string {
+	return strings.TrimSuffix(filepath.Base(s.flagFile()), ".conf")
+}
+
+// flags return the flags in the service's config file
+func (s *Service) flags() (map[string]string, error) {
+	flags, err := utils.ParseFlagFile(s.flagFile())
+	if err != nil {
+		return nil, fmt.Errorf("parse flag file of %s failed: %w", s.name, err)
+	}
+	return flags, nil
+}
+
+// logFiles return the candidate log files to diagnose the service, the output file of
+// native supervisor is used when the service crashed before logging set up
+func (s *Service) logFiles(flags map[string]string) []string {
+	logDir := flags["log_dir"]
+	if logDir == "" {
+		logDir = "logs"
+	}
+	if !filepath.IsAbs(logDir) {
+		logDir = filepath.Join(s.dir, logDir)
+	}
+	return []string{
+		filepath.Join(logDir, s.processName()+".INFO"),
+		filepath.Join(s.dir, "logs", s.instanceName()+".out"),
+	}
+}
+
+// tailLogs return the last lines of the first readable log file
+func tailLogs(files []string, n int) (string, []string) {
+	for _, f := range files {
+		lines, err := utils.TailFile(f, n)
+		if err != nil {
+			log.WithError(err).WithField("file", f).Debug("Tail log file failed.")
+			continue
+		}
+		return f, lines
+	}
+	return "", nil
+}
+
+func FromStartReq(req *pb.StartServiceRequest) (*Service, error) {
+	return NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+}
+
+func FromStopReq(req *pb.StopServiceRequest) (*Service, error) {
+	return NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+}
+
+func FromRestartReq(req *pb.RestartServiceRequest) (*Service, error) {
+	return NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+}
+
+func FromTailLogsReq(req *pb.TailLogsRequest) (*Service, error) {
+	return NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+}
+
+func FromCollectDiagnosticsReq(req *pb.CollectDiagnosticsRequest) (*Service, error) {
+	return NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+}
+
+func FromGetConfigReq(req *pb.GetServiceConfigRequest) (*Service, error) {
+	return NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+}
+
+func FromUpdateConfigReq(req *pb.UpdateServiceConfigRequest) (*Service, error) {
+	return NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+}
+
+func FromRollbackConfigReq(req *pb.RollbackServiceConfigRequest) (*Service, error) {
+	return NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+}
+
+func FromStatusReq(req *pb.ServiceStatusRequest) (*Service, error) {
+	return NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+}
+
+// Daemon will start/stop/get status of metad/storaged/graphd in the service machine
+type Daemon interface {
+	Start() error
+	Stop() error
+	Status() (*pb.ServiceStatusResponse, error)
+}
+
+const (
+	SupervisorNative = "native"
+	SupervisorScript = "script"
+)
+
+var supervisorMode = SupervisorScript
+
+// InitSupervisor set how the agent supervises the services:
+// native means launching and signaling the nebula binaries directly,
+// script means calling the scripts/nebula.service providing by the nebula
+func InitSupervisor(mode string, stopTimeout time.Duration) error {
+	switch mode {
+	case SupervisorNative, SupervisorScript:
+	default:
+		return fmt.Errorf("unknown supervisor mode: %s", mode)
+	}
+	supervisorMode = mode
+	defaultStopTimeout = stopTimeout
+	return nil
+}
+
+func NewDaemon(s *Service) (Daemon, error) {
 	if s == nil || s.dir == "" {
 		return nil, fmt.Errorf("%s's is not found or root dir is empty", s.name)
 	}
+	if err := ValidatePath(s.dir); err != nil {
+		return nil, err
+	}
 
-	d := &ServiceDaemon{
-		s: s,
+	if supervisorMode == SupervisorScript {
+		return &ScriptDaemon{s: s}, nil
 	}
-	return d, nil
+	return &NativeDaemon{s: s, stopTimeout: defaultStopTimeout}, nil
 }
 
-// TODO(spw): The code will only check the scripts return code. And when return code is 0,
-// it does not mean the service has must been started successfully. Then we need to
-// check the service process status by other means in the future
-func (d *ServiceDaemon) Start() error {
-	cmdStr := fmt.Sprintf("cd %s && scripts/nebula.service start %s", d.s.dir, d.s.name)
-	log.WithField("cmd", cmdStr).Debug("Try to start service...")
-	cmd := exec.Command("bash", "-c", cmdStr)
-	err := cmd.Run()
-	if err != nil {
+// ScriptDaemon will start/stop metad/storaged/graphd in the service machine
+// through scripts providing by the nebula
+type ScriptDaemon struct {
+	s *Service
+}
+
+// script return the command of nebula.service with the action, the config file of non-default instance is specified
+func (d *ScriptDaemon) script(action string) *Command {
+	args := make([]string, 0, 4)
+	if d.s.conf != "" {
+		args = append(args, "-c", d.s.conf)
+	}
+	return &Command{
+		Binary: "scripts/nebula.service",
+		Args:   append(args, action, string(d.s.name)),
+		Dir:    d.s.dir,
+	}
+}
+
+// Start only check the scripts return code. And when return code is 0,
+// it does not mean the service has must been started successfully,
+// use ReadyChecker to wait the service to be healthy
+func (d *ScriptDaemon) Start() error {
+	if _, err := d.script("start").Run(context.Background()); err != nil {
 		log.WithError(err).Errorf("Start %s failed", d.s.name)
 		return err
 	}
 	return nil
 }
 
-func (d *ServiceDaemon) Stop() error {
-	cmdStr := fmt.Sprintf("cd %s && scripts/nebula.service stop %s", d.s.dir, d.s.name)
-	log.WithField("cmd", cmdStr).Debug("Try to stop service...")
-	cmd := exec.Command("bash", "-c", cmdStr)
-	err := cmd.Run()
-	if err != nil {
+func (d *ScriptDaemon) Stop() error {
+	if _, err := d.script("stop").Run(context.Background()); err != nil {
 		log.WithError(err).Errorf("Stop %s failed", d.s.name)
 		return err
 	}
 	return nil
 }
 
-func (d *ServiceDaemon) Status() (pb.Status, error) {
-	cmdStr := fmt.Sprintf("cd %s && scripts/nebula.service status %s", d.s.dir, d.s.name)
-	log.WithField("cmd", cmdStr).Debug("Try to get service's status.")
-	cmd := exec.Command("bash", "-c", cmdStr)
-	outByte, err := cmd.Output()
+func (d *ScriptDaemon) Status() (*pb.ServiceStatusResponse, error) {
+	status, err := d.status()
+	return &pb.ServiceStatusResponse{Status: status, ExitCode: -1}, err
+}
+
+func (d *ScriptDaemon) status() (pb.Status, error) {
+	res, err := d.script("status").Run(context.Background())
 	if err != nil {
 		log.WithError(err).Errorf("Get status of service %s failed", d.s.name)
 		return pb.Status_UNKNOWN_STATUS, err
 	}
 
 	// Note: depend on the nebula scripts output now.
-	outStr := string(outByte)
+	outStr := res.Stdout
 
 	// an example: [INFO] nebula-graphd(46b2aac66): Exited
 	if strings.Contains(outStr, "Exit") {
@@ -1,6 +1,8 @@
 package clients
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,11 +12,13 @@ import (
 func TestDaemon(t *testing.T) {
 	assert := assert.New(t)
 	rootDir := "/tmp/nebula-install"
+	allowRoots(t, rootDir)
 	startReq := &pb.StartServiceRequest{
 		Role: pb.ServiceRole_STORAGE,
 		Dir:  rootDir,
 	}
-	s := FromStartReq(startReq)
+	s, err := FromStartReq(startReq)
+	assert.Nil(err)
 	assert.Equal(s.name, ServiceName_Storaged)
 	assert.Equal(s.dir, rootDir)
 	d, err := NewDaemon(s)
@@ -24,11 +28,71 @@ func TestDaemon(t *testing.T) {
 	// bad role format
 	startReq = &pb.StartServiceRequest{
 		Role: pb.ServiceRole(100),
+		Dir:  rootDir,
 	}
-	s = FromStartReq(startReq)
+	s, err = FromStartReq(startReq)
+	assert.Nil(err)
 	assert.Equal(s.name, ServiceName_Unknown)
 
-	// Empty dir
-	_, err = NewDaemon(s)
+	// empty dir or the one out of the allowed roots
+	_, err = FromStartReq(&pb.StartServiceRequest{Role: pb.ServiceRole_STORAGE})
+	assert.NotNil(err)
+	_, err = FromStartReq(&pb.StartServiceRequest{Role: pb.ServiceRole_STORAGE, Dir: "/etc"})
+	assert.NotNil(err)
+}
+
+func TestExpandRoles(t *testing.T) {
+	assert := assert.New(t)
+
+	s := fakeService(t, "exit 0\n")
+	roles, err := ExpandRoles(s.dir, []pb.ServiceRole{pb.ServiceRole_ALL})
+	assert.Nil(err)
+	assert.Equal([]pb.ServiceRole{pb.ServiceRole_GRAPH}, roles)
+
+	roles, err = ExpandRoles(s.dir, []pb.ServiceRole{pb.ServiceRole_GRAPH, pb.ServiceRole_META, pb.ServiceRole_STORAGE})
+	assert.Nil(err)
+	assert.Equal([]pb.ServiceRole{pb.ServiceRole_META, pb.ServiceRole_STORAGE, pb.ServiceRole_GRAPH}, roles)
+
+	_, err = ExpandRoles(t.TempDir(), []pb.ServiceRole{pb.ServiceRole_ALL})
+	assert.NotNil(err)
+	_, err = ExpandRoles(s.dir, []pb.ServiceRole{pb.ServiceRole(100)})
+	assert.NotNil(err)
+}
+
+func TestServiceInstance(t *testing.T) {
+	assert := assert.New(t)
+
+	s := fakeService(t, "exit 0\n")
+	conf := filepath.Join(s.dir, "etc", "nebula-graphd-2.conf")
+	assert.Nil(os.WriteFile(conf, []byte("--port=9670\n--pid_file=pids/nebula-graphd-2.pid\n"), 0644))
+
+	def, err := NewService(pb.ServiceRole_GRAPH, s.dir, "9669")
+	assert.Nil(err)
+	assert.Equal(filepath.Join(s.dir, "etc", "nebula-graphd.conf"), def.flagFile())
+	assert.Equal("nebula-graphd", def.instanceName())
+
+	for _, instance := range []string{"9670", "etc/nebula-graphd-2.conf", conf} {
+		other, err := NewService(pb.ServiceRole_GRAPH, s.dir, instance)
+		assert.Nil(err)
+		assert.Equal(conf, other.flagFile())
+		assert.Equal("nebula-graphd-2", other.instanceName())
+	}
+
+	_, err = NewService(pb.ServiceRole_GRAPH, s.dir, "9999")
+	assert.NotNil(err)
+	_, err = NewService(pb.ServiceRole_GRAPH, s.dir, "etc/nebula-graphd-3.conf")
 	assert.NotNil(err)
+	// only the config files in etc dir
+	assert.Nil(os.WriteFile(filepath.Join(s.dir, "nebula-graphd.conf"), nil, 0644))
+	for _, instance := range []string{"nebula-graphd.conf", "etc/../nebula-graphd.conf", "/etc/shadow", "etc/nebula-graphd-2.pid"} {
+		_, err = NewService(pb.ServiceRole_GRAPH, s.dir, instance)
+		assert.NotNil(err, instance)
+	}
+	assert.Nil(os.Symlink("/etc/hostname", filepath.Join(s.dir, "etc", "escape.conf")))
+	_, err = NewService(pb.ServiceRole_GRAPH, s.dir, "etc/escape.conf")
+	assert.NotNil(err)
+
+	hb := &Service{name: ServiceName_Graphd, dir: s.dir}
+	hb.matchAddr("192.168.8.1:9670")
+	assert.Equal(conf, hb.flagFile())
 }
@@ -0,0 +1,514 @@
+package clients
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const (
+	defaultDiagnosticsWindow   = time.Hour
+	defaultDiagnosticsMaxBytes = 256 << 20
+	// the latest lines of each log file are kept within the bytes
+	maxDiagnosticsLogBytes = 32 << 20
+	// the tail of the rocksdb LOG of each space
+	rocksdbLogSampleBytes = 1 << 20
+	redactedValue         = "<redacted>"
+	// the bundles kept in {dir}/diagnostics, the oldest ones are removed
+	maxKeptDiagnostics = 5
+	diagnosticsExt     = ".tar.gz"
+)
+
+// the values of the secret like flags or fields, such as --password=xxx, "secret_key": "xxx"
+var secretPattern = regexp.MustCompile(`(?i)([\w.-]*(?:password|passwd|secret|token|credential|access_key|private_key)[\w.-]*"?\s*[=:]\s*)("[^"]*"|'[^']*'|[^\s,;&]+)`)
+
+// redact replace the secret values in the text
+func redact(text string) string {
+	return secretPattern.ReplaceAllString(text, "${1}"+redactedValue)
+}
+
+// Diagnostics collect the logs, configs, runtime states and core files of the service into a tar.gz bundle
+type Diagnostics struct {
+	s        *Service
+	start    time.Time
+	end      time.Time
+	maxBytes int64
+
+	name    string
+	tw      *tar.Writer
+	written int64
+	files   []string
+	skipped []string
+}
+
+func NewDiagnostics(s *Service, req *pb.CollectDiagnosticsRequest) *Diagnostics {
+	d := &Diagnostics{
+		s:        s,
+		end:      time.Now(),
+		maxBytes: req.GetMaxBytes(),
+	}
+	if req.GetEndTime() > 0 {
+		d.end = time.Unix(req.GetEndTime(), 0)
+	}
+	d.start = d.end.Add(-defaultDiagnosticsWindow)
+	if req.GetStartTime() > 0 {
+		d.start = time.Unix(req.GetStartTime(), 0)
+	}
+	if d.maxBytes <= 0 {
+		d.maxBytes = defaultDiagnosticsMaxBytes
+	}
+
+	host, _ := os.Hostname()
+	d.name = fmt.Sprintf("%s-%s-%s", s.instanceName(), host, time.Now().Format("20060102150405"))
+	return d
+}
+
+// Name return the bundle name, which is also the top dir in the bundle
+func (d *Diagnostics) Name() string {
+	return d.name
+}
+
+// Collect write the bundle into {dir}/diagnostics, the missing parts are recorded as skipped
+func (d *Diagnostics) Collect() (*pb.CollectDiagnosticsResponse, error) {
+	path := filepath.Join(d.s.dir, "diagnostics", d.name+diagnosticsExt)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	d.tw = tar.NewWriter(gz)
+
+	// the missing config file is recorded by collectConf
+	flags, err := d.s.flags()
+	if err != nil {
+		flags = map[string]string{}
+	}
+	// the important and small ones first, so they are kept when exceeding the size cap
+	for _, collect := range []func(map[string]string) error{
+		d.collectConf,
+		d.collectFlags,
+		d.collectHost,
+		d.collectProc,
+		d.collectLayout,
+		d.collectLogs,
+		d.collectRocksdbLogs,
+		d.collectCores,
+	} {
+		if err := collect(flags); err != nil {
+			os.Remove(path)
+			return nil, err
+		}
+	}
+
+	if err = d.tw.Close(); err == nil {
+		err = gz.Close()
+	}
+	if err == nil {
+		err = f.Close()
+	}
+	if err != nil {
+		os.Remove(path)
+		return nil, fmt.Errorf("write diagnostics bundle failed: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	pruneBundles(filepath.Dir(path), maxKeptDiagnostics)
+	log.WithField("path", path).WithField("files", len(d.files)).Info("Collect diagnostics bundle.")
+	return &pb.CollectDiagnosticsResponse{
+		Path:        path,
+		BundleBytes: info.Size(),
+		Files:       d.files,
+		Skipped:     d.skipped,
+	}, nil
+}
+
+// pruneBundles remove the oldest bundles in the dir until at most keep ones are left
+func pruneBundles(dir string, keep int) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		log.WithError(err).WithField("dir", dir).Warn("List diagnostics bundles failed.")
+		return
+	}
+	type bundle struct {
+		path  string
+		mtime time.Time
+	}
+	bundles := make([]bundle, 0, len(entries))
+	for _, e := range entries {
+		if !e.Type().IsRegular() || !strings.HasSuffix(e.Name(), diagnosticsExt) {
+			continue
+		}
+		if info, err := e.Info(); err == nil {
+			bundles = append(bundles, bundle{path: filepath.Join(dir, e.Name()), mtime: info.ModTime()})
+		}
+	}
+	sort.SliceStable(bundles, func(i, j int) bool { return bundles[i].mtime.Before(bundles[j].mtime) })
+
+	for i := 0; i < len(bundles)-keep; i++ {
+		if err := os.Remove(bundles[i].path); err != nil {
+			log.WithError(err).WithField("path", bundles[i].path).Warn("Remove old diagnostics bundle failed.")
+		}
+	}
+}
+
+func (d *Diagnostics) skip(name string, reason interface{}) {
+	d.skipped = append(d.skipped, fmt.Sprintf("%s: %v", name, reason))
+}
+
+// fit check the bytes are in the size cap, and record the skipped file if not
+func (d *Diagnostics) fit(name string, n int64) bool {
+	if d.written+n > d.maxBytes {
+		d.skip(name, fmt.Sprintf("%d bytes exceed the size cap", n))
+		return false
+	}
+	return true
+}
+
+// addBytes write the data as a file in bundle, the errors of writing bundle are returned
+func (d *Diagnostics) addBytes(name string, data []byte) error {
+	if !d.fit(name, int64(len(data))) {
+		return nil
+	}
+	hdr := &tar.Header{Name: d.name + "/" + name, Mode: 0644, Size: int64(len(data)), ModTime: time.Now()}
+	if err := d.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := d.tw.Write(data); err != nil {
+		return err
+	}
+	d.written += int64(len(data))
+	d.files = append(d.files, name)
+	return nil
+}
+
+// addFile copy the file into bundle as it is, used for the binary files
+func (d *Diagnostics) addFile(name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		d.skip(name, err)
+		return nil
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		d.skip(name, err)
+		return nil
+	}
+	if !d.fit(name, info.Size()) {
+		return nil
+	}
+
+	hdr := &tar.Header{Name: d.name + "/" + name, Mode: 0644, Size: info.Size(), ModTime: info.ModTime()}
+	if err = d.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err = io.CopyN(d.tw, f, info.Size()); err != nil {
+		return err
+	}
+	d.written += info.Size()
+	d.files = append(d.files, name)
+	return nil
+}
+
+func (d *Diagnostics) collectConf(flags map[string]string) error {
+	data, err := os.ReadFile(d.s.flagFile())
+	if err != nil {
+		d.skip("conf", err)
+		return nil
+	}
+	return d.addBytes("conf/"+filepath.Base(d.s.flagFile()), []byte(redact(string(data))))
+}
+
+// collectFlags dump the runtime flags of the running service
+func (d *Diagnostics) collectFlags(flags map[string]string) error {
+	c, err := NewFlagsClient(d.s)
+	if err == nil {
+		var values []*pb.FlagValue
+		if values, err = c.Get(nil); err == nil {
+			buf := &bytes.Buffer{}
+			for _, f := range values {
+				fmt.Fprintf(buf, "%s=%s\n", f.Name, flagText(f))
+			}
+			return d.addBytes("flags.txt", []byte(redact(buf.String())))
+		}
+	}
+	d.skip("flags.txt", err)
+	return nil
+}
+
+func flagText(f *pb.FlagValue) string {
+	switch v := f.Value.(type) {
+	case *pb.FlagValue_StringValue:
+		return v.StringValue
+	case *pb.FlagValue_BoolValue:
+		return fmt.Sprint(v.BoolValue)
+	case *pb.FlagValue_IntValue:
+		return fmt.Sprint(v.IntValue)
+	case *pb.FlagValue_DoubleValue:
+		return fmt.Sprint(v.DoubleValue)
+	default:
+		return ""
+	}
+}
+
+func (d *Diagnostics) dataDirs(flags map[string]string) []string {
+	dirs := make([]string, 0)
+	for _, p := range strings.Split(flags["data_path"], ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(d.s.dir, p)
+		}
+		dirs = append(dirs, p)
+	}
+	return dirs
+}
+
+func (d *Diagnostics) collectHost(flags map[string]string) error {
+	info, err := CollectHostInfo(append([]string{d.s.dir}, d.dataDirs(flags)...))
+	if err != nil {
+		d.skip("host.json", err)
+		return nil
+	}
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+	return d.addBytes("host.json", data)
+}
+
+// collectProc copy the /proc files of the machine and the service process
+func (d *Diagnostics) collectProc(flags map[string]string) error {
+	files := map[string]string{
+		"proc/loadavg": "/proc/loadavg",
+		"proc/meminfo": "/proc/meminfo",
+		"proc/mounts":  "/proc/mounts",
+	}
+	if daemon, err := NewDaemon(d.s); err == nil {
+		if st, err := daemon.Status(); err == nil && st.Pid > 0 {
+			for _, f := range []string{"status", "limits", "cmdline", "io"} {
+				files["proc/self/"+f] = fmt.Sprintf("/proc/%d/%s", st.Pid, f)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		// the size of /proc files are unknown until read
+		data, err := os.ReadFile(files[name])
+		if err != nil {
+			d.skip(name, err)
+			continue
+		}
+		text := strings.ReplaceAll(string(data), "\x00", " ")
+		if err = d.addBytes(name, []byte(redact(text))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectLayout list the dirs in data paths with their files count and bytes, the files are not listed
+func (d *Diagnostics) collectLayout(flags map[string]string) error {
+	buf := &bytes.Buffer{}
+	for _, root := range d.dataDirs(flags) {
+		type dirSum struct {
+			files int
+			bytes int64
+		}
+		sums := make(map[string]*dirSum)
+		dirs := make([]string, 0)
+		err := filepath.WalkDir(root, func(path string, e fs.DirEntry, err error) error {
+			if err != nil {
+				return nil
+			}
+			if e.IsDir() {
+				sums[path] = &dirSum{}
+				dirs = append(dirs, path)
+				return nil
+			}
+			if info, err := e.Info(); err == nil {
+				sum := sums[filepath.Dir(path)]
+				sum.files++
+				sum.bytes += info.Size()
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, dir := range dirs {
+			fmt.Fprintf(buf, "%12d %6d %s\n", sums[dir].bytes, sums[dir].files, dir)
+		}
+	}
+	if buf.Len() == 0 {
+		d.skip("layout.txt", "no data path")
+		return nil
+	}
+	return d.addBytes("layout.txt", append([]byte("       bytes  files dir\n"), buf.Bytes()...))
+}
+
+// collectLogs add the lines in time window of the service's log files, the lines without
+// glog prefix follow the previous line
+func (d *Diagnostics) collectLogs(flags map[string]string) error {
+	logDir, err := d.s.logDir()
+	if err != nil {
+		d.skip("logs", err)
+		return nil
+	}
+	entries, err := os.ReadDir(logDir)
+	if err != nil {
+		d.skip("logs", err)
+		return nil
+	}
+
+	prefixes := []string{d.s.processName(), d.s.instanceName(),
+		flagOr(flags, "stdout_log_file", "stdout.log"), flagOr(flags, "stderr_log_file", "stderr.log")}
+	for _, e := range entries {
+		// the symlinks such as nebula-storaged.INFO point to the collected files
+		if !e.Type().IsRegular() {
+			continue
+		}
+		matched := false
+		for _, p := range prefixes {
+			matched = matched || strings.HasPrefix(e.Name(), p)
+		}
+		info, err := e.Info()
+		if !matched || err != nil || info.ModTime().Before(d.start) {
+			continue
+		}
+
+		data, err := d.filterLog(filepath.Join(logDir, e.Name()))
+		if err != nil {
+			d.skip("logs/"+e.Name(), err)
+			continue
+		}
+		if len(data) == 0 {
+			continue
+		}
+		if err = d.addBytes("logs/"+e.Name(), data); err != nil {
+			return err
+		}
+	}
+
+	// the output of native supervisor before logging set up
+	out := filepath.Join(d.s.dir, "logs", d.s.instanceName()+".out")
+	if dir, err := filepath.EvalSymlinks(filepath.Dir(out)); err == nil && dir != logDir {
+		if data, err := d.filterLog(out); err == nil && len(data) != 0 {
+			return d.addBytes("logs/"+filepath.Base(out), data)
+		}
+	}
+	return nil
+}
+
+// filterLog return the redacted lines in the time window, only the latest ones are kept in the bytes limit
+func (d *Diagnostics) filterLog(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	buf := &bytes.Buffer{}
+	include := true
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16<<20)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if ts, ok := glogTime(line); ok {
+			include = !ts.Before(d.start) && !ts.After(d.end)
+		}
+		if !include {
+			continue
+		}
+		buf.WriteString(redact(line))
+		buf.WriteByte('\n')
+		if buf.Len() > 2*maxDiagnosticsLogBytes {
+			buf = bytes.NewBuffer(append([]byte{}, buf.Bytes()[buf.Len()-maxDiagnosticsLogBytes:]...))
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	data := buf.Bytes()
+	if len(data) > maxDiagnosticsLogBytes {
+		data = data[len(data)-maxDiagnosticsLogBytes:]
+	}
+	return data, nil
+}
+
+// collectRocksdbLogs add the tail of rocksdb LOG of each space
+func (d *Diagnostics) collectRocksdbLogs(flags map[string]string) error {
+	for i, dir := range d.dataDirs(flags) {
+		files, _ := filepath.Glob(filepath.Join(SpacesDir(dir), "*", "data", "LOG"))
+		for _, f := range files {
+			space := filepath.Base(filepath.Dir(filepath.Dir(f)))
+			name := fmt.Sprintf("rocksdb/%d/%s/LOG", i, space)
+			data, err := readTail(f, rocksdbLogSampleBytes)
+			if err != nil {
+				d.skip(name, err)
+				continue
+			}
+			if err = d.addBytes(name, data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readTail return the last n bytes of the file
+func readTail(path string, n int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > n {
+		if _, err = f.Seek(info.Size()-n, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+	return io.ReadAll(io.LimitReader(f, n))
+}
+
+func (d *Diagnostics) collectCores(flags map[string]string) error {
+	for _, c := range findCoreFiles(d.s.dir, time.Time{}) {
+		if err := d.addFile("cores/"+filepath.Base(c), c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package clients
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestRedact(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("--password=<redacted>\n--port=9669", redact("--password=nebula\n--port=9669"))
+	assert.Equal(`{"secret_key": <redacted>, "region": "us"}`, redact(`{"secret_key": "abc", "region": "us"}`))
+	assert.Equal("connect with token: <redacted> done", redact("connect with token: abc done"))
+}
+
+func TestDiagnostics(t *testing.T) {
+	assert := assert.New(t)
+	s := fakeService(t, "")
+	conf := "--log_dir=logs\n--data_path=data/graph\n--cloud_password=abc\n"
+	assert.Nil(os.WriteFile(s.flagFile(), []byte(conf), 0644))
+	write := func(name, data string) {
+		path := filepath.Join(s.dir, name)
+		assert.Nil(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(os.WriteFile(path, []byte(data), 0644))
+	}
+	now := time.Now()
+	line := func(ts time.Time, msg string) string {
+		return "I" + ts.Format("20060102 15:04:05") + ".000000 123 main.cpp:1] " + msg + "\n"
+	}
+	write("logs/nebula-graphd.host.log.INFO.1", line(now.Add(-2*time.Hour), "old")+line(now.Add(-time.Minute), "new")+"  continued\n")
+	write("logs/other.log", "not collected\n")
+	write("data/graph/nebula/1/data/LOG", "rocksdb log\n")
+	write("core.123", string(make([]byte, 1<<20)))
+	for i := 0; i < maxKeptDiagnostics; i++ {
+		write(fmt.Sprintf("diagnostics/old-%d.tar.gz", i), "")
+		mtime := now.Add(time.Duration(i-maxKeptDiagnostics) * time.Hour)
+		assert.Nil(os.Chtimes(filepath.Join(s.dir, "diagnostics", fmt.Sprintf("old-%d.tar.gz", i)), mtime, mtime))
+	}
+
+	d := NewDiagnostics(s, &pb.CollectDiagnosticsRequest{MaxBytes: 512 << 10})
+	resp, err := d.Collect()
+	assert.Nil(err)
+	assert.Contains(resp.Skipped, "cores/core.123: 1048576 bytes exceed the size cap")
+	// the oldest bundle is removed
+	bundles, err := filepath.Glob(filepath.Join(s.dir, "diagnostics", "*.tar.gz"))
+	assert.Nil(err)
+	assert.Len(bundles, maxKeptDiagnostics)
+	assert.NotContains(bundles, filepath.Join(s.dir, "diagnostics", "old-0.tar.gz"))
+	assert.Contains(bundles, resp.Path)
+
+	files := make(map[string]string)
+	f, err := os.Open(resp.Path)
+	assert.Nil(err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	assert.Nil(err)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(err)
+		data, err := io.ReadAll(tr)
+		assert.Nil(err)
+		files[hdr.Name] = string(data)
+	}
+
+	prefix := d.Name() + "/"
+	assert.Contains(files[prefix+"conf/nebula-graphd.conf"], "--cloud_password=<redacted>")
+	assert.Equal(line(now.Add(-time.Minute), "new")+"  continued\n", files[prefix+"logs/nebula-graphd.host.log.INFO.1"])
+	assert.NotContains(files, prefix+"logs/other.log")
+	assert.Equal("rocksdb log\n", files[prefix+"rocksdb/0/1/LOG"])
+	assert.Contains(files[prefix+"layout.txt"], filepath.Join(s.dir, "data/graph/nebula/1/data"))
+	assert.Len(files, len(resp.Files))
+}
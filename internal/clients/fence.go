@@ -0,0 +1,347 @@
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vesoft-inc/fbthrift/thrift/lib/go/thrift"
+	"github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/storage"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const (
+	fenceStateFileName = "ban.json"
+	// the default max_allowed_connections of graphd
+	defaultMaxConnections = "9223372036854775807"
+
+	// what the bans block, reported in status
+	BlockedWrites         = "writes"
+	BlockedNewConnections = "new_connections"
+)
+
+// Ban blocks the writes of local storaged or the new connections of local graphd, persisted in the state dir
+type Ban struct {
+	Role  pb.ServiceRole `json:"role"`
+	Addr  string         `json:"addr"`
+	Since int64          `json:"since"`
+	// PrevMaxConnections is the max_allowed_connections of graphd before banned
+	PrevMaxConnections string `json:"prev_max_connections,omitempty"`
+}
+
+func banKey(role pb.ServiceRole, addr string) string {
+	return role.String() + "/" + addr
+}
+
+// Fence blocks the writes of the local services during restore:
+// storaged is blocked writing by its admin interface, and graphd is set to accept no new connection.
+// The reads of storaged and the existing sessions of graphd are not blocked.
+// The bans are persisted and reapplied periodically, so they survive the restart of agent and services.
+type Fence struct {
+	meta *NebulaMeta
+	path string
+
+	mu   sync.Mutex
+	bans map[string]*Ban
+	errs map[string]error
+
+	// applyFn could be replaced in test
+	applyFn func(b *Ban, on bool) error
+}
+
+func NewFence(meta *NebulaMeta, stateDir string) (*Fence, error) {
+	f := &Fence{
+		meta: meta,
+		path: filepath.Join(stateDir, fenceStateFileName),
+		bans: make(map[string]*Ban),
+		errs: make(map[string]error),
+	}
+	f.applyFn = f.apply
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return f, nil
+		}
+		return nil, err
+	}
+	bans := make([]*Ban, 0)
+	if err = json.Unmarshal(data, &bans); err != nil {
+		return nil, fmt.Errorf("parse fence state %s failed: %w", f.path, err)
+	}
+	for _, b := range bans {
+		f.bans[banKey(b.Role, b.Addr)] = b
+	}
+	log.WithField("bans", len(bans)).Info("Load bans.")
+	return f, nil
+}
+
+// save persist the bans, should be called with lock
+func (f *Fence) save() error {
+	bans := make([]*Ban, 0, len(f.bans))
+	for _, b := range f.bans {
+		bans = append(bans, b)
+	}
+	data, err := json.MarshalIndent(bans, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(f.path, data, 0644)
+}
+
+// resolve find the local service of the ban, and fill its addr if empty
+func (f *Fence) resolve(role pb.ServiceRole, addr string) (*Service, error) {
+	if role != pb.ServiceRole_STORAGE && role != pb.ServiceRole_GRAPH {
+		return nil, fmt.Errorf("ban of %s is not supported", role)
+	}
+	return f.meta.FindLocalService(role, addr)
+}
+
+func (f *Fence) Ban(role pb.ServiceRole, addr string) error {
+	s, err := f.resolve(role, addr)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	k := banKey(role, s.addr)
+	b, ok := f.bans[k]
+	if !ok {
+		b = &Ban{Role: role, Addr: s.addr, Since: time.Now().Unix()}
+	}
+	err = f.applyFn(b, true)
+	f.errs[k] = err
+	if err != nil {
+		return fmt.Errorf("ban %s %s failed: %w", role, s.addr, err)
+	}
+
+	f.bans[k] = b
+	log.WithField("role", role).WithField("addr", s.addr).Info("Ban service.")
+	return f.save()
+}
+
+func (f *Fence) Allow(role pb.ServiceRole, addr string) error {
+	s, err := f.resolve(role, addr)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	k := banKey(role, s.addr)
+	b, ok := f.bans[k]
+	if !ok {
+		// the settings changed by others should not be overwritten
+		log.WithField("role", role).WithField("addr", s.addr).Info("Not banned, nothing to allow.")
+		return nil
+	}
+	if err = f.applyFn(b, false); err != nil {
+		f.errs[k] = err
+		return fmt.Errorf("lift the ban of %s %s failed: %w", role, s.addr, err)
+	}
+
+	delete(f.bans, k)
+	delete(f.errs, k)
+	log.WithField("role", role).WithField("addr", s.addr).Info("Lift the ban of service.")
+	return f.save()
+}
+
+// Reapply apply all bans again, used after the services restarted
+func (f *Fence) Reapply() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	changed := false
+	for k, b := range f.bans {
+		prev := b.PrevMaxConnections
+		err := f.applyFn(b, true)
+		if err != nil {
+			log.WithError(err).WithField("ban", k).Warn("Reapply ban failed.")
+		}
+		f.errs[k] = err
+		changed = changed || prev != b.PrevMaxConnections
+	}
+	if changed {
+		if err := f.save(); err != nil {
+			log.WithError(err).Error("Save bans failed.")
+		}
+	}
+}
+
+// Run reapply the bans periodically until ctx done
+func (f *Fence) Run(ctx context.Context, interval time.Duration) {
+	f.Reapply()
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			f.Reapply()
+		}
+	}
+}
+
+func (f *Fence) Status() []*pb.ReadWriteBan {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := make([]*pb.ReadWriteBan, 0, len(f.bans))
+	for k, b := range f.bans {
+		rb := &pb.ReadWriteBan{
+			Role:    b.Role,
+			Addr:    b.Addr,
+			Since:   b.Since,
+			Applied: f.errs[k] == nil,
+			Blocked: BlockedWrites,
+		}
+		if b.Role == pb.ServiceRole_GRAPH {
+			rb.Blocked = BlockedNewConnections
+		}
+		if err := f.errs[k]; err != nil {
+			rb.Error = err.Error()
+		}
+		res = append(res, rb)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return banKey(res[i].Role, res[i].Addr) < banKey(res[j].Role, res[j].Addr)
+	})
+	return res
+}
+
+func (f *Fence) apply(b *Ban, on bool) error {
+	s, err := f.meta.FindLocalService(b.Role, b.Addr)
+	if err != nil {
+		return err
+	}
+
+	switch b.Role {
+	case pb.ServiceRole_STORAGE:
+		return f.blockWrites(s, on)
+	case pb.ServiceRole_GRAPH:
+		return f.limitConnections(s, b, on)
+	default:
+		return fmt.Errorf("ban of %s is not supported", b.Role)
+	}
+}
+
+// blockWrites block the writes of all spaces in storaged by its admin service
+func (f *Fence) blockWrites(s *Service, on bool) error {
+	spaces, err := f.meta.listSpaces()
+	if err != nil {
+		return err
+	}
+	req := &storage.BlockingSignRequest{Sign: storage.EngineSignType_BLOCK_OFF}
+	if on {
+		req.Sign = storage.EngineSignType_BLOCK_ON
+	}
+	for _, sp := range spaces {
+		req.SpaceIds = append(req.SpaceIds, sp.GetId().GetSpaceID())
+	}
+	if len(req.SpaceIds) == 0 {
+		return nil
+	}
+
+	addr, err := utils.ParseAddr(s.addr)
+	if err != nil {
+		return err
+	}
+	// the admin port of storaged is its port minus 1
+	admin := &nebula.HostAddr{Host: addr.Host, Port: addr.Port - 1}
+	c, err := connectStorageAdmin(admin, f.meta.config.TLSConfig)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	resp, err := c.BlockingWrites(req)
+	if err != nil {
+		return err
+	}
+	if resp.GetCode() != nebula.ErrorCode_SUCCEEDED {
+		return fmt.Errorf("blocking writes failed: %s", resp.GetCode().String())
+	}
+	return nil
+}
+
+// limitConnections set the max_allowed_connections of graphd to 0 when banned, and restore it when allowed.
+// The sessions already connected are not closed.
+func (f *Fence) limitConnections(s *Service, b *Ban, on bool) error {
+	c, err := NewFlagsClient(s)
+	if err != nil {
+		return err
+	}
+
+	if !on {
+		prev := b.PrevMaxConnections
+		if prev == "" {
+			prev = defaultMaxConnections
+		}
+		return c.Set(map[string]string{"max_allowed_connections": prev})
+	}
+
+	if b.PrevMaxConnections == "" {
+		flags, err := c.Get([]string{"max_allowed_connections"})
+		if err != nil {
+			return err
+		}
+		for _, fv := range flags {
+			if fv.GetName() != "max_allowed_connections" {
+				continue
+			}
+			v, ok := fv.GetValue().(*pb.FlagValue_IntValue)
+			if !ok {
+				return fmt.Errorf("unexpected max_allowed_connections of graphd: %v", fv.GetValue())
+			}
+			// 0 is set by the ban itself, which is applied before the state saved
+			if v.IntValue != 0 {
+				b.PrevMaxConnections = strconv.FormatInt(v.IntValue, 10)
+			}
+		}
+	}
+	return c.Set(map[string]string{"max_allowed_connections": "0"})
+}
+
+func connectStorageAdmin(addr *nebula.HostAddr, tlsConfig *tls.Config) (*storage.StorageAdminServiceClient, error) {
+	hostPort := utils.StringifyAddr(addr)
+
+	var (
+		err  error
+		sock thrift.Transport
+	)
+	if tlsConfig != nil {
+		sock, err = thrift.NewSSLSocketTimeout(hostPort, tlsConfig, defaultTimeout)
+	} else {
+		sock, err = thrift.NewSocket(thrift.SocketTimeout(defaultTimeout), thrift.SocketAddr(hostPort))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open socket to storage admin %s failed: %w", hostPort, err)
+	}
+
+	bufferedTranFactory := thrift.NewBufferedTransportFactory(128 << 10)
+	transport := thrift.NewFramedTransport(bufferedTranFactory.GetTransport(sock))
+	pf := thrift.NewBinaryProtocolFactoryDefault()
+	client := storage.NewStorageAdminServiceClientFactory(transport, pf)
+	if err := client.CC.Open(); err != nil {
+		return nil, fmt.Errorf("open storage admin %s failed: %w", hostPort, err)
+	}
+	return client, nil
+}
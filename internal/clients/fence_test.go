@@ -0,0 +1,113 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/meta"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestFence(t *testing.T) {
+	assert := assert.New(t)
+	stateDir := t.TempDir()
+
+	m := &NebulaMeta{
+		services: map[string]*meta.ServiceInfo{
+			"192.168.0.1:9779": {
+				Addr: &nebula.HostAddr{Host: "192.168.0.1", Port: 9779},
+				Role: meta.HostRole_STORAGE,
+				Dir:  &nebula.DirInfo{Root: []byte("/usr/local/nebula")},
+			},
+		},
+	}
+	applied := make(map[string]bool)
+	fail := false
+	newFence := func() *Fence {
+		f, err := NewFence(m, stateDir)
+		assert.Nil(err)
+		f.applyFn = func(b *Ban, on bool) error {
+			if fail {
+				return fmt.Errorf("storaged is down")
+			}
+			applied[banKey(b.Role, b.Addr)] = on
+			return nil
+		}
+		return f
+	}
+
+	f := newFence()
+	assert.NotNil(f.Ban(pb.ServiceRole_META, ""))
+	assert.NotNil(f.Ban(pb.ServiceRole_GRAPH, ""))
+	assert.Nil(f.Ban(pb.ServiceRole_STORAGE, ""))
+	assert.True(applied["STORAGE/192.168.0.1:9779"])
+
+	// the ban survives agent restart and is reapplied
+	delete(applied, "STORAGE/192.168.0.1:9779")
+	f = newFence()
+	status := f.Status()
+	assert.Equal(1, len(status))
+	assert.Equal("192.168.0.1:9779", status[0].Addr)
+
+	fail = true
+	f.Reapply()
+	status = f.Status()
+	assert.False(status[0].Applied)
+	assert.Contains(status[0].Error, "storaged is down")
+
+	fail = false
+	f.Reapply()
+	assert.True(applied["STORAGE/192.168.0.1:9779"])
+	assert.True(f.Status()[0].Applied)
+
+	assert.Equal(BlockedWrites, f.Status()[0].Blocked)
+
+	assert.Nil(f.Allow(pb.ServiceRole_STORAGE, "192.168.0.1:9779"))
+	assert.False(applied["STORAGE/192.168.0.1:9779"])
+	assert.Empty(newFence().Status())
+
+	// nothing applied if not banned
+	delete(applied, "STORAGE/192.168.0.1:9779")
+	assert.Nil(f.Allow(pb.ServiceRole_STORAGE, "192.168.0.1:9779"))
+	assert.NotContains(applied, "STORAGE/192.168.0.1:9779")
+}
+
+func TestLimitConnections(t *testing.T) {
+	assert := assert.New(t)
+
+	flags := map[string]string{"max_allowed_connections": "1"}
+	ws := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			assert.Nil(json.NewDecoder(r.Body).Decode(&flags))
+			return
+		}
+		fmt.Fprintf(w, "max_allowed_connections=%s\n", flags["max_allowed_connections"])
+	}))
+	defer ws.Close()
+
+	s := fakeService(t, "")
+	conf := fmt.Sprintf("--ws_http_port=%d\n", ws.Listener.Addr().(*net.TCPAddr).Port)
+	assert.Nil(os.WriteFile(filepath.Join(s.dir, "etc", "nebula-graphd.conf"), []byte(conf), 0644))
+
+	f := &Fence{}
+	b := &Ban{Role: pb.ServiceRole_GRAPH}
+	assert.Nil(f.limitConnections(s, b, true))
+	assert.Equal("0", flags["max_allowed_connections"])
+	assert.Equal("1", b.PrevMaxConnections)
+
+	// reapplied
+	assert.Nil(f.limitConnections(s, b, true))
+	assert.Equal("1", b.PrevMaxConnections)
+
+	assert.Nil(f.limitConnections(s, b, false))
+	assert.Equal("1", flags["max_allowed_connections"])
+}
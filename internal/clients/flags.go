@@ -0,0 +1,120 @@
+package clients
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const flagsRequestTimeout = 10 * time.Second
+
+// FlagsClient get and set the runtime flags of the service through its http /flags
+type FlagsClient struct {
+	s        *Service
+	httpAddr string
+	client   *http.Client
+}
+
+func NewFlagsClient(s *Service) (*FlagsClient, error) {
+	flags, err := s.flags()
+	if err != nil {
+		return nil, err
+	}
+	return &FlagsClient{
+		s:        s,
+		httpAddr: s.httpAddr(flags),
+		client:   &http.Client{Timeout: flagsRequestTimeout},
+	}, nil
+}
+
+// parseFlagValue convert the value in /flags output to typed one, the string value is quoted, such as:
+//
+//	v=0
+//	enable_ssl=false
+//	local_ip="127.0.0.1"
+func parseFlagValue(name, v string) *pb.FlagValue {
+	f := &pb.FlagValue{Name: name}
+	if s, err := strconv.Unquote(v); err == nil {
+		f.Value = &pb.FlagValue_StringValue{StringValue: s}
+	} else if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+		f.Value = &pb.FlagValue_IntValue{IntValue: i}
+	} else if isBool(v) {
+		f.Value = &pb.FlagValue_BoolValue{BoolValue: v == "true"}
+	} else if d, err := strconv.ParseFloat(v, 64); err == nil {
+		f.Value = &pb.FlagValue_DoubleValue{DoubleValue: d}
+	} else {
+		f.Value = &pb.FlagValue_StringValue{StringValue: v}
+	}
+	return f
+}
+
+// Get return the flags of given names, all flags if names is empty
+func (c *FlagsClient) Get(names []string) ([]*pb.FlagValue, error) {
+	u := fmt.Sprintf("http://%s/flags", c.httpAddr)
+	if len(names) != 0 {
+		u += "?flags=" + url.QueryEscape(strings.Join(names, ","))
+	}
+
+	resp, err := c.client.Get(u)
+	if err != nil {
+		return nil, fmt.Errorf("get flags of %s failed: %w", c.s.name, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get flags of %s failed: %s, %s", c.s.name, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	flags := make([]*pb.FlagValue, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		k, v, found := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !found {
+			continue
+		}
+		flags = append(flags, parseFlagValue(k, v))
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags, nil
+}
+
+// Set change the flags at runtime, the flags not existing or immutable would be rejected by the service
+func (c *FlagsClient) Set(flags map[string]string) error {
+	body, err := json.Marshal(flags)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("http://%s/flags", c.httpAddr), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("set flags of %s failed: %w", c.s.name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("set flags of %s failed: %s, %s", c.s.name, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	log.WithField("flags", flags).Infof("Set flags of %s.", c.s.name)
+	return nil
+}
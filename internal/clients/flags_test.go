@@ -0,0 +1,58 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestFlagsClient(t *testing.T) {
+	assert := assert.New(t)
+
+	flags := map[string]string{"v": "0", "enable_ssl": "false", "local_ip": `"127.0.0.1"`, "ratio": "0.5", "max_allowed_connections": "1"}
+	ws := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			changes := make(map[string]string)
+			assert.Nil(json.NewDecoder(r.Body).Decode(&changes))
+			for k, v := range changes {
+				if _, ok := flags[k]; !ok {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				flags[k] = v
+			}
+			return
+		}
+		for k, v := range flags {
+			fmt.Fprintf(w, "%s=%s\n", k, v)
+		}
+	}))
+	defer ws.Close()
+
+	s := fakeService(t, "")
+	conf := fmt.Sprintf("--ws_http_port=%d\n", ws.Listener.Addr().(*net.TCPAddr).Port)
+	assert.Nil(os.WriteFile(filepath.Join(s.dir, "etc", "nebula-graphd.conf"), []byte(conf), 0644))
+
+	c, err := NewFlagsClient(s)
+	assert.Nil(err)
+	values, err := c.Get(nil)
+	assert.Nil(err)
+	assert.Equal(5, len(values))
+	assert.Equal(&pb.FlagValue{Name: "enable_ssl", Value: &pb.FlagValue_BoolValue{BoolValue: false}}, values[0])
+	assert.Equal("127.0.0.1", values[1].GetStringValue())
+	assert.Equal(&pb.FlagValue{Name: "max_allowed_connections", Value: &pb.FlagValue_IntValue{IntValue: 1}}, values[2])
+	assert.Equal(0.5, values[3].GetDoubleValue())
+	assert.Equal(&pb.FlagValue{Name: "v", Value: &pb.FlagValue_IntValue{IntValue: 0}}, values[4])
+
+	assert.Nil(c.Set(map[string]string{"v": "3"}))
+	assert.Equal("3", flags["v"])
+	assert.NotNil(c.Set(map[string]string{"not_exist": "1"}))
+}
@@ -0,0 +1,107 @@
+package clients
+
+import (
+	"os"
+	"runtime"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+// CollectHostInfo collect the resources of agent machine from /proc and syscalls,
+// the usage of the mounts containing the dirs are reported
+func CollectHostInfo(dirs []string) (*pb.GetHostInfoResponse, error) {
+	info := &pb.GetHostInfoResponse{
+		CpuCount: int32(runtime.NumCPU()),
+		TimeMs:   time.Now().UnixMilli(),
+	}
+
+	var err error
+	if info.Hostname, err = os.Hostname(); err != nil {
+		return nil, err
+	}
+	avg, err := utils.LoadAvg()
+	if err != nil {
+		return nil, err
+	}
+	info.LoadAvg = avg[:]
+	mem, err := utils.MemInfo()
+	if err != nil {
+		return nil, err
+	}
+	info.MemTotalBytes = mem["MemTotal"]
+	info.MemAvailableBytes = mem["MemAvailable"]
+
+	if info.NofileSoftLimit, info.NofileHardLimit, err = utils.NofileLimit(); err != nil {
+		return nil, err
+	}
+	// allocated, unused and max file handles
+	if nr, err := utils.ReadProcValues("/proc/sys/fs/file-nr"); err == nil && len(nr) == 3 {
+		info.FileAllocated, info.FileMax = nr[0], nr[2]
+	}
+	if info.KernelVersion, err = utils.KernelVersion(); err != nil {
+		return nil, err
+	}
+	if info.ClockOffsetUs, info.ClockSynchronized, err = utils.ClockOffset(); err != nil {
+		log.WithError(err).Debug("Get clock offset failed.")
+	}
+
+	info.Mounts, err = mountUsages(dirs)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// mountUsages group the dirs by their mounts, and return the usage of each mount
+func mountUsages(dirs []string) ([]*pb.MountUsage, error) {
+	mounts, err := utils.Mounts()
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make(map[string]*pb.MountUsage)
+	for _, d := range dirs {
+		if _, err := os.Stat(d); err != nil {
+			log.WithError(err).WithField("dir", d).Warn("Dir of service not found.")
+			continue
+		}
+		m := utils.FindMount(mounts, d)
+		if m == nil {
+			log.WithField("dir", d).Warn("Mount of dir not found.")
+			continue
+		}
+		if u, ok := usages[m.Point]; ok {
+			u.Dirs = append(u.Dirs, d)
+			continue
+		}
+
+		// stat the dir instead of the mount point which may be invisible in container
+		du, err := utils.StatDisk(d)
+		if err != nil {
+			log.WithError(err).WithField("dir", d).Warn("Stat disk of dir failed.")
+			continue
+		}
+		usages[m.Point] = &pb.MountUsage{
+			MountPoint:  m.Point,
+			Device:      m.Device,
+			FsType:      m.FsType,
+			Dirs:        []string{d},
+			TotalBytes:  du.TotalBytes,
+			FreeBytes:   du.FreeBytes,
+			TotalInodes: du.TotalInodes,
+			FreeInodes:  du.FreeInodes,
+		}
+	}
+
+	res := make([]*pb.MountUsage, 0, len(usages))
+	for _, u := range usages {
+		res = append(res, u)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].MountPoint < res[j].MountPoint })
+	return res, nil
+}
@@ -0,0 +1,25 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectHostInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	dir1, dir2 := t.TempDir(), t.TempDir()
+	info, err := CollectHostInfo([]string{dir1, dir2, "/not/exist/dir"})
+	assert.Nil(err)
+	assert.Greater(info.CpuCount, int32(0))
+	assert.Len(info.LoadAvg, 3)
+	assert.Greater(info.MemTotalBytes, info.MemAvailableBytes)
+	assert.NotEmpty(info.KernelVersion)
+	assert.Greater(info.NofileHardLimit, uint64(0))
+
+	// the temp dirs are in the same mount
+	assert.Len(info.Mounts, 1)
+	assert.Equal([]string{dir1, dir2}, info.Mounts[0].Dirs)
+	assert.Greater(info.Mounts[0].TotalBytes, uint64(0))
+}
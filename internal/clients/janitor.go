@@ -0,0 +1,346 @@
+package clients
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+	"github.com/vesoft-inc/nebula-agent/v3/pkg/storage"
+)
+
+const (
+	defaultJanitorInterval = time.Hour
+
+	LogCompressed = "compressed"
+	LogArchived   = "archived"
+	LogRemoved    = "removed"
+)
+
+// LoadLogRetentionConfig read the retention policies from the json file, such as:
+//
+//	{"intervalSeconds": 600, "default": {"maxAgeSeconds": 604800}, "roles": {"storaged": {"maxTotalBytes": 10737418240}}}
+func LoadLogRetentionConfig(path string) (*pb.LogRetentionConfig, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cfg := &pb.LogRetentionConfig{}
+	if err = jsonpb.Unmarshal(f, cfg); err != nil {
+		return nil, fmt.Errorf("parse log retention config %s failed: %w", path, err)
+	}
+	for role := range cfg.Roles {
+		if _, ok := defaultPorts[ServiceName(role)]; !ok {
+			return nil, fmt.Errorf("unknown role %s in log retention config", role)
+		}
+	}
+	return cfg, nil
+}
+
+// logFile is a glog file of the service in the log dir
+type logFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+	// being written by the service, which is never touched
+	active bool
+}
+
+// LogJanitor compress, archive and remove the glog files of the local services by the retention policies
+type LogJanitor struct {
+	cfg      *pb.LogRetentionConfig
+	services func() []*Service
+
+	// runMu serializes the cleanups, mu protects the reports
+	runMu   sync.Mutex
+	mu      sync.Mutex
+	reports []*pb.LogCleanReport
+}
+
+func NewLogJanitor(cfg *pb.LogRetentionConfig, services func() []*Service) *LogJanitor {
+	return &LogJanitor{
+		cfg:      cfg,
+		services: services,
+	}
+}
+
+func (j *LogJanitor) Config() *pb.LogRetentionConfig {
+	return j.cfg
+}
+
+// Reports return the reports of the last run
+func (j *LogJanitor) Reports() []*pb.LogCleanReport {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.reports
+}
+
+func (j *LogJanitor) Run(ctx context.Context) {
+	interval := time.Duration(j.cfg.GetIntervalSeconds()) * time.Second
+	if interval <= 0 {
+		interval = defaultJanitorInterval
+	}
+	log.WithField("interval", interval).Info("Start log janitor.")
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			j.Clean(ctx, false)
+		}
+	}
+}
+
+func (j *LogJanitor) policy(s *Service) *pb.LogRetentionPolicy {
+	if p, ok := j.cfg.GetRoles()[string(s.name)]; ok {
+		return p
+	}
+	return j.cfg.GetDefault()
+}
+
+// Clean apply the policies to the local services once, the instances of the same role sharing
+// a log dir are cleaned together
+func (j *LogJanitor) Clean(ctx context.Context, dryRun bool) []*pb.LogCleanReport {
+	j.runMu.Lock()
+	defer j.runMu.Unlock()
+
+	reports := make([]*pb.LogCleanReport, 0)
+	seen := make(map[string]bool)
+	for _, s := range j.services() {
+		p := j.policy(s)
+		if p == nil {
+			continue
+		}
+		logDir, err := s.logDir()
+		if err != nil {
+			log.WithError(err).WithField("service", serviceKey(s)).Debug("Resolve log dir failed in log janitor.")
+			continue
+		}
+		k := logDir + "/" + s.processName()
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+
+		r := &pb.LogCleanReport{
+			Role:   toRole(s.name),
+			LogDir: logDir,
+			Time:   time.Now().Unix(),
+			DryRun: dryRun,
+		}
+		// the log dir is taken from the config file, never clean the files out of the allowed roots
+		if err = ValidatePath(logDir); err == nil {
+			err = cleanLogs(ctx, s.processName(), logDir, p, r)
+		}
+		if err != nil {
+			r.Error = err.Error()
+			log.WithError(err).WithField("dir", logDir).Warnf("Clean logs of %s failed.", s.name)
+		}
+		if len(r.Actions) != 0 {
+			log.WithField("dir", logDir).WithField("actions", len(r.Actions)).WithField("freed_bytes", r.FreedBytes).
+				WithField("dry_run", dryRun).Infof("Clean logs of %s.", s.name)
+		}
+		reports = append(reports, r)
+	}
+
+	if !dryRun {
+		j.mu.Lock()
+		j.reports = reports
+		j.mu.Unlock()
+	}
+	return reports
+}
+
+// listLogs return the glog files of the process in the log dir sorted by modification time,
+// such as nebula-storaged.host.user.log.INFO.20230101-120000.1234
+func listLogs(process, logDir string) ([]*logFile, error) {
+	entries, err := os.ReadDir(logDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// the symlinks such as nebula-storaged.INFO point to the files being written,
+	// compared by file info since the log dir itself may be a symlink
+	active := make([]os.FileInfo, 0)
+	files := make([]*logFile, 0)
+	infos := make([]os.FileInfo, 0)
+	for _, e := range entries {
+		if !strings.HasPrefix(e.Name(), process+".") {
+			continue
+		}
+		path := filepath.Join(logDir, e.Name())
+		if e.Type()&os.ModeSymlink != 0 {
+			if info, err := os.Stat(path); err == nil {
+				active = append(active, info)
+			}
+			continue
+		}
+		if !e.Type().IsRegular() || !strings.Contains(e.Name(), ".log.") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, &logFile{path: path, size: info.Size(), modTime: info.ModTime()})
+		infos = append(infos, info)
+	}
+
+	for i, f := range files {
+		for _, a := range active {
+			if os.SameFile(infos[i], a) {
+				f.active = true
+			}
+		}
+	}
+	sort.Slice(files, func(i, k int) bool { return files[i].modTime.Before(files[k].modTime) })
+	return files, nil
+}
+
+// cleanLogs compress the old files first, then remove the expired files and the oldest ones exceeding the limits
+func cleanLogs(ctx context.Context, process, logDir string, p *pb.LogRetentionPolicy, r *pb.LogCleanReport) error {
+	files, err := listLogs(process, logDir)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	if p.GetCompressAfterSeconds() > 0 {
+		threshold := now.Add(-time.Duration(p.GetCompressAfterSeconds()) * time.Second)
+		for _, f := range files {
+			if f.active || strings.HasSuffix(f.path, ".gz") || !f.modTime.Before(threshold) {
+				continue
+			}
+			a := &pb.LogFileAction{File: f.path, Action: LogCompressed, Bytes: f.size, Reason: "compress_after_seconds"}
+			if !r.DryRun {
+				size, err := gzipFile(f.path)
+				if err != nil {
+					a.Error = err.Error()
+					r.Actions = append(r.Actions, a)
+					continue
+				}
+				r.FreedBytes += f.size - size
+				f.path, f.size = f.path+".gz", size
+			}
+			r.Actions = append(r.Actions, a)
+		}
+	}
+
+	var sto storage.ExternalStorage
+	if p.GetArchive() != nil {
+		if sto, err = storage.New(p.GetArchive()); err != nil {
+			return err
+		}
+	}
+
+	var total int64
+	for _, f := range files {
+		total += f.size
+	}
+	count := len(files)
+	maxAge := time.Duration(p.GetMaxAgeSeconds()) * time.Second
+	for _, f := range files {
+		var reason string
+		switch {
+		case f.active:
+			continue
+		case maxAge > 0 && now.Sub(f.modTime) > maxAge:
+			reason = "max_age_seconds"
+		case p.GetMaxTotalBytes() > 0 && total > p.GetMaxTotalBytes():
+			reason = "max_total_bytes"
+		case p.GetMaxFiles() > 0 && int32(count) > p.GetMaxFiles():
+			reason = "max_files"
+		default:
+			continue
+		}
+
+		if sto != nil {
+			host, _ := os.Hostname()
+			uri := storage.JoinUri(p.GetArchive().Uri(), host, filepath.Base(f.path))
+			a := &pb.LogFileAction{File: f.path, Action: LogArchived, Bytes: f.size, Reason: reason, Uri: uri}
+			r.Actions = append(r.Actions, a)
+			if !r.DryRun {
+				if err := sto.Upload(ctx, uri, f.path, false); err != nil {
+					a.Error = err.Error()
+					continue
+				}
+			}
+		}
+
+		a := &pb.LogFileAction{File: f.path, Action: LogRemoved, Bytes: f.size, Reason: reason}
+		r.Actions = append(r.Actions, a)
+		if !r.DryRun {
+			if err := os.Remove(f.path); err != nil {
+				a.Error = err.Error()
+				continue
+			}
+		}
+		r.FreedBytes += f.size
+		total -= f.size
+		count--
+	}
+
+	r.RemainingBytes = total
+	r.RemainingFiles = int32(count)
+	return nil
+}
+
+// gzipFile compress the file into {path}.gz with the same modification time and remove it,
+// return the compressed size
+func gzipFile(path string) (int64, error) {
+	in, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	gzPath := path + ".gz"
+	out, err := os.OpenFile(gzPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return 0, err
+	}
+	gz := gzip.NewWriter(out)
+	gz.Name = filepath.Base(path)
+	gz.ModTime = info.ModTime()
+	_, err = io.Copy(gz, in)
+	if err == nil {
+		err = gz.Close()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chtimes(gzPath, info.ModTime(), info.ModTime())
+	}
+	if err != nil {
+		os.Remove(gzPath)
+		return 0, fmt.Errorf("compress %s failed: %w", path, err)
+	}
+
+	gzInfo, err := os.Stat(gzPath)
+	if err != nil {
+		return 0, err
+	}
+	if err = os.Remove(path); err != nil {
+		return 0, err
+	}
+	return gzInfo.Size(), nil
+}
@@ -0,0 +1,134 @@
+package clients
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestLoadLogRetentionConfig(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "retention.json")
+	data := `{"intervalSeconds": 600, "default": {"maxAgeSeconds": "604800"}, "roles": {"storaged": {"maxTotalBytes": 1024, "maxFiles": 3}}}`
+	assert.Nil(os.WriteFile(path, []byte(data), 0644))
+
+	cfg, err := LoadLogRetentionConfig(path)
+	assert.Nil(err)
+	assert.EqualValues(600, cfg.IntervalSeconds)
+	assert.EqualValues(604800, cfg.Default.MaxAgeSeconds)
+	assert.EqualValues(1024, cfg.Roles["storaged"].MaxTotalBytes)
+	assert.EqualValues(3, cfg.Roles["storaged"].MaxFiles)
+
+	assert.Nil(os.WriteFile(path, []byte(`{"roles": {"unknown": {}}}`), 0644))
+	_, err = LoadLogRetentionConfig(path)
+	assert.NotNil(err)
+}
+
+func TestLogJanitor(t *testing.T) {
+	assert := assert.New(t)
+	s := fakeService(t, "")
+	logDir := filepath.Join(s.dir, "logs")
+	assert.Nil(os.MkdirAll(logDir, 0755))
+
+	now := time.Now()
+	write := func(name string, age time.Duration) {
+		path := filepath.Join(logDir, name)
+		assert.Nil(os.WriteFile(path, make([]byte, 4096), 0644))
+		assert.Nil(os.Chtimes(path, now.Add(-age), now.Add(-age)))
+	}
+	write("nebula-graphd.host.user.log.INFO.1", 10*24*time.Hour)
+	write("nebula-graphd.host.user.log.INFO.2", 3*24*time.Hour)
+	write("nebula-graphd.host.user.log.INFO.3", 2*time.Hour)
+	write("nebula-graphd.host.user.log.INFO.4", 3*24*time.Hour)
+	write("nebula-metad.host.user.log.INFO.1", 10*24*time.Hour)
+	assert.Nil(os.Symlink("nebula-graphd.host.user.log.INFO.4", filepath.Join(logDir, "nebula-graphd.INFO")))
+
+	cfg := &pb.LogRetentionConfig{
+		Roles: map[string]*pb.LogRetentionPolicy{
+			"graphd": {MaxAgeSeconds: 7 * 24 * 3600, CompressAfterSeconds: 24 * 3600, MaxFiles: 2},
+		},
+	}
+	j := NewLogJanitor(cfg, func() []*Service { return []*Service{s, s} })
+
+	// the dry run only reports the actions
+	reports := j.Clean(context.Background(), true)
+	assert.Len(reports, 1)
+	assert.Empty(j.Reports())
+	r := reports[0]
+	assert.Equal("", r.Error)
+	actions := make([]string, 0)
+	for _, a := range r.Actions {
+		actions = append(actions, filepath.Base(a.File)+" "+a.Action+" "+a.Reason)
+	}
+	assert.Equal([]string{
+		"nebula-graphd.host.user.log.INFO.1 compressed compress_after_seconds",
+		"nebula-graphd.host.user.log.INFO.2 compressed compress_after_seconds",
+		"nebula-graphd.host.user.log.INFO.1 removed max_age_seconds",
+		"nebula-graphd.host.user.log.INFO.2 removed max_files",
+	}, actions)
+	assert.EqualValues(2, r.RemainingFiles)
+	assert.FileExists(filepath.Join(logDir, "nebula-graphd.host.user.log.INFO.1"))
+
+	reports = j.Clean(context.Background(), false)
+	assert.Len(reports, 1)
+	assert.Equal(reports, j.Reports())
+	assert.Equal("", reports[0].Error)
+	assert.EqualValues(2, reports[0].RemainingFiles)
+	assert.EqualValues(2*4096, reports[0].RemainingBytes)
+
+	entries, err := os.ReadDir(logDir)
+	assert.Nil(err)
+	names := make([]string, 0)
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	// the active file is kept even if it is old enough to be compressed
+	assert.Equal([]string{
+		"nebula-graphd.INFO",
+		"nebula-graphd.host.user.log.INFO.3",
+		"nebula-graphd.host.user.log.INFO.4",
+		"nebula-metad.host.user.log.INFO.1",
+	}, names)
+
+	// the active file is found through the symlinked log dir
+	link := filepath.Join(s.dir, "logs-link")
+	assert.Nil(os.Symlink(logDir, link))
+	files, err := listLogs("nebula-graphd", link)
+	assert.Nil(err)
+	assert.Len(files, 2)
+	for _, f := range files {
+		assert.Equal(strings.HasSuffix(f.path, "INFO.4"), f.active, f.path)
+	}
+
+	// the log dir out of the allowed roots is not cleaned
+	outside := t.TempDir()
+	assert.Nil(os.WriteFile(s.flagFile(), []byte("--log_dir="+outside+"\n"), 0644))
+	reports = j.Clean(context.Background(), false)
+	assert.Len(reports, 1)
+	assert.NotEmpty(reports[0].Error)
+}
+
+func TestGzipFile(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "nebula-graphd.host.user.log.INFO.1")
+	assert.Nil(os.WriteFile(path, make([]byte, 4096), 0644))
+	mtime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	assert.Nil(os.Chtimes(path, mtime, mtime))
+
+	size, err := gzipFile(path)
+	assert.Nil(err)
+	assert.NoFileExists(path)
+	info, err := os.Stat(path + ".gz")
+	assert.Nil(err)
+	assert.Equal(info.Size(), size)
+	assert.True(info.ModTime().Equal(mtime))
+}
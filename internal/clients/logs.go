@@ -0,0 +1,301 @@
+package clients
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const (
+	defaultTailLines = 100
+	maxLinesPerSend  = 256
+	followInterval   = 500 * time.Millisecond
+)
+
+// glog line prefix, such as: I20230101 12:00:00.123456 or I0101 12:00:00.123456
+var glogPrefix = regexp.MustCompile(`^[IWEF](\d{4}|\d{8}) (\d{2}:\d{2}:\d{2})`)
+
+// glogTime return the time in the glog line prefix, false if the line has no prefix
+func glogTime(line string) (time.Time, bool) {
+	m := glogPrefix.FindStringSubmatch(line)
+	if m == nil {
+		return time.Time{}, false
+	}
+	date := m[1]
+	if len(date) == 4 {
+		// the year is not logged by old glog
+		date = fmt.Sprintf("%d%s", time.Now().Year(), date)
+	}
+	ts, err := time.ParseInLocation("20060102 15:04:05", date+" "+m[2], time.Local)
+	return ts, err == nil
+}
+
+// logDir return the real path of the service's log dir
+func (s *Service) logDir() (string, error) {
+	flags, err := s.flags()
+	if err != nil {
+		return "", err
+	}
+	dir := flags["log_dir"]
+	if dir == "" {
+		dir = "logs"
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(s.dir, dir)
+	}
+	return filepath.EvalSymlinks(dir)
+}
+
+// logFile return the log file name of the kind in the log dir, which may be a symlink
+func (s *Service) logFile(kind pb.LogKind, flags map[string]string) (string, error) {
+	switch kind {
+	case pb.LogKind_LOG_INFO:
+		return s.processName() + ".INFO", nil
+	case pb.LogKind_LOG_WARNING:
+		return s.processName() + ".WARNING", nil
+	case pb.LogKind_LOG_ERROR:
+		return s.processName() + ".ERROR", nil
+	case pb.LogKind_LOG_STDOUT:
+		return flagOr(flags, "stdout_log_file", "stdout.log"), nil
+	case pb.LogKind_LOG_STDERR:
+		return flagOr(flags, "stderr_log_file", "stderr.log"), nil
+	default:
+		return "", fmt.Errorf("unknown log kind: %s", kind)
+	}
+}
+
+// followedLog is a log file being read
+type followedLog struct {
+	kind    pb.LogKind
+	name    string
+	path    string // resolved real path
+	offset  int64
+	partial string
+	// include is the since filter result of the last timestamped line, inherited by the lines without timestamp
+	include bool
+}
+
+// LogTailer reads the glog files and stdout/stderr of the service, only the files in the log dir are allowed
+type LogTailer struct {
+	s      *Service
+	logDir string
+	logs   []*followedLog
+
+	lines  int
+	follow bool
+	grep   *regexp.Regexp
+	since  time.Time
+}
+
+func NewLogTailer(s *Service, req *pb.TailLogsRequest) (*LogTailer, error) {
+	flags, err := s.flags()
+	if err != nil {
+		return nil, err
+	}
+	logDir, err := s.logDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve log dir of %s failed: %w", s.name, err)
+	}
+
+	t := &LogTailer{
+		s:      s,
+		logDir: logDir,
+		lines:  int(req.GetLines()),
+		follow: req.GetFollow(),
+	}
+	if t.lines <= 0 {
+		t.lines = defaultTailLines
+	}
+	if req.GetGrep() != "" {
+		if t.grep, err = regexp.Compile(req.GetGrep()); err != nil {
+			return nil, fmt.Errorf("bad grep pattern: %w", err)
+		}
+	}
+	if req.GetSince() > 0 {
+		t.since = time.Unix(req.GetSince(), 0)
+	}
+
+	kinds := req.GetKinds()
+	if len(kinds) == 0 {
+		kinds = []pb.LogKind{pb.LogKind_LOG_INFO}
+	}
+	for _, k := range kinds {
+		name, err := s.logFile(k, flags)
+		if err != nil {
+			return nil, err
+		}
+		t.logs = append(t.logs, &followedLog{kind: k, name: name, include: true})
+	}
+	return t, nil
+}
+
+// resolve return the real path of the log file, and check it's in the log dir
+func (t *LogTailer) resolve(name string) (string, error) {
+	p, err := filepath.EvalSymlinks(filepath.Join(t.logDir, name))
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(t.logDir, p)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", fmt.Errorf("log file %s is out of log dir %s", p, t.logDir)
+	}
+	return p, nil
+}
+
+// match check the line by the grep and since filters
+func (t *LogTailer) match(l *followedLog, line string) bool {
+	if !t.since.IsZero() {
+		if ts, ok := glogTime(line); ok {
+			l.include = !ts.Before(t.since)
+		}
+		if !l.include {
+			return false
+		}
+	}
+	return t.grep == nil || t.grep.MatchString(line)
+}
+
+func (t *LogTailer) send(l *followedLog, lines []string, send func(*pb.TailLogsResponse) error) error {
+	matched := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if t.match(l, line) {
+			matched = append(matched, line)
+		}
+	}
+
+	for len(matched) > 0 {
+		n := len(matched)
+		if n > maxLinesPerSend {
+			n = maxLinesPerSend
+		}
+		err := send(&pb.TailLogsResponse{Kind: l.kind, File: l.path, Lines: matched[:n]})
+		if err != nil {
+			return err
+		}
+		matched = matched[n:]
+	}
+	return nil
+}
+
+// read send the complete lines from the offset to the end of the file
+func (t *LogTailer) read(l *followedLog, send func(*pb.TailLogsResponse) error) error {
+	path, err := t.resolve(l.name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	// the symlink is switched to a new file when glog rotates
+	if path != l.path {
+		l.path, l.offset, l.partial = path, 0, ""
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() < l.offset {
+		// truncated
+		l.offset, l.partial = 0, ""
+	}
+	if info.Size() == l.offset {
+		return nil
+	}
+	if _, err = f.Seek(l.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	lines := make([]string, 0)
+	r := bufio.NewReader(io.LimitReader(f, info.Size()-l.offset))
+	for {
+		s, err := r.ReadString('\n')
+		l.offset += int64(len(s))
+		if err == io.EOF {
+			l.partial += s
+			break
+		}
+		if err != nil {
+			return err
+		}
+		lines = append(lines, l.partial+strings.TrimRight(s, "\n"))
+		l.partial = ""
+
+		if len(lines) >= maxLinesPerSend {
+			if err = t.send(l, lines, send); err != nil {
+				return err
+			}
+			lines = lines[:0]
+		}
+	}
+	return t.send(l, lines, send)
+}
+
+// start send the last lines of the log, or the whole log filtered by the since time
+func (t *LogTailer) start(l *followedLog, send func(*pb.TailLogsResponse) error) error {
+	if !t.since.IsZero() {
+		return t.read(l, send)
+	}
+
+	path, err := t.resolve(l.name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	lines, err := utils.TailFile(path, t.lines)
+	if err != nil {
+		return err
+	}
+
+	l.path, l.offset = path, info.Size()
+	return t.send(l, lines, send)
+}
+
+// Run send the log lines, and keep following the new lines until ctx is done if follow is set
+func (t *LogTailer) Run(ctx context.Context, send func(*pb.TailLogsResponse) error) error {
+	for _, l := range t.logs {
+		if err := t.start(l, send); err != nil {
+			return fmt.Errorf("read %s log of %s failed: %w", l.kind, t.s.name, err)
+		}
+	}
+	if !t.follow {
+		return nil
+	}
+
+	ticker := time.NewTicker(followInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		for _, l := range t.logs {
+			if err := t.read(l, send); err != nil {
+				return fmt.Errorf("follow %s log of %s failed: %w", l.kind, t.s.name, err)
+			}
+		}
+	}
+}
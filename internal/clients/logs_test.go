@@ -0,0 +1,95 @@
+package clients
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestLogTailer(t *testing.T) {
+	assert := assert.New(t)
+
+	s := fakeService(t, "")
+	logDir := filepath.Join(s.dir, "logs")
+	assert.Nil(os.MkdirAll(logDir, 0755))
+	info1 := filepath.Join(logDir, "nebula-graphd.host.root.log.INFO.20230101-120000.1")
+	content := "I20230101 12:00:00.000000 1 main.cpp:1] starting\n" +
+		"I20230101 12:00:01.000000 1 main.cpp:2] loading\n" +
+		"  continuation of loading\n" +
+		"E20230101 12:00:02.000000 1 main.cpp:3] failed to connect\n"
+	assert.Nil(os.WriteFile(info1, []byte(content), 0644))
+	assert.Nil(os.Symlink(filepath.Base(info1), filepath.Join(logDir, "nebula-graphd.INFO")))
+
+	collect := func(req *pb.TailLogsRequest) []string {
+		tailer, err := NewLogTailer(s, req)
+		assert.Nil(err)
+		lines := make([]string, 0)
+		assert.Nil(tailer.Run(context.Background(), func(resp *pb.TailLogsResponse) error {
+			lines = append(lines, resp.Lines...)
+			return nil
+		}))
+		return lines
+	}
+
+	lines := collect(&pb.TailLogsRequest{Lines: 2})
+	assert.Equal([]string{"  continuation of loading", "E20230101 12:00:02.000000 1 main.cpp:3] failed to connect"}, lines)
+
+	lines = collect(&pb.TailLogsRequest{Grep: "connect|start"})
+	assert.Equal(2, len(lines))
+
+	since := time.Date(2023, 1, 1, 12, 0, 1, 0, time.Local).Unix()
+	lines = collect(&pb.TailLogsRequest{Since: since})
+	assert.Equal(3, len(lines))
+	assert.Equal("  continuation of loading", lines[1])
+
+	// the file out of log dir is not allowed
+	assert.Nil(os.WriteFile(filepath.Join(s.dir, "secret"), []byte("secret\n"), 0644))
+	assert.Nil(os.Symlink("../secret", filepath.Join(logDir, "stderr.log")))
+	tailer, err := NewLogTailer(s, &pb.TailLogsRequest{Kinds: []pb.LogKind{pb.LogKind_LOG_STDERR}})
+	assert.Nil(err)
+	assert.NotNil(tailer.Run(context.Background(), func(resp *pb.TailLogsResponse) error { return nil }))
+
+	// follow the new lines and the rotated file
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tailer, err = NewLogTailer(s, &pb.TailLogsRequest{Lines: 1, Follow: true})
+	assert.Nil(err)
+	var mu sync.Mutex
+	lines = make([]string, 0)
+	go tailer.Run(ctx, func(resp *pb.TailLogsResponse) error {
+		mu.Lock()
+		defer mu.Unlock()
+		lines = append(lines, resp.Lines...)
+		return nil
+	})
+	got := func(n int) func() bool {
+		return func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(lines) == n
+		}
+	}
+	assert.Eventually(got(1), 5*time.Second, 10*time.Millisecond)
+
+	f, err := os.OpenFile(info1, os.O_APPEND|os.O_WRONLY, 0644)
+	assert.Nil(err)
+	_, err = f.WriteString("I20230101 12:00:03.000000 1 main.cpp:4] retry\n")
+	assert.Nil(err)
+	assert.Nil(f.Close())
+	assert.Eventually(got(2), 5*time.Second, 10*time.Millisecond)
+
+	info2 := filepath.Join(logDir, "nebula-graphd.host.root.log.INFO.20230102-120000.1")
+	assert.Nil(os.WriteFile(info2, []byte("I20230102 12:00:00.000000 1 main.cpp:1] rotated\n"), 0644))
+	assert.Nil(os.Remove(filepath.Join(logDir, "nebula-graphd.INFO")))
+	assert.Nil(os.Symlink(filepath.Base(info2), filepath.Join(logDir, "nebula-graphd.INFO")))
+	assert.Eventually(got(3), 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	assert.Contains(lines[2], "rotated")
+	mu.Unlock()
+}
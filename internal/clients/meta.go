@@ -8,6 +8,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/vesoft-inc/fbthrift/thrift/lib/go/thrift"
+	"github.com/vesoft-inc/nebula-agent/v3/internal/metrics"
 	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
 	"github.com/vesoft-inc/nebula-go/v3/nebula"
 	"github.com/vesoft-inc/nebula-go/v3/nebula/meta"
@@ -48,8 +49,10 @@ func NewMetaConfig(agentAddr, metaAddr, gitSHA string, hbInterval int, tlsConfig
 // NebulaMeta is the client to communicate with nebula meta servie, such as heartbeat\getMetaInfo.
 // Through which, the agent could get services to monitor dynamically
 type NebulaMeta struct {
-	client *meta.MetaServiceClient
-	config *MetaConfig
+	// clientMu protects the client shared by heartbeat and rpc handlers
+	clientMu sync.Mutex
+	client   *meta.MetaServiceClient
+	config   *MetaConfig
 
 	mu       sync.RWMutex
 	services map[string]*meta.ServiceInfo
@@ -139,12 +142,19 @@ func (m *NebulaMeta) heartbeatLoop() {
 	}
 }
 
-func (m *NebulaMeta) heartbeat() error {
+func (m *NebulaMeta) heartbeat() (err error) {
+	defer func(start time.Time) {
+		metrics.ObserveHeartbeat(time.Since(start), err)
+	}(time.Now())
+
 	req := &meta.AgentHBReq{
 		Host:       m.config.AgentAddr,
 		GitInfoSha: []byte(m.config.GitInfoSHA),
 	}
 
+	m.clientMu.Lock()
+	defer m.clientMu.Unlock()
+
 	try := 1
 	// retry only when leader change
 	for {
@@ -161,6 +171,7 @@ func (m *NebulaMeta) heartbeat() error {
 		}
 
 		if resp.GetCode() == nebula.ErrorCode_SUCCEEDED {
+			metrics.SetMetaLeader(utils.StringifyAddr(m.config.MetaAddr))
 			m.refreshInfo(resp.GetServiceList())
 
 			m.mu.RLock()
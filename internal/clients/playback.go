@@ -1,15 +1,36 @@
 package clients
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"os/exec"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/job"
+	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
 	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
 )
 
+const (
+	playbackBinary = "bin/db_playback"
+	// the interval to report the last output line as job message
+	playbackProgressInterval = 5 * time.Second
+	// the time to wait after SIGTERM before killing the process group
+	playbackKillGrace = 10 * time.Second
+	playbackTailLines = 5
+)
+
 var pbtc *PlayBackTLSConfig
 
+// DefaultPlayBackTimeout is used when the request has no timeout, no limit if 0
+var DefaultPlayBackTimeout time.Duration
+
 type PlayBackTLSConfig struct {
 	CertPath   string
 	KeyPath    string
@@ -32,32 +53,135 @@ type ServicePlayBack struct {
 	dir      string
 	dataPath string
 	metaAddr string
+	timeout  time.Duration
 }
 
 func NewPlayBack(req *pb.DataPlayBackRequest) *ServicePlayBack {
+	timeout := DefaultPlayBackTimeout
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
 	return &ServicePlayBack{
 		dir:      req.Dir,
 		dataPath: req.DataPath,
 		metaAddr: req.MetaAddr,
+		timeout:  timeout,
 	}
 }
 
-func (p *ServicePlayBack) PlayBack() error {
-	cmdStr := fmt.Sprintf("cd %s && bin/db_playback --db_path=%s --playback_meta_server=%s", p.dir, p.dataPath, p.metaAddr)
-	if pbtc.EnableSSL {
-		cmdStr += fmt.Sprintf(" --enable_ssl=%t --cert_path=%s --key_path=%s --ca_path=%s", pbtc.EnableSSL, pbtc.CertPath, pbtc.KeyPath, pbtc.CAPath)
+func (p *ServicePlayBack) args() []string {
+	args := []string{"--db_path=" + p.dataPath, "--playback_meta_server=" + p.metaAddr}
+	if pbtc != nil && pbtc.EnableSSL {
+		args = append(args, "--enable_ssl=true", "--cert_path="+pbtc.CertPath, "--key_path="+pbtc.KeyPath, "--ca_path="+pbtc.CAPath)
 		if pbtc.ServerName != "" {
-			cmdStr += fmt.Sprintf(" --ssl_server_SAN=%s", pbtc.ServerName)
+			args = append(args, "--ssl_server_SAN="+pbtc.ServerName)
 		}
 	}
+	return args
+}
+
+// LogFile return the file keeping the output of the playback job
+func (p *ServicePlayBack) LogFile(jobId string) string {
+	return filepath.Join(p.dir, "logs", fmt.Sprintf("db_playback.%s.log", jobId))
+}
 
-	log.WithField("cmd", cmdStr).Info("Try to playback storage data...")
-	cmd := exec.Command("bash", "-c", cmdStr)
-	err := cmd.Run()
+// Run the db_playback in its own process group until it exits, the whole group is killed
+// when the job is cancelled or timed out
+func (p *ServicePlayBack) Run(ctx context.Context, j *job.Job) error {
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	if err := ValidatePath(p.dir); err != nil {
+		return err
+	}
+	logFile := p.LogFile(j.Info().Id)
+	if err := os.MkdirAll(filepath.Dir(logFile), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
 	if err != nil {
-		log.WithError(err).Errorf("Data playback failed")
 		return err
 	}
+	defer out.Close()
 
+	c := &Command{
+		Binary:  playbackBinary,
+		Args:    p.args(),
+		Dir:     p.dir,
+		Output:  out,
+		Setpgid: true,
+	}
+	log.WithField("cmd", c.String()).Info("Try to playback storage data...")
+	cmd, err := c.Start()
+	if err != nil {
+		return err
+	}
+	pid := int32(cmd.Process.Pid)
+	j.Update(func(info *pb.JobInfo) {
+		info.PlaybackResult = &pb.PlayBackResult{LogFile: logFile, Pid: pid, ExitCode: -1}
+	})
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+	ticker := time.NewTicker(playbackProgressInterval)
+	defer ticker.Stop()
+
+	killed := false
+	for running := true; running; {
+		select {
+		case err = <-exited:
+			running = false
+		case <-ticker.C:
+			// db_playback has no progress in its output, so only the message is reported
+			if lines, _ := utils.TailFile(logFile, 1); len(lines) != 0 {
+				j.Update(func(info *pb.JobInfo) {
+					info.Message = lines[len(lines)-1]
+				})
+			}
+		case <-ctx.Done():
+			err = killGroup(cmd.Process.Pid, exited)
+			killed, running = true, false
+		}
+	}
+
+	j.Update(func(info *pb.JobInfo) {
+		info.PlaybackResult = &pb.PlayBackResult{LogFile: logFile, Pid: pid, ExitCode: int32(cmd.ProcessState.ExitCode())}
+	})
+	switch {
+	case killed && errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return fmt.Errorf("db_playback not finished in %v, killed", p.timeout)
+	case killed:
+		return fmt.Errorf("db_playback killed: %w", ctx.Err())
+	case err != nil:
+		lines, _ := utils.TailFile(logFile, playbackTailLines)
+		log.WithError(err).Errorf("Data playback failed")
+		return fmt.Errorf("db_playback failed: %w, last output:\n%s", err, strings.Join(lines, "\n"))
+	}
+	log.WithField("log", logFile).Info("Data playback finished.")
 	return nil
 }
+
+// killGroup send SIGTERM to the process group, and SIGKILL if it does not exit in the grace,
+// return the result of wait
+func killGroup(pgid int, exited <-chan error) error {
+	log.WithField("pgid", pgid).Info("Stop db_playback.")
+	if err := syscall.Kill(-pgid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+		log.WithError(err).Warn("Send SIGTERM to db_playback failed.")
+	}
+	select {
+	case err := <-exited:
+		return err
+	case <-time.After(playbackKillGrace):
+	}
+
+	log.WithField("pgid", pgid).Warnf("db_playback does not exit in %v, kill it.", playbackKillGrace)
+	if err := syscall.Kill(-pgid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		log.WithError(err).Warn("Send SIGKILL to db_playback failed.")
+	}
+	return <-exited
+}
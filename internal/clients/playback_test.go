@@ -0,0 +1,51 @@
+package clients
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/job"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestPlayBack(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	allowRoots(t, dir)
+	assert.Nil(os.MkdirAll(filepath.Join(dir, "bin"), 0755))
+	// the child sleep should be killed together with the playback
+	script := "#!/bin/sh\necho \"$@\"\nif [ \"$1\" = --db_path=hang ]; then sleep 60 & echo $! > child.pid; wait; fi\necho done >&2\n"
+	assert.Nil(os.WriteFile(filepath.Join(dir, playbackBinary), []byte(script), 0755))
+
+	p := NewPlayBack(&pb.DataPlayBackRequest{Dir: dir, DataPath: "/data/storage", MetaAddr: "127.0.0.1:9559"})
+	info := job.Jobs.Submit(job.KindDataPlayBack, p.Run).Wait()
+	assert.Equal(pb.JobStatus_JOB_SUCCEEDED, info.Status, info.Error)
+	assert.Equal(int32(0), info.PlaybackResult.ExitCode)
+	data, err := os.ReadFile(p.LogFile(info.Id))
+	assert.Nil(err)
+	assert.Equal("--db_path=/data/storage --playback_meta_server=127.0.0.1:9559\ndone\n", string(data))
+
+	p = NewPlayBack(&pb.DataPlayBackRequest{Dir: dir, DataPath: "hang", TimeoutSeconds: 1})
+	info = job.Jobs.Submit(job.KindDataPlayBack, p.Run).Wait()
+	assert.Equal(pb.JobStatus_JOB_FAILED, info.Status)
+	assert.Contains(info.Error, "not finished in 1s")
+
+	j := job.Jobs.Submit(job.KindDataPlayBack, NewPlayBack(&pb.DataPlayBackRequest{Dir: dir, DataPath: "hang"}).Run)
+	time.Sleep(200 * time.Millisecond)
+	assert.Nil(job.Jobs.Cancel(j.Info().Id))
+	info = j.Wait()
+	assert.Equal(pb.JobStatus_JOB_CANCELLED, info.Status)
+	assert.Equal(int32(-1), info.PlaybackResult.ExitCode)
+
+	pid, err := os.ReadFile(filepath.Join(dir, "child.pid"))
+	assert.Nil(err)
+	time.Sleep(100 * time.Millisecond)
+	// the killed child may be left as zombie if the agent is not reaping
+	stat, err := os.ReadFile(filepath.Join("/proc", strings.TrimSpace(string(pid)), "stat"))
+	assert.True(os.IsNotExist(err) || strings.Contains(string(stat), ") Z "), string(stat))
+}
@@ -0,0 +1,169 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const (
+	readyCheckInterval = time.Second
+	readyProbeTimeout  = 2 * time.Second
+	notReadyLogLines   = 50
+)
+
+var ErrProcessExited = errors.New("process exited")
+
+// default rpc port and http port of the services when not set in the config file
+var defaultPorts = map[ServiceName][2]string{
+	ServiceName_Metad:    {"9559", "19559"},
+	ServiceName_Storaged: {"9779", "19779"},
+	ServiceName_Graphd:   {"9669", "19669"},
+}
+
+// NotReadyError means the service does not become healthy in the readiness timeout,
+// with the last lines of its log for diagnosing
+type NotReadyError struct {
+	Role    ServiceName
+	Reason  string
+	LogFile string
+	LogTail []string
+}
+
+func (e *NotReadyError) Error() string {
+	return fmt.Sprintf("%s is not ready: %s", e.Role, e.Reason)
+}
+
+// probeAddr return the address to probe, the wildcard address is probed by loopback
+func probeAddr(ip, port string) string {
+	if ip == "" || ip == "0.0.0.0" || ip == "::" {
+		ip = "127.0.0.1"
+	}
+	return net.JoinHostPort(ip, port)
+}
+
+func flagOr(flags map[string]string, k, def string) string {
+	if v, ok := flags[k]; ok && v != "" {
+		return v
+	}
+	return def
+}
+
+// rpcAddr return the local address to connect the service's rpc port
+func (s *Service) rpcAddr(flags map[string]string) string {
+	return probeAddr(flagOr(flags, "local_ip", ""), flagOr(flags, "port", defaultPorts[s.name][0]))
+}
+
+// httpAddr return the local address to connect the service's http port
+func (s *Service) httpAddr(flags map[string]string) string {
+	return probeAddr(flagOr(flags, "ws_ip", ""), flagOr(flags, "ws_http_port", defaultPorts[s.name][1]))
+}
+
+// ReadyChecker check if the service is healthy by its http /status and rpc port
+type ReadyChecker struct {
+	s      *Service
+	d      Daemon
+	client *http.Client
+
+	rpcAddr  string
+	httpAddr string
+	logFiles []string
+}
+
+func NewReadyChecker(s *Service, d Daemon) (*ReadyChecker, error) {
+	flags, err := s.flags()
+	if err != nil {
+		return nil, err
+	}
+	return &ReadyChecker{
+		s:        s,
+		d:        d,
+		client:   &http.Client{Timeout: readyProbeTimeout},
+		rpcAddr:  s.rpcAddr(flags),
+		httpAddr: s.httpAddr(flags),
+		logFiles: s.logFiles(flags),
+	}, nil
+}
+
+// Check return nil if the service is healthy, or the reason why not
+func (c *ReadyChecker) Check() error {
+	status, err := c.d.Status()
+	if err != nil {
+		return err
+	}
+	if status.Status == pb.Status_EXITED {
+		return fmt.Errorf("%w with code %d", ErrProcessExited, status.ExitCode)
+	}
+
+	resp, err := c.client.Get(fmt.Sprintf("http://%s/status", c.httpAddr))
+	if err != nil {
+		return fmt.Errorf("http status: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("http status: %s", resp.Status)
+	}
+	// an example: {"git_info_sha":"46b2aac66","status":"running"}
+	body := struct {
+		Status string `json:"status"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("http status: %w", err)
+	}
+	if body.Status != "running" {
+		return fmt.Errorf("http status: %s", body.Status)
+	}
+
+	conn, err := net.DialTimeout("tcp", c.rpcAddr, readyProbeTimeout)
+	if err != nil {
+		return fmt.Errorf("rpc port: %w", err)
+	}
+	conn.Close()
+	return nil
+}
+
+// Wait poll the service until healthy, return NotReadyError when timeout or the process exited
+func (c *ReadyChecker) Wait(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	log.WithField("http", c.httpAddr).WithField("rpc", c.rpcAddr).
+		Infof("Wait %s to be ready in %v.", c.s.name, timeout)
+	t := time.NewTicker(readyCheckInterval)
+	defer t.Stop()
+	for {
+		err := c.Check()
+		if err == nil {
+			log.Infof("Service %s is ready.", c.s.name)
+			return nil
+		}
+		log.WithError(err).Debugf("Service %s is not ready yet.", c.s.name)
+		if errors.Is(err, ErrProcessExited) {
+			return c.notReady(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return c.notReady(fmt.Errorf("timeout after %v, last error: %w", timeout, err))
+		case <-t.C:
+		}
+	}
+}
+
+func (c *ReadyChecker) notReady(cause error) error {
+	e := &NotReadyError{
+		Role:   c.s.name,
+		Reason: cause.Error(),
+	}
+
+	e.LogFile, e.LogTail = tailLogs(c.logFiles, notReadyLogLines)
+	return e
+}
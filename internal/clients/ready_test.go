@@ -0,0 +1,67 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+type fakeDaemon struct {
+	status pb.Status
+}
+
+func (d *fakeDaemon) Start() error { return nil }
+func (d *fakeDaemon) Stop() error  { return nil }
+func (d *fakeDaemon) Status() (*pb.ServiceStatusResponse, error) {
+	return &pb.ServiceStatusResponse{Status: d.status, ExitCode: 1}, nil
+}
+
+func TestReadyChecker(t *testing.T) {
+	assert := assert.New(t)
+
+	rpc, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(err)
+	defer rpc.Close()
+	status := "starting"
+	ws := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"git_info_sha":"46b2aac66","status":"%s"}`, status)
+	}))
+	defer ws.Close()
+
+	s := fakeService(t, "")
+	conf := fmt.Sprintf("--local_ip=127.0.0.1\n--port=%d\n--ws_http_port=%d\n--log_dir=logs\n",
+		rpc.Addr().(*net.TCPAddr).Port, ws.Listener.Addr().(*net.TCPAddr).Port)
+	assert.Nil(os.WriteFile(filepath.Join(s.dir, "etc", "nebula-graphd.conf"), []byte(conf), 0644))
+	assert.Nil(os.MkdirAll(filepath.Join(s.dir, "logs"), 0755))
+	assert.Nil(os.WriteFile(filepath.Join(s.dir, "logs", "nebula-graphd.INFO"), []byte("line1\nline2\n"), 0644))
+
+	d := &fakeDaemon{status: pb.Status_RUNNING}
+	c, err := NewReadyChecker(s, d)
+	assert.Nil(err)
+
+	// not ready until the http status is running
+	err = c.Wait(context.Background(), 1500*time.Millisecond)
+	var e *NotReadyError
+	assert.True(errors.As(err, &e))
+	assert.Equal([]string{"line1", "line2"}, e.LogTail)
+
+	status = "running"
+	assert.Nil(c.Wait(context.Background(), 3*time.Second))
+
+	// fail fast when the process exited
+	d.status = pb.Status_EXITED
+	start := time.Now()
+	err = c.Wait(context.Background(), 10*time.Second)
+	assert.True(errors.As(err, &e))
+	assert.Less(time.Since(start), 5*time.Second)
+}
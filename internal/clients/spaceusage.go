@@ -1,51 +1,254 @@
 package clients
 
 import (
+	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
 
 	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
 )
 
-type SpaceUsage struct {
-	DataPath string
+// the components in the space dir {dataPath}/{spaceId}, which are scanned in order,
+// so that the checkpoint files hard linked to data or wal are not counted again
+const (
+	componentData        = "data"
+	componentWal         = "wal"
+	componentCheckpoints = "checkpoints"
+)
+
+type fileKey struct {
+	dev uint64
+	ino uint64
+}
+
+type sstFile struct {
+	key  fileKey
+	size int64
+}
+
+// pathUsage is the cached scan result of a data path
+type pathUsage struct {
+	mu       sync.Mutex
+	scanTime time.Time
+	spaces   map[int64]*pb.GetSpaceUsagesResponse_SpaceUsageItem
+	// the sst files are immutable, their sizes are remembered to avoid stat in next scan
+	ssts map[string]*sstFile
 }
 
-func NewSpaceUsage(dataPath string) *SpaceUsage {
-	return &SpaceUsage{
-		DataPath: dataPath,
+// SpaceScanner scan the usages of spaces in storaged data paths, the results are cached per data path
+type SpaceScanner struct {
+	mu    sync.Mutex
+	paths map[string]*pathUsage
+}
+
+func NewSpaceScanner() *SpaceScanner {
+	return &SpaceScanner{
+		paths: make(map[string]*pathUsage),
 	}
 }
 
-func (s *SpaceUsage) GetSpaceUsages() (*pb.GetSpaceUsagesResponse, error) {
-	spaceUsages := make([]*pb.GetSpaceUsagesResponse_SpaceUsageItem, 0)
-	dirs, err := filepath.Glob(filepath.Join(s.DataPath, "*/"))
+func (s *SpaceScanner) get(dataPath string) *pathUsage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dataPath = filepath.Clean(dataPath)
+	u, ok := s.paths[dataPath]
+	if !ok {
+		u = &pathUsage{ssts: make(map[string]*sstFile)}
+		s.paths[dataPath] = u
+	}
+	return u
+}
+
+// GetSpaceUsages return the usages of spaces summed over the data paths,
+// the data path is rescanned only if its cache is older than maxAge
+func (s *SpaceScanner) GetSpaceUsages(dataPaths []string, maxAge time.Duration) (*pb.GetSpaceUsagesResponse, error) {
+	resp := &pb.GetSpaceUsagesResponse{}
+	spaces := make(map[int64]*pb.GetSpaceUsagesResponse_SpaceUsageItem)
+	parts := make(map[int64]map[int64]*pb.GetSpaceUsagesResponse_PartUsageItem)
+
+	for _, p := range dataPaths {
+		u := s.get(p)
+		u.mu.Lock()
+		if u.scanTime.IsZero() || time.Since(u.scanTime) > maxAge {
+			if err := u.scan(p); err != nil {
+				u.mu.Unlock()
+				return nil, err
+			}
+		}
+		if resp.ScanTime == 0 || u.scanTime.Unix() < resp.ScanTime {
+			resp.ScanTime = u.scanTime.Unix()
+		}
+
+		for id, item := range u.spaces {
+			sum, ok := spaces[id]
+			if !ok {
+				sum = &pb.GetSpaceUsagesResponse_SpaceUsageItem{Id: id}
+				spaces[id] = sum
+				parts[id] = make(map[int64]*pb.GetSpaceUsagesResponse_PartUsageItem)
+			}
+			sum.Usage += item.Usage
+			sum.DataBytes += item.DataBytes
+			sum.WalBytes += item.WalBytes
+			sum.CheckpointBytes += item.CheckpointBytes
+			sum.OtherBytes += item.OtherBytes
+			for _, part := range item.Parts {
+				if ps, ok := parts[id][part.Id]; ok {
+					ps.WalBytes += part.WalBytes
+				} else {
+					ps := *part
+					parts[id][part.Id] = &ps
+					sum.Parts = append(sum.Parts, &ps)
+				}
+			}
+		}
+		u.mu.Unlock()
+	}
+
+	for _, item := range spaces {
+		sort.Slice(item.Parts, func(i, j int) bool { return item.Parts[i].Id < item.Parts[j].Id })
+		resp.SpaceUsages = append(resp.SpaceUsages, item)
+	}
+	sort.Slice(resp.SpaceUsages, func(i, j int) bool { return resp.SpaceUsages[i].Id < resp.SpaceUsages[j].Id })
+	return resp, nil
+}
+
+// scan walk the space dirs in the data path, should be called with lock
+func (u *pathUsage) scan(dataPath string) error {
+	start := time.Now()
+	dirs, err := os.ReadDir(dataPath)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("read data path %s failed: %w", dataPath, err)
 	}
-	for _, dir := range dirs {
-		id, err := strconv.Atoi(filepath.Base(dir))
-		if err != nil || id == 0 {
+
+	spaces := make(map[int64]*pb.GetSpaceUsagesResponse_SpaceUsageItem)
+	ssts := make(map[string]*sstFile)
+	seen := make(map[fileKey]bool)
+	for _, d := range dirs {
+		id, err := strconv.ParseInt(d.Name(), 10, 64)
+		if err != nil || id == 0 || !d.IsDir() {
 			continue
 		}
+		item, err := u.scanSpace(filepath.Join(dataPath, d.Name()), ssts, seen)
+		if err != nil {
+			return err
+		}
+		item.Id = id
+		spaces[id] = item
+	}
+
+	u.spaces = spaces
+	u.ssts = ssts
+	u.scanTime = time.Now()
+	log.WithField("path", dataPath).WithField("ssts", len(ssts)).
+		Debugf("Scan space usages in %v.", time.Since(start))
+	return nil
+}
+
+func (u *pathUsage) scanSpace(dir string, ssts map[string]*sstFile, seen map[fileKey]bool) (*pb.GetSpaceUsagesResponse_SpaceUsageItem, error) {
+	item := &pb.GetSpaceUsagesResponse_SpaceUsageItem{}
+	parts := make(map[int64]*pb.GetSpaceUsagesResponse_PartUsageItem)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	rank := map[string]int{componentData: 0, componentWal: 1, componentCheckpoints: 3}
+	order := func(name string) int {
+		if r, ok := rank[name]; ok {
+			return r
+		}
+		return 2
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return order(entries[i].Name()) < order(entries[j].Name()) })
 
-		var size int64
-		err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-			if !info.IsDir() {
-				size += info.Size()
+	for _, e := range entries {
+		component := e.Name()
+		err = filepath.WalkDir(filepath.Join(dir, component), func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// the files may be removed by compaction or wal cleaning during scan
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
 			}
-			return err
+			if d.IsDir() {
+				return nil
+			}
+
+			f, err := u.stat(path, d, ssts)
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if seen[f.key] {
+				return nil
+			}
+			seen[f.key] = true
+
+			item.Usage += f.size
+			switch component {
+			case componentData:
+				item.DataBytes += f.size
+			case componentWal:
+				item.WalBytes += f.size
+				// wal/{partId}/{logId}.wal
+				rel, _ := filepath.Rel(filepath.Join(dir, component), path)
+				partDir, _, _ := strings.Cut(filepath.ToSlash(rel), "/")
+				if partId, err := strconv.ParseInt(partDir, 10, 64); err == nil {
+					p, ok := parts[partId]
+					if !ok {
+						p = &pb.GetSpaceUsagesResponse_PartUsageItem{Id: partId}
+						parts[partId] = p
+						item.Parts = append(item.Parts, p)
+					}
+					p.WalBytes += f.size
+				}
+			case componentCheckpoints:
+				item.CheckpointBytes += f.size
+			default:
+				item.OtherBytes += f.size
+			}
+			return nil
 		})
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("scan %s failed: %w", dir, err)
 		}
+	}
+	return item, nil
+}
 
-		spaceUsages = append(spaceUsages, &pb.GetSpaceUsagesResponse_SpaceUsageItem{
-			Id:    int64(id),
-			Usage: size,
-		})
+// stat return the size and inode of the file, the remembered sst files are not stat again
+func (u *pathUsage) stat(path string, d fs.DirEntry, ssts map[string]*sstFile) (*sstFile, error) {
+	isSst := strings.HasSuffix(path, ".sst")
+	if isSst {
+		if f, ok := u.ssts[path]; ok {
+			ssts[path] = f
+			return f, nil
+		}
 	}
 
-	return &pb.GetSpaceUsagesResponse{SpaceUsages: spaceUsages}, nil
+	info, err := d.Info()
+	if err != nil {
+		return nil, err
+	}
+	f := &sstFile{size: info.Size()}
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		f.key = fileKey{dev: uint64(st.Dev), ino: st.Ino}
+	}
+	if isSst {
+		ssts[path] = f
+	}
+	return f, nil
 }
@@ -0,0 +1,54 @@
+package clients
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpaceScanner(t *testing.T) {
+	assert := assert.New(t)
+	dataPath := t.TempDir()
+	write := func(name string, n int) {
+		path := filepath.Join(dataPath, name)
+		assert.Nil(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(os.WriteFile(path, make([]byte, n), 0644))
+	}
+	write("1/data/000001.sst", 100)
+	write("1/data/CURRENT", 10)
+	write("1/wal/1/0000001.wal", 20)
+	write("1/wal/2/0000001.wal", 30)
+	write("1/checkpoints/snap/data/000002.sst", 5)
+	write("1/other/LOCK", 1)
+	write("0/data/000001.sst", 1000)
+	// the checkpoint files are hard linked to the data
+	assert.Nil(os.MkdirAll(filepath.Join(dataPath, "1/checkpoints/snap/wal/1"), 0755))
+	assert.Nil(os.Link(filepath.Join(dataPath, "1/data/000001.sst"), filepath.Join(dataPath, "1/checkpoints/snap/data/000001.sst")))
+	assert.Nil(os.Link(filepath.Join(dataPath, "1/wal/1/0000001.wal"), filepath.Join(dataPath, "1/checkpoints/snap/wal/1/0000001.wal")))
+
+	s := NewSpaceScanner()
+	resp, err := s.GetSpaceUsages([]string{dataPath}, time.Minute)
+	assert.Nil(err)
+	assert.Len(resp.SpaceUsages, 1)
+	item := resp.SpaceUsages[0]
+	assert.Equal(int64(1), item.Id)
+	assert.Equal(int64(110), item.DataBytes)
+	assert.Equal(int64(50), item.WalBytes)
+	assert.Equal(int64(5), item.CheckpointBytes)
+	assert.Equal(int64(1), item.OtherBytes)
+	assert.Equal(int64(166), item.Usage)
+	assert.Len(item.Parts, 2)
+	assert.Equal(int64(30), item.Parts[1].WalBytes)
+
+	// cached until max age
+	write("1/data/000003.sst", 1000)
+	resp, err = s.GetSpaceUsages([]string{dataPath}, time.Minute)
+	assert.Nil(err)
+	assert.Equal(int64(166), resp.SpaceUsages[0].Usage)
+	resp, err = s.GetSpaceUsages([]string{dataPath}, -1)
+	assert.Nil(err)
+	assert.Equal(int64(1166), resp.SpaceUsages[0].Usage)
+}
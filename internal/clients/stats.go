@@ -0,0 +1,186 @@
+package clients
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+const statsRequestTimeout = 5 * time.Second
+
+// the labels of all re-exported stats, the missing ones are empty
+var statLabels = []string{"role", "addr", "space", "part", "stat", "window"}
+
+var (
+	serviceUpDesc = prometheus.NewDesc("nebula_service_up",
+		"Whether the local nebula service could be scraped by agent.", []string{"role", "addr"}, nil)
+	invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+	statSuffix       = regexp.MustCompile(`^(sum|avg|rate|count|p\d+)$`)
+)
+
+// stat is a line in nebula's /stats or /rocksdb_stats, such as:
+//
+//	num_queries.sum.60=10
+//	num_queries{space=nba}.rate.5=1
+//	rocksdb.block.cache.miss=123
+type stat struct {
+	name   string
+	space  string
+	part   string
+	stat   string
+	window string
+	value  float64
+}
+
+func parseStat(line string) (*stat, bool) {
+	i := strings.LastIndex(line, "=")
+	if i <= 0 {
+		return nil, false
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(line[i+1:]), 64)
+	if err != nil {
+		return nil, false
+	}
+	st := &stat{value: v}
+	key := strings.TrimSpace(line[:i])
+
+	// the labels in braces
+	if l, r := strings.Index(key, "{"), strings.Index(key, "}"); l > 0 && r > l {
+		for _, kv := range strings.Split(key[l+1:r], ",") {
+			k, v, _ := strings.Cut(kv, "=")
+			switch strings.TrimSpace(k) {
+			case "space":
+				st.space = strings.TrimSpace(v)
+			case "part":
+				st.part = strings.TrimSpace(v)
+			}
+		}
+		key = key[:l] + key[r+1:]
+	}
+
+	// the stat method and time window suffix
+	elems := strings.Split(key, ".")
+	if n := len(elems); n >= 3 && statSuffix.MatchString(elems[n-2]) {
+		if _, err := strconv.Atoi(elems[n-1]); err == nil {
+			st.stat, st.window = elems[n-2], elems[n-1]
+			elems = elems[:n-2]
+		}
+	}
+	st.name = "nebula_" + invalidNameChars.ReplaceAllString(strings.Join(elems, "_"), "_")
+	return st, true
+}
+
+// StatsCollector scrape the /stats and /rocksdb_stats of the local services when collected,
+// and re-export them with the role, addr, space and part labels
+type StatsCollector struct {
+	services func() []*Service
+	client   *http.Client
+}
+
+func NewStatsCollector(services func() []*Service) *StatsCollector {
+	return &StatsCollector{
+		services: services,
+		client:   &http.Client{Timeout: statsRequestTimeout},
+	}
+}
+
+// Describe send nothing, the stats of nebula are not known until scraped
+func (c *StatsCollector) Describe(ch chan<- *prometheus.Desc) {}
+
+func (c *StatsCollector) Collect(ch chan<- prometheus.Metric) {
+	services := c.services()
+	results := make([][]*stat, len(services))
+	var wg sync.WaitGroup
+	for i, s := range services {
+		wg.Add(1)
+		go func(i int, s *Service) {
+			defer wg.Done()
+			stats, err := c.scrape(s)
+			if err != nil {
+				log.WithError(err).WithField("addr", s.addr).Debugf("Scrape stats of %s failed.", s.name)
+				return
+			}
+			results[i] = stats
+		}(i, s)
+	}
+	wg.Wait()
+
+	descs := make(map[string]*prometheus.Desc)
+	seen := make(map[string]bool)
+	for i, s := range services {
+		up := 0.0
+		if results[i] != nil {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(serviceUpDesc, prometheus.GaugeValue, up, string(s.name), s.addr)
+
+		for _, st := range results[i] {
+			labels := []string{string(s.name), s.addr, st.space, st.part, st.stat, st.window}
+			// the sanitized names may conflict
+			k := st.name + "|" + strings.Join(labels, "|")
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+
+			d, ok := descs[st.name]
+			if !ok {
+				d = prometheus.NewDesc(st.name, "Nebula service stat.", statLabels, nil)
+				descs[st.name] = d
+			}
+			ch <- prometheus.MustNewConstMetric(d, prometheus.GaugeValue, st.value, labels...)
+		}
+	}
+}
+
+// scrape get the stats of the service, the rocksdb stats are only available in storaged
+func (c *StatsCollector) scrape(s *Service) ([]*stat, error) {
+	flags, err := s.flags()
+	if err != nil {
+		return nil, err
+	}
+	addr := s.httpAddr(flags)
+
+	stats, err := c.get(fmt.Sprintf("http://%s/stats", addr))
+	if err != nil {
+		return nil, err
+	}
+	if s.name == ServiceName_Storaged {
+		rocksdb, err := c.get(fmt.Sprintf("http://%s/rocksdb_stats", addr))
+		if err != nil {
+			log.WithError(err).WithField("addr", s.addr).Debug("Scrape rocksdb stats failed.")
+		}
+		stats = append(stats, rocksdb...)
+	}
+	return stats, nil
+}
+
+func (c *StatsCollector) get(url string) ([]*stat, error) {
+	resp, err := c.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("get %s failed: %s", url, resp.Status)
+	}
+
+	stats := make([]*stat, 0)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if st, ok := parseStat(scanner.Text()); ok {
+			stats = append(stats, st)
+		}
+	}
+	return stats, scanner.Err()
+}
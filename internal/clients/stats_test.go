@@ -0,0 +1,70 @@
+package clients
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStat(t *testing.T) {
+	assert := assert.New(t)
+
+	st, ok := parseStat("num_queries{space=nba}.rate.5=1.5")
+	assert.True(ok)
+	assert.Equal(&stat{name: "nebula_num_queries", space: "nba", stat: "rate", window: "5", value: 1.5}, st)
+
+	st, ok = parseStat("rocksdb.block.cache.miss=123")
+	assert.True(ok)
+	assert.Equal(&stat{name: "nebula_rocksdb_block_cache_miss", value: 123}, st)
+
+	_, ok = parseStat("bad line")
+	assert.False(ok)
+}
+
+func TestStatsCollector(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "num_queries.sum.60=3")
+		fmt.Fprintln(w, "num_queries{space=nba}.sum.60=2")
+	}))
+	defer ts.Close()
+	_, port, _ := net.SplitHostPort(ts.Listener.Addr().String())
+
+	up := fakeService(t, "exit 0\n")
+	up.addr = "127.0.0.1:9669"
+	conf := fmt.Sprintf("--ws_ip=127.0.0.1\n--ws_http_port=%s\n", port)
+	assert.Nil(os.WriteFile(up.flagFile(), []byte(conf), 0644))
+	down := &Service{name: ServiceName_Metad, dir: filepath.Join(up.dir, "not-exist"), addr: "127.0.0.1:9559"}
+
+	r := prometheus.NewRegistry()
+	r.MustRegister(NewStatsCollector(func() []*Service { return []*Service{up, down} }))
+	families, err := r.Gather()
+	assert.Nil(err)
+
+	values := make(map[string]float64)
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			k := f.GetName()
+			for _, l := range m.GetLabel() {
+				if l.GetValue() != "" {
+					k += "," + l.GetName() + "=" + l.GetValue()
+				}
+			}
+			values[k] = m.GetGauge().GetValue()
+		}
+	}
+	assert.Equal(map[string]float64{
+		"nebula_service_up,addr=127.0.0.1:9669,role=graphd":                               1,
+		"nebula_service_up,addr=127.0.0.1:9559,role=metad":                                0,
+		"nebula_num_queries,addr=127.0.0.1:9669,role=graphd,stat=sum,window=60":           3,
+		"nebula_num_queries,addr=127.0.0.1:9669,role=graphd,space=nba,stat=sum,window=60": 2,
+	}, values)
+}
@@ -0,0 +1,232 @@
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const (
+	killWaitTimeout = 5 * time.Second
+	aliveCheckTick  = 100 * time.Millisecond
+)
+
+var defaultStopTimeout = 30 * time.Second
+
+// processTable keeps the processes started by the agent and their exit codes, keyed by the config file
+// of the instance, since the instances of a role share the binary
+type processTable struct {
+	mu        sync.Mutex
+	running   map[string]*exec.Cmd
+	exitCodes map[string]int32
+}
+
+var processes = &processTable{
+	running:   make(map[string]*exec.Cmd),
+	exitCodes: make(map[string]int32),
+}
+
+// track wait the process in background and record its exit code
+func (t *processTable) track(conf string, cmd *exec.Cmd, out *os.File) {
+	t.mu.Lock()
+	t.running[conf] = cmd
+	delete(t.exitCodes, conf)
+	t.mu.Unlock()
+
+	go func() {
+		err := cmd.Wait()
+		out.Close()
+
+		code := int32(cmd.ProcessState.ExitCode())
+		if ws, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+			// follow the shell convention for the process killed by signal
+			code = 128 + int32(ws.Signal())
+		}
+		log.WithField("conf", conf).WithField("pid", cmd.Process.Pid).WithField("exit_code", code).
+			WithError(err).Info("Service process exited.")
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.running[conf] == cmd {
+			delete(t.running, conf)
+		}
+		t.exitCodes[conf] = code
+	}()
+}
+
+func (t *processTable) exitCode(conf string) int32 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if code, ok := t.exitCodes[conf]; ok {
+		return code
+	}
+	return -1
+}
+
+// NativeDaemon will start/stop metad/storaged/graphd in the service machine
+// by launching and signaling the nebula binaries directly
+type NativeDaemon struct {
+	s           *Service
+	stopTimeout time.Duration
+}
+
+// pidFile return the pid_file in the flag file, relative to the root dir
+func (d *NativeDaemon) pidFile() (string, error) {
+	flags, err := d.s.flags()
+	if err != nil {
+		return "", err
+	}
+
+	p := flags["pid_file"]
+	if p == "" {
+		p = filepath.Join("pids", d.s.instanceName()+".pid")
+	}
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(d.s.dir, p)
+	}
+	return p, nil
+}
+
+// pid return the pid of the running service, 0 if not running
+func (d *NativeDaemon) pid() (int, error) {
+	pidFile, err := d.pidFile()
+	if err != nil {
+		return 0, err
+	}
+	pid, err := utils.ReadPidFile(pidFile)
+	if err != nil {
+		return 0, err
+	}
+
+	if !utils.ProcessAlive(pid, d.s.processName()) {
+		return 0, nil
+	}
+	return pid, nil
+}
+
+func (d *NativeDaemon) Start() error {
+	pidFile, err := d.pidFile()
+	if err != nil {
+		return err
+	}
+	pid, err := d.pid()
+	if err != nil {
+		return err
+	}
+	if pid != 0 {
+		log.WithField("pid", pid).Infof("Service %s is already running.", d.s.name)
+		return nil
+	}
+
+	// keep the output before nebula set up its own logging
+	logDir := filepath.Join(d.s.dir, "logs")
+	if err = os.MkdirAll(logDir, 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(filepath.Join(logDir, d.s.instanceName()+".out"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	c := &Command{
+		Binary: d.s.binary(),
+		Args:   []string{"--flagfile=" + d.s.flagFile(), "--daemonize=false"},
+		Dir:    d.s.dir,
+		Output: out,
+		// run in its own session, so it would not be killed together with the agent
+		Setsid: true,
+	}
+	log.WithField("cmd", c.String()).Debug("Try to start service...")
+	cmd, err := c.Start()
+	if err != nil {
+		out.Close()
+		log.WithError(err).Errorf("Start %s failed", d.s.name)
+		return err
+	}
+	processes.track(d.s.flagFile(), cmd, out)
+
+	if err = utils.WritePidFile(pidFile, cmd.Process.Pid); err != nil {
+		return fmt.Errorf("write pid file of %s failed: %w", d.s.name, err)
+	}
+	log.WithField("pid", cmd.Process.Pid).Infof("Start %s successfully.", d.s.name)
+	return nil
+}
+
+// waitExit wait the process to exit until timeout, return true if exited
+func (d *NativeDaemon) waitExit(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for utils.ProcessAlive(pid, d.s.processName()) {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(aliveCheckTick)
+	}
+	return true
+}
+
+// Stop send SIGTERM to the service, and SIGKILL if it does not exit in the stop timeout
+func (d *NativeDaemon) Stop() error {
+	pid, err := d.pid()
+	if err != nil {
+		return err
+	}
+	if pid == 0 {
+		log.Infof("Service %s is not running.", d.s.name)
+		return nil
+	}
+
+	log.WithField("pid", pid).Debug("Try to stop service...")
+	if err = syscall.Kill(pid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return fmt.Errorf("send SIGTERM to %s failed: %w", d.s.name, err)
+	}
+	if d.waitExit(pid, d.stopTimeout) {
+		log.WithField("pid", pid).Infof("Stop %s successfully.", d.s.name)
+		return nil
+	}
+
+	log.WithField("pid", pid).Warnf("Service %s does not exit in %v, kill it.", d.s.name, d.stopTimeout)
+	if err = syscall.Kill(pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return fmt.Errorf("send SIGKILL to %s failed: %w", d.s.name, err)
+	}
+	if !d.waitExit(pid, killWaitTimeout) {
+		return fmt.Errorf("%s(%d) is still alive after killed", d.s.name, pid)
+	}
+	return nil
+}
+
+func (d *NativeDaemon) Status() (*pb.ServiceStatusResponse, error) {
+	resp := &pb.ServiceStatusResponse{
+		Status:   pb.Status_UNKNOWN_STATUS,
+		ExitCode: processes.exitCode(d.s.flagFile()),
+	}
+
+	pid, err := d.pid()
+	if err != nil {
+		return resp, err
+	}
+	if pid == 0 {
+		resp.Status = pb.Status_EXITED
+		return resp, nil
+	}
+
+	resp.Status = pb.Status_RUNNING
+	resp.Pid = int64(pid)
+	if start, err := utils.ProcessStartTime(pid); err == nil {
+		resp.UptimeSeconds = int64(time.Since(start).Seconds())
+	}
+	if resp.ListeningPorts, err = utils.ListeningPorts(pid); err != nil {
+		log.WithError(err).WithField("pid", pid).Debug("Get listening ports failed.")
+	}
+	return resp, nil
+}
@@ -0,0 +1,97 @@
+package clients
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+// fakeService create a root dir with a fake nebula-graphd shell script
+func fakeService(t *testing.T, script string) *Service {
+	dir := t.TempDir()
+	allowRoots(t, dir)
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "bin"), 0755))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "etc"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "bin", "nebula-graphd"), []byte("#!/bin/sh\n"+script), 0755))
+	conf := "########## basics ##########\n--daemonize=true\n--pid_file=pids/nebula-graphd.pid\n"
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "etc", "nebula-graphd.conf"), []byte(conf), 0644))
+
+	return &Service{name: ServiceName_Graphd, dir: dir}
+}
+
+// useNativeSupervisor make NewDaemon return the native daemon in the test
+func useNativeSupervisor(t *testing.T) {
+	mode := supervisorMode
+	supervisorMode = SupervisorNative
+	t.Cleanup(func() { supervisorMode = mode })
+}
+
+func TestNativeDaemon(t *testing.T) {
+	assert := assert.New(t)
+
+	s := fakeService(t, "trap 'exit 3' TERM\ntouch trapped\nwhile true; do sleep 0.1; done\n")
+	d := &NativeDaemon{s: s, stopTimeout: 5 * time.Second}
+
+	status, err := d.Status()
+	assert.Nil(err)
+	assert.Equal(pb.Status_EXITED, status.Status)
+	assert.EqualValues(-1, status.ExitCode)
+
+	assert.Nil(d.Start())
+	status, err = d.Status()
+	assert.Nil(err)
+	assert.Equal(pb.Status_RUNNING, status.Status)
+	assert.NotZero(status.Pid)
+	pid, err := os.ReadFile(filepath.Join(s.dir, "pids", "nebula-graphd.pid"))
+	assert.Nil(err)
+	assert.Equal(fmt.Sprintf("%d\n", status.Pid), string(pid))
+
+	// start again should not launch another process
+	assert.Nil(d.Start())
+	status2, err := d.Status()
+	assert.Nil(err)
+	assert.Equal(status.Pid, status2.Pid)
+
+	assert.Eventually(func() bool {
+		_, err := os.Stat(filepath.Join(s.dir, "trapped"))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Nil(d.Stop())
+	assert.Eventually(func() bool {
+		status, err = d.Status()
+		return err == nil && status.Status == pb.Status_EXITED && status.ExitCode == 3
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// another instance sharing the binary has its own exit code
+	conf := filepath.Join(s.dir, "etc", "nebula-graphd-2.conf")
+	assert.Nil(os.WriteFile(conf, []byte("--daemonize=true\n--pid_file=pids/nebula-graphd-2.pid\n"), 0644))
+	d2 := &NativeDaemon{s: &Service{name: ServiceName_Graphd, dir: s.dir, conf: conf}, stopTimeout: time.Second}
+	status, err = d2.Status()
+	assert.Nil(err)
+	assert.EqualValues(-1, status.ExitCode)
+}
+
+func TestNativeDaemonKill(t *testing.T) {
+	assert := assert.New(t)
+
+	// ignore SIGTERM, so it should be killed after the stop timeout
+	s := fakeService(t, "trap '' TERM\ntouch trapped\nwhile true; do sleep 0.1; done\n")
+	d := &NativeDaemon{s: s, stopTimeout: 500 * time.Millisecond}
+
+	assert.Nil(d.Start())
+	assert.Eventually(func() bool {
+		_, err := os.Stat(filepath.Join(s.dir, "trapped"))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Nil(d.Stop())
+	assert.Eventually(func() bool {
+		status, err := d.Status()
+		return err == nil && status.Status == pb.Status_EXITED && status.ExitCode == 128+9
+	}, 5*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,339 @@
+package clients
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/meta"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/metrics"
+	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+type metaResp interface {
+	GetCode() nebula.ErrorCode
+	GetLeader() *nebula.HostAddr
+}
+
+// call the meta service with client lock, and retry when the meta leader changed
+func (m *NebulaMeta) call(name string, fn func(c *meta.MetaServiceClient) (metaResp, error)) error {
+	m.clientMu.Lock()
+	defer m.clientMu.Unlock()
+
+	for try := 1; try <= 3; try++ {
+		resp, err := fn(m.client)
+		if err != nil {
+			c, cerr := connect(m.config.MetaAddr, m.config.AgentAddr, m.config.TLSConfig)
+			if cerr != nil {
+				return fmt.Errorf("%s failed: %w", name, err)
+			}
+			m.client = c
+			continue
+		}
+
+		switch resp.GetCode() {
+		case nebula.ErrorCode_SUCCEEDED:
+			return nil
+		case nebula.ErrorCode_E_LEADER_CHANGED:
+			if resp.GetLeader() == nil || resp.GetLeader() == meta.ExecResp_Leader_DEFAULT {
+				return LeaderNotFoundError
+			}
+			m.config.MetaAddr = resp.GetLeader()
+			c, err := connect(m.config.MetaAddr, m.config.AgentAddr, m.config.TLSConfig)
+			if err != nil {
+				return err
+			}
+			m.client = c
+			metrics.SetMetaLeader(utils.StringifyAddr(m.config.MetaAddr))
+			log.Infof("retry %s, meta leader changed, try times=%d.", name, try)
+		default:
+			return fmt.Errorf("%s failed: %s", name, resp.GetCode().String())
+		}
+	}
+	return fmt.Errorf("%s failed after retry", name)
+}
+
+func (m *NebulaMeta) listHosts(t meta.ListHostType) ([]*meta.HostItem, error) {
+	var hosts []*meta.HostItem
+	err := m.call("list hosts", func(c *meta.MetaServiceClient) (metaResp, error) {
+		resp, err := c.ListHosts(&meta.ListHostsReq{Type: t})
+		if err != nil {
+			return nil, err
+		}
+		hosts = resp.GetHosts()
+		return resp, nil
+	})
+	return hosts, err
+}
+
+func fromMetaRole(r meta.HostRole) pb.ServiceRole {
+	switch r {
+	case meta.HostRole_META:
+		return pb.ServiceRole_META
+	case meta.HostRole_STORAGE:
+		return pb.ServiceRole_STORAGE
+	case meta.HostRole_GRAPH:
+		return pb.ServiceRole_GRAPH
+	default:
+		return pb.ServiceRole_UNKNOWN_ROLE
+	}
+}
+
+func countParts(parts map[string][]nebula.PartitionID) int64 {
+	var n int64
+	for _, p := range parts {
+		n += int64(len(p))
+	}
+	return n
+}
+
+// ListServices return the services in the agent machine learned from heartbeat,
+// with their host status and partitions in meta
+func (m *NebulaMeta) ListServices() ([]*pb.ServiceInfo, error) {
+	m.mu.RLock()
+	services := make([]*meta.ServiceInfo, 0, len(m.services))
+	for _, s := range m.services {
+		services = append(services, s)
+	}
+	m.mu.RUnlock()
+
+	hosts := make(map[string]*meta.HostItem)
+	for _, t := range []meta.ListHostType{meta.ListHostType_META, meta.ListHostType_STORAGE, meta.ListHostType_GRAPH} {
+		items, err := m.listHosts(t)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range items {
+			hosts[fmt.Sprintf("%s/%s", h.GetRole(), utils.StringifyAddr(h.GetHostAddr()))] = h
+		}
+	}
+
+	infos := make([]*pb.ServiceInfo, 0, len(services))
+	for _, s := range services {
+		info := &pb.ServiceInfo{
+			Role:       fromMetaRole(s.GetRole()),
+			Addr:       utils.StringifyAddr(s.GetAddr()),
+			RootDir:    string(s.GetDir().GetRoot()),
+			HostStatus: meta.HostStatus_UNKNOWN.String(),
+		}
+		ls := &Service{name: toName(info.Role), dir: info.RootDir}
+		ls.matchAddr(info.Addr)
+		if _, err := os.Stat(ls.flagFile()); err == nil {
+			info.Conf = ls.flagFile()
+		}
+		for _, d := range s.GetDir().GetData() {
+			if len(d) != 0 {
+				info.DataDirs = append(info.DataDirs, string(d))
+			}
+		}
+		if h, ok := hosts[fmt.Sprintf("%s/%s", s.GetRole(), info.Addr)]; ok {
+			info.HostStatus = h.GetStatus().String()
+			info.LeaderParts = countParts(h.GetLeaderParts())
+			info.TotalParts = countParts(h.GetAllParts())
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Role != infos[j].Role {
+			return infos[i].Role < infos[j].Role
+		}
+		return infos[i].Addr < infos[j].Addr
+	})
+	return infos, nil
+}
+
+// LocalServices return the metad/storaged/graphd in the agent machine learned from heartbeat
+func (m *NebulaMeta) LocalServices() []*Service {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	services := make([]*Service, 0, len(m.services))
+	for _, s := range m.services {
+		role := fromMetaRole(s.GetRole())
+		if role == pb.ServiceRole_UNKNOWN_ROLE || len(s.GetDir().GetRoot()) == 0 {
+			continue
+		}
+		ls := &Service{name: toName(role), dir: string(s.GetDir().GetRoot())}
+		ls.matchAddr(utils.StringifyAddr(s.GetAddr()))
+		services = append(services, ls)
+	}
+	return services
+}
+
+// RootDirs return the root dirs of the services in the agent machine learned from heartbeat
+func (m *NebulaMeta) RootDirs() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	dirs := make([]string, 0)
+	for _, s := range m.services {
+		if d := string(s.GetDir().GetRoot()); d != "" && !seen[d] {
+			seen[d] = true
+			dirs = append(dirs, d)
+		}
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// LocalDirs return the root and data dirs of the services in the agent machine learned from heartbeat
+func (m *NebulaMeta) LocalDirs() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	dirs := make([]string, 0)
+	for _, s := range m.services {
+		root := string(s.GetDir().GetRoot())
+		for _, d := range append([][]byte{s.GetDir().GetRoot()}, s.GetDir().GetData()...) {
+			if len(d) == 0 {
+				continue
+			}
+			path := dataPath(root, string(d))
+			if !seen[path] {
+				seen[path] = true
+				dirs = append(dirs, path)
+			}
+		}
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// dataPath return the data path reported by the service, the relative one is resolved against its root dir
+func dataPath(root, path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(root, path)
+}
+
+// SpacesDir return the dir containing the space dirs in the data path of metad/storaged
+func SpacesDir(dataDir string) string {
+	return filepath.Join(dataDir, "nebula")
+}
+
+// ServiceDirs return the root and data dirs of the services of the role in the agent machine learned
+// from heartbeat, all the services are returned if role is UNKNOWN_ROLE or ALL
+func (m *NebulaMeta) ServiceDirs(role pb.ServiceRole) []*pb.ServiceDirs {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	dirs := make([]*pb.ServiceDirs, 0, len(m.services))
+	for k, s := range m.services {
+		r := fromMetaRole(s.GetRole())
+		if r == pb.ServiceRole_UNKNOWN_ROLE || (role != pb.ServiceRole_UNKNOWN_ROLE && role != pb.ServiceRole_ALL && r != role) {
+			continue
+		}
+		d := &pb.ServiceDirs{
+			Role:    r,
+			Addr:    k,
+			RootDir: string(s.GetDir().GetRoot()),
+		}
+		ls := &Service{name: toName(r), dir: d.RootDir}
+		ls.matchAddr(k)
+		if _, err := os.Stat(ls.flagFile()); err == nil {
+			d.Conf = ls.flagFile()
+		}
+		for _, data := range s.GetDir().GetData() {
+			if len(data) == 0 {
+				continue
+			}
+			path := dataPath(d.RootDir, string(data))
+			d.DataDirs = append(d.DataDirs, path)
+			d.SpaceDirs = append(d.SpaceDirs, SpacesDir(path))
+		}
+		dirs = append(dirs, d)
+	}
+
+	sort.Slice(dirs, func(i, j int) bool {
+		if dirs[i].Role != dirs[j].Role {
+			return dirs[i].Role < dirs[j].Role
+		}
+		return dirs[i].Addr < dirs[j].Addr
+	})
+	return dirs
+}
+
+// StorageSpaceDirs return the dirs containing the space dirs of the local storaged
+func (m *NebulaMeta) StorageSpaceDirs() []string {
+	paths := make([]string, 0)
+	for _, d := range m.ServiceDirs(pb.ServiceRole_STORAGE) {
+		paths = append(paths, d.SpaceDirs...)
+	}
+	return paths
+}
+
+// FindLocalService return the service of the role in the agent machine, the addr is needed only when
+// there are several services of the role
+func (m *NebulaMeta) FindLocalService(role pb.ServiceRole, addr string) (*Service, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	found := make([]*Service, 0)
+	for k, s := range m.services {
+		if fromMetaRole(s.GetRole()) != role || (addr != "" && k != addr) {
+			continue
+		}
+		ls := &Service{name: toName(role), dir: string(s.GetDir().GetRoot())}
+		ls.matchAddr(k)
+		found = append(found, ls)
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no %s service found in agent machine", role)
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("%d %s services found in agent machine, need the addr", len(found), role)
+	}
+}
+
+func (m *NebulaMeta) listSpaces() ([]*meta.IdName, error) {
+	var spaces []*meta.IdName
+	err := m.call("list spaces", func(c *meta.MetaServiceClient) (metaResp, error) {
+		resp, err := c.ListSpaces(&meta.ListSpacesReq{})
+		if err != nil {
+			return nil, err
+		}
+		spaces = resp.GetSpaces()
+		return resp, nil
+	})
+	return spaces, err
+}
+
+// BalanceLeader submit leader balance job for all spaces
+func (m *NebulaMeta) BalanceLeader() error {
+	spaces, err := m.listSpaces()
+	if err != nil {
+		return err
+	}
+
+	for _, s := range spaces {
+		spaceId := s.GetId().GetSpaceID()
+		err = m.call("balance leader", func(c *meta.MetaServiceClient) (metaResp, error) {
+			resp, err := c.RunAdminJob(&meta.AdminJobReq{
+				SpaceID: spaceId,
+				Op:      meta.JobOp_ADD,
+				Type:    meta.JobType_LEADER_BALANCE,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		})
+		if err != nil {
+			return fmt.Errorf("balance leader of space %s failed: %w", s.GetName(), err)
+		}
+		log.WithField("space", string(s.GetName())).Info("Submit leader balance job.")
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vesoft-inc/nebula-go/v3/nebula"
+	"github.com/vesoft-inc/nebula-go/v3/nebula/meta"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestServiceDirs(t *testing.T) {
+	assert := assert.New(t)
+	m := &NebulaMeta{
+		services: map[string]*meta.ServiceInfo{
+			"192.168.0.1:9559": {
+				Role: meta.HostRole_META,
+				Dir:  &nebula.DirInfo{Root: []byte("/usr/local/nebula"), Data: [][]byte{[]byte("data/meta")}},
+			},
+			"192.168.0.1:9779": {
+				Role: meta.HostRole_STORAGE,
+				Dir:  &nebula.DirInfo{Root: []byte("/usr/local/nebula"), Data: [][]byte{[]byte("/data1/storage"), []byte("/data2/storage")}},
+			},
+		},
+	}
+
+	dirs := m.ServiceDirs(pb.ServiceRole_ALL)
+	assert.Len(dirs, 2)
+	assert.Equal(pb.ServiceRole_META, dirs[0].Role)
+	assert.Equal([]string{"/usr/local/nebula/data/meta"}, dirs[0].DataDirs)
+
+	dirs = m.ServiceDirs(pb.ServiceRole_STORAGE)
+	assert.Len(dirs, 1)
+	assert.Equal("192.168.0.1:9779", dirs[0].Addr)
+	assert.Equal([]string{"/data1/storage/nebula", "/data2/storage/nebula"}, m.StorageSpaceDirs())
+	assert.Len(m.ServiceDirs(pb.ServiceRole_GRAPH), 0)
+
+	assert.Equal([]string{"/data1/storage", "/data2/storage", "/usr/local/nebula", "/usr/local/nebula/data/meta"}, m.LocalDirs())
+}
@@ -0,0 +1,333 @@
+package clients
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/utils"
+	"github.com/vesoft-inc/nebula-agent/v3/pkg/storage"
+)
+
+const (
+	binaryVersionsDirName = "versions"
+	currentVersionFile    = "CURRENT"
+	stagingDirName        = ".staging"
+	// the version name of the binary installed before the first upgrade by agent
+	originalVersion     = "original"
+	DefaultKeepVersions = 3
+)
+
+// BinaryVersions manages the binaries of the service, the versions installed by agent are kept in
+// versions/nebula-{role}/{version} of the root dir, and the one in use is recorded in CURRENT.
+// Note that the instances of the same role in the root dir share the binary.
+type BinaryVersions struct {
+	s *Service
+}
+
+func NewBinaryVersions(s *Service) *BinaryVersions {
+	return &BinaryVersions{s: s}
+}
+
+func (b *BinaryVersions) dir() string {
+	return filepath.Join(b.s.dir, binaryVersionsDirName, b.s.processName())
+}
+
+func (b *BinaryVersions) path(version string) string {
+	return filepath.Join(b.dir(), version)
+}
+
+// checksumPath return the file keeping the sha256 of the package which the version is staged from
+func (b *BinaryVersions) checksumPath(version string) string {
+	return filepath.Join(b.dir(), "."+version+".sha256")
+}
+
+func validVersion(version string) error {
+	if version == "" || version == currentVersionFile || strings.HasPrefix(version, ".") ||
+		strings.ContainsAny(version, `/\`) {
+		return fmt.Errorf("bad version name: '%s'", version)
+	}
+	return nil
+}
+
+// Current return the version in use, empty if never installed by agent
+func (b *BinaryVersions) Current() string {
+	data, err := os.ReadFile(filepath.Join(b.dir(), currentVersionFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// List return the kept versions, the latest installed one is the last
+func (b *BinaryVersions) List() ([]string, error) {
+	entries, err := os.ReadDir(b.dir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	type version struct {
+		name  string
+		mtime time.Time
+	}
+	versions := make([]version, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || validVersion(e.Name()) != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version{name: e.Name(), mtime: info.ModTime()})
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].mtime.Before(versions[j].mtime) })
+
+	names := make([]string, 0, len(versions))
+	for _, v := range versions {
+		names = append(names, v.name)
+	}
+	return names, nil
+}
+
+// Exclusive check the binary is used by only one instance in the root dir,
+// the binary could not be switched for one instance without affecting the others
+func (b *BinaryVersions) Exclusive() error {
+	files, err := b.s.confFiles()
+	if err != nil {
+		return err
+	}
+	if len(files) > 1 {
+		return fmt.Errorf("the binary of %s is shared by %d instances in %s: %v", b.s.name, len(files), b.s.dir, files)
+	}
+	return nil
+}
+
+// Exist check if the version is kept
+func (b *BinaryVersions) Exist(version string) bool {
+	if validVersion(version) != nil {
+		return false
+	}
+	_, err := os.Stat(b.path(version))
+	return err == nil
+}
+
+var tarballExts = []string{".tar.gz", ".tgz"}
+
+// PackageVersion return the version name from the package uri, such as
+// nebula-graph-3.4.0.ubuntu1804.amd64 for .../nebula-graph-3.4.0.ubuntu1804.amd64.tar.gz
+func PackageVersion(uri string) string {
+	name := path.Base(uri)
+	for _, ext := range tarballExts {
+		if strings.HasSuffix(name, ext) {
+			return strings.TrimSuffix(name, ext)
+		}
+	}
+	return name
+}
+
+// Stage download the package, verify its sha256 checksum and keep the binary of the service as the version.
+// The package is either a tar.gz of nebula release containing bin/nebula-{role} or the binary itself.
+// The version already staged from the same package is reused, e.g. when retrying a failed upgrade.
+func (b *BinaryVersions) Stage(ctx context.Context, sto storage.ExternalStorage, uri, checksum, version string) error {
+	if err := validVersion(version); err != nil {
+		return err
+	}
+	if version == b.Current() {
+		return fmt.Errorf("version %s of %s is in use", version, b.s.name)
+	}
+	if checksum == "" {
+		return fmt.Errorf("the sha256 checksum of package %s is required", uri)
+	}
+	if b.Exist(version) {
+		data, err := os.ReadFile(b.checksumPath(version))
+		if err != nil || !strings.EqualFold(strings.TrimSpace(string(data)), checksum) {
+			return fmt.Errorf("version %s of %s already exists and is not staged from package %s", version, b.s.name, uri)
+		}
+		log.WithField("uri", uri).WithField("version", version).Infof("%s binary already staged.", b.s.name)
+		return nil
+	}
+
+	staging := filepath.Join(b.dir(), stagingDirName)
+	if err := os.MkdirAll(staging, 0755); err != nil {
+		return err
+	}
+	defer os.RemoveAll(staging)
+
+	pkg := filepath.Join(staging, path.Base(uri))
+	if err := download(ctx, sto, uri, pkg, checksum); err != nil {
+		return err
+	}
+
+	bin := pkg
+	if PackageVersion(uri) != path.Base(uri) {
+		bin = filepath.Join(staging, b.s.processName())
+		if err := extractBinary(pkg, "bin/"+b.s.processName(), bin); err != nil {
+			return fmt.Errorf("extract %s from package %s failed: %w", b.s.processName(), uri, err)
+		}
+	}
+	if err := os.Chmod(bin, 0755); err != nil {
+		return err
+	}
+	if err := utils.WriteFileAtomic(b.checksumPath(version), []byte(checksum+"\n"), 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(bin, b.path(version)); err != nil {
+		return err
+	}
+	log.WithField("uri", uri).WithField("version", version).Infof("Stage %s binary.", b.s.name)
+	return nil
+}
+
+// download copy the remote file to local path and check its sha256 checksum at the same time
+func download(ctx context.Context, sto storage.ExternalStorage, uri, local, checksum string) error {
+	rc, err := sto.OpenFile(ctx, uri)
+	if err != nil {
+		return fmt.Errorf("open package %s failed: %w", uri, err)
+	}
+	defer rc.Close()
+
+	f, err := os.Create(local)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(f, io.TeeReader(rc, h)); err != nil {
+		return fmt.Errorf("download package %s failed: %w", uri, err)
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(got, checksum) {
+		return fmt.Errorf("checksum mismatch of package %s, expected %s, got %s", uri, checksum, got)
+	}
+	return nil
+}
+
+// extractBinary find the file whose path ends with name in the tar.gz and write it to dst
+func extractBinary(pkg, name, dst string) error {
+	f, err := os.Open(pkg)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return fmt.Errorf("%s not found", name)
+		}
+		if err != nil {
+			return err
+		}
+		p := path.Clean(hdr.Name)
+		if hdr.Typeflag != tar.TypeReg || (p != name && !strings.HasSuffix(p, "/"+name)) {
+			continue
+		}
+
+		out, err := os.Create(dst)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		_, err = io.Copy(out, tr)
+		return err
+	}
+}
+
+// SaveCurrent keep the binary in use as a version so that it could be rolled back to, return the version
+func (b *BinaryVersions) SaveCurrent() (string, error) {
+	if cur := b.Current(); cur != "" && b.Exist(cur) {
+		return cur, nil
+	}
+
+	version := originalVersion
+	if b.Exist(version) {
+		version = fmt.Sprintf("%s-%d", originalVersion, time.Now().Unix())
+	}
+	data, err := os.ReadFile(b.s.binary())
+	if err != nil {
+		return "", fmt.Errorf("read binary of %s failed: %w", b.s.name, err)
+	}
+	if err = os.MkdirAll(b.dir(), 0755); err != nil {
+		return "", err
+	}
+	if err = utils.WriteFileAtomic(b.path(version), data, 0755); err != nil {
+		return "", err
+	}
+	if err = utils.WriteFileAtomic(filepath.Join(b.dir(), currentVersionFile), []byte(version+"\n"), 0644); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// Install replace the binary in bin dir with the version, the running process is not affected
+func (b *BinaryVersions) Install(version string) error {
+	if !b.Exist(version) {
+		return fmt.Errorf("version %s of %s not found", version, b.s.name)
+	}
+
+	data, err := os.ReadFile(b.path(version))
+	if err != nil {
+		return err
+	}
+	// write a new file and rename it, since the binary may be busy
+	if err = utils.WriteFileAtomic(b.s.binary(), data, 0755); err != nil {
+		return fmt.Errorf("install binary of %s failed: %w", b.s.name, err)
+	}
+	if err = utils.WriteFileAtomic(filepath.Join(b.dir(), currentVersionFile), []byte(version+"\n"), 0644); err != nil {
+		return err
+	}
+	log.WithField("version", version).Infof("Install %s binary.", b.s.name)
+	return nil
+}
+
+// Prune remove the oldest versions to keep at most n ones, the current and the pinned ones are always kept
+func (b *BinaryVersions) Prune(n int, pinned ...string) {
+	versions, err := b.List()
+	if err != nil {
+		log.WithError(err).Warnf("List %s binary versions failed.", b.s.name)
+		return
+	}
+
+	keep := map[string]bool{b.Current(): true}
+	for _, p := range pinned {
+		keep[p] = true
+	}
+	left := len(versions)
+	for _, v := range versions {
+		if left <= n {
+			break
+		}
+		if keep[v] {
+			continue
+		}
+		if err = os.Remove(b.path(v)); err != nil {
+			log.WithError(err).WithField("version", v).Warnf("Remove %s binary failed.", b.s.name)
+			continue
+		}
+		os.Remove(b.checksumPath(v))
+		left--
+	}
+}
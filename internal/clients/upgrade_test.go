@@ -0,0 +1,93 @@
+package clients
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+	"github.com/vesoft-inc/nebula-agent/v3/pkg/storage"
+)
+
+// fakePackage write a release tar.gz containing the graphd binary, return its uri and checksum
+func fakePackage(t *testing.T, name, binary string) (string, string) {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for _, f := range []string{"bin/nebula-metad", "bin/nebula-graphd"} {
+		data := []byte("#!/bin/sh\n" + binary + " " + f + "\n")
+		assert.Nil(t, tw.WriteHeader(&tar.Header{Name: name + "/" + f, Mode: 0755, Size: int64(len(data)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write(data)
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, gz.Close())
+
+	path := filepath.Join(t.TempDir(), name+".tar.gz")
+	assert.Nil(t, os.WriteFile(path, buf.Bytes(), 0644))
+	sum := sha256.Sum256(buf.Bytes())
+	return pb.LocalPrefix + path, hex.EncodeToString(sum[:])
+}
+
+func TestBinaryVersions(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	sto := &storage.Local{}
+
+	s := fakeService(t, "echo old\n")
+	b := NewBinaryVersions(s)
+	assert.Equal("", b.Current())
+
+	prev, err := b.SaveCurrent()
+	assert.Nil(err)
+	assert.Equal(originalVersion, prev)
+
+	uri, sum := fakePackage(t, "nebula-graph-3.4.0", "echo new")
+	version := PackageVersion(uri)
+	assert.Equal("nebula-graph-3.4.0", version)
+	assert.NotNil(b.Stage(ctx, sto, uri, "", version))
+	assert.NotNil(b.Stage(ctx, sto, uri, "deadbeef", version))
+	assert.False(b.Exist(version))
+	assert.NotNil(b.Stage(ctx, sto, uri, sum, "../escape"))
+
+	assert.Nil(b.Stage(ctx, sto, uri, sum, version))
+	assert.Nil(b.Install(version))
+	assert.Equal(version, b.Current())
+	data, err := os.ReadFile(s.binary())
+	assert.Nil(err)
+	assert.Equal("#!/bin/sh\necho new bin/nebula-graphd\n", string(data))
+	// the version in use or kept could not be staged again
+	assert.NotNil(b.Stage(ctx, sto, uri, sum, version))
+	assert.NotNil(b.Stage(ctx, sto, uri, sum, prev))
+
+	// rolled back after the upgrade failed
+	assert.Nil(b.Install(prev))
+	data, err = os.ReadFile(s.binary())
+	assert.Nil(err)
+	assert.Equal("#!/bin/sh\necho old\n", string(data))
+	// retry the same package, but not another one with the same name
+	assert.Nil(b.Stage(ctx, sto, uri, sum, version))
+	other, otherSum := fakePackage(t, "nebula-graph-3.4.0", "echo other")
+	assert.NotNil(b.Stage(ctx, sto, other, otherSum, version))
+
+	versions, err := b.List()
+	assert.Nil(err)
+	assert.Equal([]string{originalVersion, version}, versions)
+	b.Prune(1)
+	versions, err = b.List()
+	assert.Nil(err)
+	assert.Equal([]string{originalVersion}, versions)
+
+	// the binary shared by several instances
+	assert.Nil(b.Exclusive())
+	assert.Nil(os.WriteFile(filepath.Join(s.dir, "etc", "nebula-graphd-2.conf"), nil, 0644))
+	assert.NotNil(b.Exclusive())
+}
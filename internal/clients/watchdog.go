@@ -0,0 +1,337 @@
+package clients
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const (
+	maxCrashRecords = 5
+	crashLogLines   = 100
+)
+
+type WatchdogConfig struct {
+	Interval       time.Duration
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// the service is in crash loop when crashed CrashLoopCount times in CrashLoopWindow
+	CrashLoopCount  int
+	CrashLoopWindow time.Duration
+}
+
+func DefaultWatchdogConfig() *WatchdogConfig {
+	return &WatchdogConfig{
+		Interval:        10 * time.Second,
+		InitialBackoff:  10 * time.Second,
+		MaxBackoff:      5 * time.Minute,
+		CrashLoopCount:  5,
+		CrashLoopWindow: 30 * time.Minute,
+	}
+}
+
+// watched is the state of a service watched by the watchdog
+type watched struct {
+	s           *Service
+	state       pb.WatchState
+	seenRunning bool
+	// startTime is when the process started or first seen running, used to find its core files
+	startTime    time.Time
+	restartCount int64
+	lastRestart  time.Time
+	nextRestart  time.Time
+	backoff      time.Duration
+	crashes      []*pb.CrashRecord
+	// gen is increased when paused or resumed, so the check started before is discarded
+	gen int64
+	// held when starting the service out of the watchdog lock
+	restarting sync.Mutex
+}
+
+// Watchdog restarts the crashed local services with exponential backoff.
+// Only the services seen running would be restarted, and the services stopped by
+// StopService are paused until started again.
+type Watchdog struct {
+	cfg      *WatchdogConfig
+	services func() []*Service
+
+	mu      sync.Mutex
+	watched map[string]*watched
+}
+
+func NewWatchdog(cfg *WatchdogConfig, services func() []*Service) *Watchdog {
+	return &Watchdog{
+		cfg:      cfg,
+		services: services,
+		watched:  make(map[string]*watched),
+	}
+}
+
+func serviceKey(s *Service) string {
+	return string(s.name) + ":" + filepath.Clean(s.flagFile())
+}
+
+// get return the watched state of the service, should be called with lock
+func (w *Watchdog) get(s *Service) *watched {
+	k := serviceKey(s)
+	ws, ok := w.watched[k]
+	if !ok {
+		ws = &watched{s: s, state: pb.WatchState_WATCHING}
+		w.watched[k] = ws
+	}
+	return ws
+}
+
+func (w *Watchdog) Run(ctx context.Context) {
+	log.WithField("interval", w.cfg.Interval).Info("Start watchdog.")
+	t := time.NewTicker(w.cfg.Interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			w.check()
+		}
+	}
+}
+
+func (w *Watchdog) check() {
+	for _, s := range w.services() {
+		w.checkService(s)
+	}
+}
+
+// checkService restart the service if it crashed, the lock is not held when calling
+// the daemon, which may take seconds
+func (w *Watchdog) checkService(s *Service) {
+	w.mu.Lock()
+	ws := w.get(s)
+	state, gen := ws.state, ws.gen
+	w.mu.Unlock()
+	if state == pb.WatchState_PAUSED || state == pb.WatchState_CRASH_LOOP {
+		return
+	}
+
+	d, err := NewDaemon(s)
+	if err != nil {
+		log.WithError(err).WithField("service", serviceKey(s)).Debug("Create daemon failed in watchdog.")
+		return
+	}
+	status, err := d.Status()
+	if err != nil {
+		log.WithError(err).WithField("service", serviceKey(s)).Debug("Get status failed in watchdog.")
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	// paused or resumed when getting the status
+	if ws.gen != gen {
+		return
+	}
+	now := time.Now()
+	switch status.Status {
+	case pb.Status_RUNNING:
+		if !ws.seenRunning {
+			ws.seenRunning = true
+			ws.startTime = now
+		}
+		// started by others when in backoff
+		ws.state = pb.WatchState_WATCHING
+		// reset the backoff after running stably
+		if now.Sub(ws.lastRestart) > w.cfg.MaxBackoff {
+			ws.backoff = 0
+		}
+	case pb.Status_EXITED:
+		if !ws.seenRunning {
+			return
+		}
+		if ws.state == pb.WatchState_WATCHING {
+			w.crashed(ws, status.ExitCode, now)
+			return
+		}
+		if ws.state == pb.WatchState_BACKOFF && !now.Before(ws.nextRestart) {
+			w.restart(ws, d, now)
+		}
+	}
+}
+
+// crashed record the crash context, and decide to restart after backoff or give up
+func (w *Watchdog) crashed(ws *watched, exitCode int32, now time.Time) {
+	flags, err := ws.s.flags()
+	if err != nil {
+		flags = make(map[string]string)
+	}
+	c := &pb.CrashRecord{
+		Time:      now.Unix(),
+		ExitCode:  exitCode,
+		CoreFiles: findCoreFiles(ws.s.dir, ws.startTime),
+	}
+	c.LogFile, c.LogTail = tailLogs(ws.s.logFiles(flags), crashLogLines)
+	ws.crashes = append(ws.crashes, c)
+	if len(ws.crashes) > maxCrashRecords {
+		ws.crashes = ws.crashes[len(ws.crashes)-maxCrashRecords:]
+	}
+
+	logger := log.WithField("service", serviceKey(ws.s)).WithField("exit_code", exitCode).
+		WithField("core_files", c.CoreFiles)
+	if w.inCrashLoop(ws, now) {
+		ws.state = pb.WatchState_CRASH_LOOP
+		logger.Errorf("Service crashed %d times in %v, stop restarting it.", w.cfg.CrashLoopCount, w.cfg.CrashLoopWindow)
+		return
+	}
+
+	w.backoff(ws, now)
+	logger.Warnf("Service crashed, restart it after %v.", ws.backoff)
+}
+
+func (w *Watchdog) backoff(ws *watched, now time.Time) {
+	if ws.backoff == 0 {
+		ws.backoff = w.cfg.InitialBackoff
+	} else {
+		ws.backoff *= 2
+	}
+	if ws.backoff > w.cfg.MaxBackoff {
+		ws.backoff = w.cfg.MaxBackoff
+	}
+	ws.state = pb.WatchState_BACKOFF
+	ws.nextRestart = now.Add(ws.backoff)
+}
+
+func (w *Watchdog) inCrashLoop(ws *watched, now time.Time) bool {
+	n := 0
+	for _, c := range ws.crashes {
+		if now.Sub(time.Unix(c.Time, 0)) <= w.cfg.CrashLoopWindow {
+			n++
+		}
+	}
+	return n >= w.cfg.CrashLoopCount
+}
+
+// restart start the service out of the lock, should be called with lock
+func (w *Watchdog) restart(ws *watched, d Daemon, now time.Time) {
+	logger := log.WithField("service", serviceKey(ws.s))
+	ws.restartCount++
+	ws.lastRestart = now
+
+	gen := ws.gen
+	ws.restarting.Lock()
+	defer ws.restarting.Unlock()
+	w.mu.Unlock()
+	err := d.Start()
+	w.mu.Lock()
+	if ws.gen != gen {
+		logger.Info("Service paused or resumed when restarting by watchdog.")
+		return
+	}
+	if err != nil {
+		logger.WithError(err).Error("Restart service failed in watchdog.")
+		w.backoff(ws, now)
+		return
+	}
+
+	ws.state = pb.WatchState_WATCHING
+	ws.startTime = now
+	logger.WithField("restart_count", ws.restartCount).Info("Restart service by watchdog.")
+}
+
+// findCoreFiles find the core files generated after the process started in the service root dir
+func findCoreFiles(dir string, since time.Time) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	cores := make([]string, 0)
+	for _, e := range entries {
+		if e.IsDir() || (e.Name() != "core" && !strings.HasPrefix(e.Name(), "core.")) {
+			continue
+		}
+		info, err := e.Info()
+		if err == nil && !info.ModTime().Before(since) {
+			cores = append(cores, filepath.Join(dir, e.Name()))
+		}
+	}
+	return cores
+}
+
+// Pause stop watching the service, used when the service is stopped on purpose.
+// It waits the restart in progress, so the service is not started by watchdog after paused.
+func (w *Watchdog) Pause(s *Service) {
+	w.mu.Lock()
+	ws := w.get(s)
+	ws.state = pb.WatchState_PAUSED
+	ws.gen++
+	w.mu.Unlock()
+
+	ws.restarting.Lock()
+	defer ws.restarting.Unlock()
+	log.WithField("service", serviceKey(s)).Info("Pause watching service.")
+}
+
+// Resume watch the service again, also clear the crash loop state
+func (w *Watchdog) Resume(s *Service) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ws := w.get(s)
+	ws.state = pb.WatchState_WATCHING
+	ws.seenRunning = false
+	ws.backoff = 0
+	ws.gen++
+	log.WithField("service", serviceKey(s)).Info("Resume watching service.")
+}
+
+// ResumeAfterFailure watch the service again after it failed to be started on purpose,
+// it is restarted with backoff as if it crashed
+func (w *Watchdog) ResumeAfterFailure(s *Service) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ws := w.get(s)
+	ws.state = pb.WatchState_WATCHING
+	ws.seenRunning = true
+	ws.backoff = 0
+	ws.gen++
+	log.WithField("service", serviceKey(s)).Info("Resume watching service failed to start.")
+}
+
+func (w *Watchdog) Status() []*pb.WatchedService {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	res := make([]*pb.WatchedService, 0, len(w.watched))
+	for _, ws := range w.watched {
+		info := &pb.WatchedService{
+			Role:         toRole(ws.s.name),
+			Dir:          ws.s.dir,
+			Conf:         ws.s.flagFile(),
+			State:        ws.state,
+			RestartCount: ws.restartCount,
+			Crashes:      append([]*pb.CrashRecord{}, ws.crashes...),
+		}
+		if !ws.lastRestart.IsZero() {
+			info.LastRestartTime = ws.lastRestart.Unix()
+		}
+		if ws.state == pb.WatchState_BACKOFF {
+			info.NextRestartTime = ws.nextRestart.Unix()
+		}
+		res = append(res, info)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Role != res[j].Role {
+			return res[i].Role < res[j].Role
+		}
+		return res[i].Dir < res[j].Dir
+	})
+	return res
+}
@@ -0,0 +1,115 @@
+package clients
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestWatchdog(t *testing.T) {
+	assert := assert.New(t)
+	useNativeSupervisor(t)
+
+	s := fakeService(t, "echo started >> logs/nebula-graphd.out\nwhile true; do sleep 0.1; done\n")
+	cfg := &WatchdogConfig{
+		InitialBackoff:  10 * time.Millisecond,
+		MaxBackoff:      time.Second,
+		CrashLoopCount:  3,
+		CrashLoopWindow: time.Minute,
+	}
+	w := NewWatchdog(cfg, func() []*Service { return []*Service{s} })
+	d := &NativeDaemon{s: s, stopTimeout: time.Second}
+
+	// not restarted if never seen running
+	w.check()
+	status, _ := d.Status()
+	assert.Equal(pb.Status_EXITED, status.Status)
+
+	assert.Nil(d.Start())
+	defer d.Stop()
+	w.check()
+
+	crash := func() {
+		status, err := d.Status()
+		assert.Nil(err)
+		assert.Nil(syscall.Kill(int(status.Pid), syscall.SIGKILL))
+		assert.Eventually(func() bool {
+			status, _ := d.Status()
+			return status.Status == pb.Status_EXITED
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	restarted := func() bool {
+		w.check()
+		status, _ := d.Status()
+		return status.Status == pb.Status_RUNNING
+	}
+
+	// wait the output written before crash
+	assert.Eventually(func() bool {
+		data, _ := os.ReadFile(filepath.Join(s.dir, "logs", "nebula-graphd.out"))
+		return len(data) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	crash()
+	assert.Eventually(restarted, 5*time.Second, 20*time.Millisecond)
+	ws := w.Status()
+	assert.Equal(1, len(ws))
+	assert.EqualValues(1, ws[0].RestartCount)
+	assert.Equal(1, len(ws[0].Crashes))
+	assert.EqualValues(128+9, ws[0].Crashes[0].ExitCode)
+	assert.Contains(ws[0].Crashes[0].LogTail, "started")
+
+	// paused service would not be restarted
+	w.Pause(s)
+	crash()
+	w.check()
+	status, _ = d.Status()
+	assert.Equal(pb.Status_EXITED, status.Status)
+	assert.Equal(pb.WatchState_PAUSED, w.Status()[0].State)
+
+	// crash loop
+	assert.Nil(d.Start())
+	w.Resume(s)
+	w.check()
+	crash()
+	assert.Eventually(restarted, 5*time.Second, 20*time.Millisecond)
+	crash()
+	w.check()
+	assert.Equal(pb.WatchState_CRASH_LOOP, w.Status()[0].State)
+	time.Sleep(100 * time.Millisecond)
+	w.check()
+	status, _ = d.Status()
+	assert.Equal(pb.Status_EXITED, status.Status)
+
+	// failed to be started on purpose, restarted with backoff
+	w = NewWatchdog(cfg, func() []*Service { return []*Service{s} })
+	w.ResumeAfterFailure(s)
+	assert.Eventually(restarted, 5*time.Second, 20*time.Millisecond)
+	assert.EqualValues(1, w.Status()[0].RestartCount)
+
+	// pause waits the restart in progress
+	cur := w.get(s)
+	cur.restarting.Lock()
+	paused := make(chan struct{})
+	go func() {
+		w.Pause(s)
+		close(paused)
+	}()
+	assert.Eventually(func() bool { return w.Status()[0].State == pb.WatchState_PAUSED }, time.Second, 10*time.Millisecond)
+	select {
+	case <-paused:
+		t.Fatal("pause should wait the restart in progress")
+	case <-time.After(50 * time.Millisecond):
+	}
+	cur.restarting.Unlock()
+	<-paused
+
+	// core files generated after started
+	assert.Nil(os.WriteFile(filepath.Join(s.dir, "core.123"), nil, 0644))
+	assert.Equal([]string{filepath.Join(s.dir, "core.123")}, findCoreFiles(s.dir, time.Now().Add(-time.Minute)))
+	assert.Empty(findCoreFiles(s.dir, time.Now().Add(time.Minute)))
+}
@@ -0,0 +1,199 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const (
+	KindVerifyBackup = "verify_backup"
+	KindDataPlayBack = "data_playback"
+)
+
+const (
+	// keep the finished jobs for querying result
+	maxFinishedJobs = 128
+)
+
+var Jobs = NewManager()
+
+// Job is a background task in the agent machine which could be cancelled
+type Job struct {
+	mu     sync.Mutex
+	info   *pb.JobInfo
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Info return a snapshot of the job info
+func (j *Job) Info() *pb.JobInfo {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	info := *j.info
+	return &info
+}
+
+// Update change the job info with lock, used by the job function to report progress and result
+func (j *Job) Update(fn func(info *pb.JobInfo)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	fn(j.info)
+}
+
+// SetProgress report the progress in [0, 1] with a message
+func (j *Job) SetProgress(progress float64, msg string) {
+	j.Update(func(info *pb.JobInfo) {
+		info.Progress = progress
+		info.Message = msg
+	})
+}
+
+// Wait block until the job finished
+func (j *Job) Wait() *pb.JobInfo {
+	<-j.done
+	return j.Info()
+}
+
+// Done return a channel closed when the job finished
+func (j *Job) Done() <-chan struct{} {
+	return j.done
+}
+
+func (j *Job) finished() bool {
+	select {
+	case <-j.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Manager keeps the running and recently finished jobs
+type Manager struct {
+	mu   sync.Mutex
+	jobs map[string]*Job
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		jobs: make(map[string]*Job),
+	}
+}
+
+// Submit run the fn in background, the ctx passed to fn will be cancelled when the job is cancelled
+func (m *Manager) Submit(kind string, fn func(ctx context.Context, j *Job) error) *Job {
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &Job{
+		info: &pb.JobInfo{
+			Id:        uuid.New().String(),
+			Kind:      kind,
+			Status:    pb.JobStatus_JOB_RUNNING,
+			StartTime: time.Now().Unix(),
+		},
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	m.jobs[j.info.Id] = j
+	m.gc()
+	m.mu.Unlock()
+
+	log.WithField("id", j.info.Id).WithField("kind", kind).Info("Start job.")
+	go func() {
+		defer close(j.done)
+		defer cancel()
+
+		err := fn(ctx, j)
+		j.Update(func(info *pb.JobInfo) {
+			info.EndTime = time.Now().Unix()
+			switch {
+			case err == nil:
+				info.Status = pb.JobStatus_JOB_SUCCEEDED
+				info.Progress = 1
+			case errors.Is(err, context.Canceled):
+				info.Status = pb.JobStatus_JOB_CANCELLED
+				info.Error = err.Error()
+			default:
+				info.Status = pb.JobStatus_JOB_FAILED
+				info.Error = err.Error()
+			}
+		})
+		log.WithField("id", j.info.Id).WithField("kind", kind).WithError(err).Info("Job finished.")
+	}()
+
+	return j
+}
+
+func (m *Manager) Get(id string) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	j, ok := m.jobs[id]
+	if !ok {
+		return nil, fmt.Errorf("job %s not found", id)
+	}
+	return j, nil
+}
+
+// List return the jobs of given kind sorted by start time, all jobs if kind is empty
+func (m *Manager) List(kind string) []*pb.JobInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	infos := make([]*pb.JobInfo, 0, len(m.jobs))
+	for _, j := range m.jobs {
+		info := j.Info()
+		if kind == "" || info.Kind == kind {
+			infos = append(infos, info)
+		}
+	}
+	sort.Slice(infos, func(i, k int) bool {
+		return infos[i].StartTime < infos[k].StartTime
+	})
+	return infos
+}
+
+func (m *Manager) Cancel(id string) error {
+	j, err := m.Get(id)
+	if err != nil {
+		return err
+	}
+	if j.finished() {
+		return fmt.Errorf("job %s has finished", id)
+	}
+
+	log.WithField("id", id).Info("Cancel job.")
+	j.cancel()
+	return nil
+}
+
+// gc remove the oldest finished jobs, should be called with lock
+func (m *Manager) gc() {
+	finished := make([]*Job, 0)
+	for _, j := range m.jobs {
+		if j.finished() {
+			finished = append(finished, j)
+		}
+	}
+	if len(finished) <= maxFinishedJobs {
+		return
+	}
+
+	sort.Slice(finished, func(i, k int) bool {
+		return finished[i].Info().EndTime < finished[k].Info().EndTime
+	})
+	for _, j := range finished[:len(finished)-maxFinishedJobs] {
+		delete(m.jobs, j.Info().Id)
+	}
+}
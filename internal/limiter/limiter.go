@@ -1,11 +1,16 @@
 package limiter
 
 import (
+	"time"
+
 	"github.com/juju/ratelimit"
 )
 
 var Rate rateLimiter
 
+// WaitObserver is called with the time waited for the rate limiter, nil by default
+var WaitObserver func(time.Duration)
+
 type rateLimiter struct {
 	limiter *ratelimit.Bucket
 }
@@ -19,7 +24,11 @@ func (r *rateLimiter) SetLimiter(limit int) {
 
 func (r *rateLimiter) Wait(size int64) {
 	if r.limiter != nil {
+		start := time.Now()
 		r.limiter.Wait(size)
+		if WaitObserver != nil {
+			WaitObserver(time.Since(start))
+		}
 	}
 }
 
@@ -0,0 +1,155 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	namespace = "nebula_agent"
+
+	Upload   = "upload"
+	Download = "download"
+)
+
+// Registry keeps all metrics exported by the agent's /metrics
+var Registry = prometheus.NewRegistry()
+
+var (
+	rpcHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "handled_total",
+		Help:      "Total number of rpcs completed by the agent, by method and status code.",
+	}, []string{"method", "code"})
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "handling_seconds",
+		Help:      "Latency of rpcs handled by the agent.",
+		Buckets:   []float64{0.005, 0.05, 0.5, 1, 5, 30, 120, 600, 3600},
+	}, []string{"method"})
+
+	transferBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "storage",
+		Name:      "transfer_bytes_total",
+		Help:      "Bytes transferred between agent machine and external storage.",
+	}, []string{"backend", "direction"})
+	transferFiles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "storage",
+		Name:      "transfer_files_total",
+		Help:      "Files transferred between agent machine and external storage.",
+	}, []string{"backend", "direction"})
+
+	limiterWait = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "limiter",
+		Name:      "wait_seconds",
+		Help:      "Time waited for the rate limiter before transferring a file.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+	})
+
+	heartbeats = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "meta",
+		Name:      "heartbeats_total",
+		Help:      "Heartbeats to nebula meta, by result.",
+	}, []string{"result"})
+	heartbeatDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "meta",
+		Name:      "heartbeat_seconds",
+		Help:      "Latency of heartbeats to nebula meta.",
+		Buckets:   prometheus.DefBuckets,
+	})
+	metaLeader = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "meta",
+		Name:      "leader",
+		Help:      "The current meta leader the agent talks to, always 1.",
+	}, []string{"addr"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcHandled, rpcDuration,
+		transferBytes, transferFiles,
+		limiterWait,
+		heartbeats, heartbeatDuration, metaLeader,
+	)
+}
+
+// RegisterGauge export the value returned by fn when scraped
+func RegisterGauge(subsystem, name, help string, fn func() float64) {
+	Registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      name,
+		Help:      help,
+	}, fn))
+}
+
+// ObserveTransfer record a file transferred from or to the backend, such as local/s3/gs
+func ObserveTransfer(backend, direction string, bytes int64) {
+	transferBytes.WithLabelValues(backend, direction).Add(float64(bytes))
+	transferFiles.WithLabelValues(backend, direction).Inc()
+}
+
+func ObserveLimiterWait(d time.Duration) {
+	limiterWait.Observe(d.Seconds())
+}
+
+func ObserveHeartbeat(d time.Duration, err error) {
+	result := "succeeded"
+	if err != nil {
+		result = "failed"
+	}
+	heartbeats.WithLabelValues(result).Inc()
+	heartbeatDuration.Observe(d.Seconds())
+}
+
+func SetMetaLeader(addr string) {
+	metaLeader.Reset()
+	metaLeader.WithLabelValues(addr).Set(1)
+}
+
+func observeRPC(method string, start time.Time, err error) {
+	rpcHandled.WithLabelValues(method, status.Code(err).String()).Inc()
+	rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeRPC(info.FullMethod, start, err)
+	return resp, err
+}
+
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	observeRPC(info.FullMethod, start, err)
+	return err
+}
+
+// Serve export the metrics in http /metrics of the addr
+func Serve(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+	log.WithField("addr", addr).Info("Start metrics server.")
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.WithError(err).Error("Metrics server exited.")
+	}
+}
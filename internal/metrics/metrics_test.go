@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.AgentService/StartService"}
+	_, err := UnaryServerInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	assert.NotNil(err)
+	assert.EqualValues(1, testutil.ToFloat64(rpcHandled.WithLabelValues(info.FullMethod, codes.NotFound.String())))
+
+	ObserveTransfer("local", Upload, 100)
+	ObserveTransfer("local", Upload, 20)
+	assert.EqualValues(120, testutil.ToFloat64(transferBytes.WithLabelValues("local", Upload)))
+	assert.EqualValues(2, testutil.ToFloat64(transferFiles.WithLabelValues("local", Upload)))
+
+	ObserveHeartbeat(0, errors.New("timeout"))
+	assert.EqualValues(1, testutil.ToFloat64(heartbeats.WithLabelValues("failed")))
+
+	SetMetaLeader("192.168.8.1:9559")
+	SetMetaLeader("192.168.8.2:9559")
+	assert.Equal(1, testutil.CollectAndCount(metaLeader))
+}
@@ -2,25 +2,72 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/vesoft-inc/nebula-agent/v3/internal/clients"
+	"github.com/vesoft-inc/nebula-agent/v3/internal/job"
+	"github.com/vesoft-inc/nebula-agent/v3/internal/metrics"
 	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+	"github.com/vesoft-inc/nebula-agent/v3/pkg/storage"
 )
 
 // AgentServer act as an agent to interactive with services in agent machine
 type AgentServer struct {
-	meta *clients.NebulaMeta
+	meta  *clients.NebulaMeta
+	fence *clients.Fence
+	// watchdog is nil if not enabled
+	watchdog *clients.Watchdog
+	// janitor is nil if not enabled
+	janitor *clients.LogJanitor
+	// upgradeMu serializes the binary switches
+	upgradeMu sync.Mutex
+	spaces    *clients.SpaceScanner
 }
 
-func NewAgent(metaConfig *clients.MetaConfig) (*AgentServer, error) {
+const (
+	fenceCheckInterval = 30 * time.Second
+	// the ready timeout between the steps when starting several services
+	defaultReadyTimeoutSeconds = 120
+)
+
+// NewAgent create the agent server, the states surviving restart are kept in stateDir,
+// and the watchdog or log janitor is enabled when its config is not nil
+func NewAgent(metaConfig *clients.MetaConfig, watchdogConfig *clients.WatchdogConfig, janitorConfig *pb.LogRetentionConfig, stateDir string) (*AgentServer, error) {
 	metaclient, err := clients.NewMeta(metaConfig)
 	if err != nil {
 		return nil, err
 	}
+	clients.InitLearnedRoots(metaclient.RootDirs)
+	fence, err := clients.NewFence(metaclient, stateDir)
+	if err != nil {
+		return nil, err
+	}
+	go fence.Run(context.Background(), fenceCheckInterval)
 
 	a := &AgentServer{
-		meta: metaclient,
+		meta:   metaclient,
+		fence:  fence,
+		spaces: clients.NewSpaceScanner(),
+	}
+	if watchdogConfig != nil {
+		a.watchdog = clients.NewWatchdog(watchdogConfig, metaclient.LocalServices)
+		go a.watchdog.Run(context.Background())
+	}
+	if janitorConfig != nil {
+		a.janitor = clients.NewLogJanitor(janitorConfig, metaclient.LocalServices)
+		go a.janitor.Run(context.Background())
 	}
 	return a, nil
 }
@@ -29,24 +76,490 @@ func NewAgent(metaConfig *clients.MetaConfig) (*AgentServer, error) {
 func (a *AgentServer) StartService(ctx context.Context, req *pb.StartServiceRequest) (*pb.StartServiceResponse, error) {
 	resp := &pb.StartServiceResponse{}
 
-	d, err := clients.NewDaemon(clients.FromStartReq(req))
+	if len(req.GetRoles()) == 0 && req.GetRole() != pb.ServiceRole_ALL {
+		s, err := clients.FromStartReq(req)
+		if err != nil {
+			return resp, err
+		}
+		return resp, a.startService(ctx, s, req.GetReadyTimeoutSeconds())
+	}
+
+	roles := req.GetRoles()
+	if len(roles) == 0 {
+		roles = []pb.ServiceRole{req.GetRole()}
+	}
+	roles, err := clients.ExpandRoles(req.GetDir(), roles)
 	if err != nil {
-		return resp, fmt.Errorf("create service daemon failed when start service: %w", err)
+		return resp, err
 	}
 
-	return resp, d.Start()
+	// the later services depend on the former ones, so wait each one to be ready
+	timeout := req.GetReadyTimeoutSeconds()
+	if timeout <= 0 {
+		timeout = defaultReadyTimeoutSeconds
+	}
+	var failed error
+	for _, r := range roles {
+		res := &pb.RoleResult{Role: r}
+		if failed != nil {
+			res.Error = fmt.Sprintf("skipped since the former service failed: %v", failed)
+		} else if failed = a.startRole(ctx, r, req.GetDir(), timeout); failed != nil {
+			res.Error = failed.Error()
+		} else {
+			res.Succeeded = true
+		}
+		resp.Results = append(resp.Results, res)
+	}
+	return resp, rolesStatus("start", resp.Results)
 }
 
-// StartService stop metad/storaged/graphd/all service in agent machine
+// rolesStatus return the grpc status with the results as details if any role failed
+func rolesStatus(action string, results []*pb.RoleResult) error {
+	msgs := make([]string, 0)
+	details := make([]proto.Message, 0, len(results))
+	for _, r := range results {
+		if !r.Succeeded {
+			msgs = append(msgs, fmt.Sprintf("%s %s: %s", action, r.Role, r.Error))
+		}
+		details = append(details, r)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.Aborted, strings.Join(msgs, "; "))
+	if dst, err := st.WithDetails(details...); err == nil {
+		st = dst
+	}
+	return st.Err()
+}
+
+func (a *AgentServer) startRole(ctx context.Context, role pb.ServiceRole, dir string, readyTimeout int32) error {
+	s, err := clients.NewService(role, dir, "")
+	if err != nil {
+		return err
+	}
+	return a.startService(ctx, s, readyTimeout)
+}
+
+func (a *AgentServer) startService(ctx context.Context, s *clients.Service, readyTimeout int32) error {
+	d, err := clients.NewDaemon(s)
+	if err != nil {
+		return fmt.Errorf("create service daemon failed when start service: %w", err)
+	}
+
+	if err = startService(ctx, s, d, readyTimeout); err != nil {
+		return err
+	}
+	if a.watchdog != nil {
+		a.watchdog.Resume(s)
+	}
+	// the runtime bans are lost when the service restarted
+	go a.fence.Reapply()
+	return nil
+}
+
+// startService start the service and wait it to be ready if timeout greater than 0
+func startService(ctx context.Context, s *clients.Service, d clients.Daemon, readyTimeout int32) error {
+	if err := d.Start(); err != nil || readyTimeout <= 0 {
+		return err
+	}
+
+	c, err := clients.NewReadyChecker(s, d)
+	if err != nil {
+		return fmt.Errorf("create ready checker failed when start service: %w", err)
+	}
+	return notReadyStatus(c.Wait(ctx, time.Duration(readyTimeout)*time.Second))
+}
+
+// notReadyStatus convert the NotReadyError to grpc status with the reason and log tail as details
+func notReadyStatus(err error) error {
+	var e *clients.NotReadyError
+	if !errors.As(err, &e) {
+		return err
+	}
+
+	st, derr := status.New(codes.DeadlineExceeded, e.Error()).WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   "SERVICE_NOT_READY",
+			Metadata: map[string]string{"role": string(e.Role), "log_file": e.LogFile},
+		},
+		&errdetails.DebugInfo{
+			StackEntries: e.LogTail,
+			Detail:       e.Reason,
+		},
+	)
+	if derr != nil {
+		return err
+	}
+	return st.Err()
+}
+
+// StopService stop metad/storaged/graphd/all service in agent machine
 func (a *AgentServer) StopService(ctx context.Context, req *pb.StopServiceRequest) (*pb.StopServiceResponse, error) {
 	resp := &pb.StopServiceResponse{}
 
-	d, err := clients.NewDaemon(clients.FromStopReq(req))
+	if len(req.GetRoles()) == 0 && req.GetRole() != pb.ServiceRole_ALL {
+		s, err := clients.FromStopReq(req)
+		if err != nil {
+			return resp, err
+		}
+		return resp, a.stopService(s)
+	}
+
+	roles := req.GetRoles()
+	if len(roles) == 0 {
+		roles = []pb.ServiceRole{req.GetRole()}
+	}
+	roles, err := clients.ExpandRoles(req.GetDir(), roles)
+	if err != nil {
+		return resp, err
+	}
+
+	// stop in reverse order, and try all services even if some failed
+	for i := len(roles) - 1; i >= 0; i-- {
+		res := &pb.RoleResult{Role: roles[i]}
+		if err = a.stopRole(roles[i], req.GetDir()); err != nil {
+			res.Error = err.Error()
+		} else {
+			res.Succeeded = true
+		}
+		resp.Results = append(resp.Results, res)
+	}
+	return resp, rolesStatus("stop", resp.Results)
+}
+
+func (a *AgentServer) stopRole(role pb.ServiceRole, dir string) error {
+	s, err := clients.NewService(role, dir, "")
+	if err != nil {
+		return err
+	}
+	return a.stopService(s)
+}
+
+func (a *AgentServer) stopService(s *clients.Service) error {
+	d, err := clients.NewDaemon(s)
+	if err != nil {
+		return fmt.Errorf("create service daemon failed when stop service: %w", err)
+	}
+
+	// stopped on purpose, should not be restarted by watchdog
+	if a.watchdog != nil {
+		a.watchdog.Pause(s)
+	}
+	return d.Stop()
+}
+
+// resumeWatching watch the service again after it is started on purpose
+func (a *AgentServer) resumeWatching(s *clients.Service, err error) {
+	if a.watchdog == nil {
+		return
+	}
+	if err != nil {
+		a.watchdog.ResumeAfterFailure(s)
+	} else {
+		a.watchdog.Resume(s)
+	}
+}
+
+// RestartService stop and then start metad/storaged/graphd service in agent machine
+func (a *AgentServer) RestartService(ctx context.Context, req *pb.RestartServiceRequest) (resp *pb.RestartServiceResponse, err error) {
+	resp = &pb.RestartServiceResponse{}
+
+	s, err := clients.FromRestartReq(req)
+	if err != nil {
+		return resp, err
+	}
+	d, err := clients.NewDaemon(s)
+	if err != nil {
+		return resp, fmt.Errorf("create service daemon failed when restart service: %w", err)
+	}
+
+	// watch it again even if failed, so the watchdog restarts it with backoff
+	if a.watchdog != nil {
+		a.watchdog.Pause(s)
+		defer func() { a.resumeWatching(s, err) }()
+	}
+	if err = d.Stop(); err != nil {
+		return resp, fmt.Errorf("stop %s failed when restart service: %w", req.Role, err)
+	}
+	if err = startService(ctx, s, d, req.GetReadyTimeoutSeconds()); err != nil {
+		return resp, err
+	}
+	// the runtime bans are lost when the service restarted
+	go a.fence.Reapply()
+	return resp, nil
+}
+
+// RegisterMetrics re-export the stats of the local services learned from heartbeat in agent's /metrics
+func (a *AgentServer) RegisterMetrics() {
+	metrics.Registry.MustRegister(clients.NewStatsCollector(a.meta.LocalServices))
+}
+
+// UpgradeService install the binary in the release package and restart the service,
+// the previous binary is restored if the service is not ready after started
+func (a *AgentServer) UpgradeService(ctx context.Context, req *pb.UpgradeServiceRequest) (*pb.UpgradeServiceResponse, error) {
+	resp := &pb.UpgradeServiceResponse{}
+	a.upgradeMu.Lock()
+	defer a.upgradeMu.Unlock()
+
+	s, err := clients.NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+	if err != nil {
+		return resp, err
+	}
+	d, err := clients.NewDaemon(s)
+	if err != nil {
+		return resp, fmt.Errorf("create service daemon failed when upgrade service: %w", err)
+	}
+	b := clients.NewBinaryVersions(s)
+	if err = b.Exclusive(); err != nil {
+		return resp, err
+	}
+	if req.GetSource() == nil {
+		return resp, fmt.Errorf("the package to upgrade %s is not set", req.Role)
+	}
+	sto, err := storage.New(req.GetSource())
+	if err != nil {
+		return resp, fmt.Errorf("create storage failed when upgrade service: %w", err)
+	}
+
+	version := req.GetVersion()
+	if version == "" {
+		version = clients.PackageVersion(req.GetSource().Uri())
+	}
+	prev, err := b.SaveCurrent()
+	if err != nil {
+		return resp, err
+	}
+	if err = b.Stage(ctx, sto, req.GetSource().Uri(), req.GetSha256(), version); err != nil {
+		return resp, err
+	}
+
+	timeout := req.GetReadyTimeoutSeconds()
+	if timeout <= 0 {
+		timeout = defaultReadyTimeoutSeconds
+	}
+	if err = a.switchBinary(ctx, s, d, b, version, timeout); err != nil {
+		log.WithError(err).Warnf("Upgrade %s to %s failed, roll back to %s.", req.Role, version, prev)
+		if rerr := a.switchBinary(ctx, s, d, b, prev, timeout); rerr != nil {
+			return resp, fmt.Errorf("upgrade %s to %s failed: %v, and roll back to %s failed: %w", req.Role, version, err, prev, rerr)
+		}
+		return resp, fmt.Errorf("upgrade %s to %s failed, rolled back to %s: %w", req.Role, version, prev, err)
+	}
+
+	keep := int(req.GetKeepVersions())
+	if keep <= 0 {
+		keep = clients.DefaultKeepVersions
+	}
+	b.Prune(keep, prev)
+
+	resp.PreviousVersion = prev
+	resp.Versions, err = b.List()
+	return resp, err
+}
+
+// RollbackServiceBinary switch the service to a kept binary version and restart it
+func (a *AgentServer) RollbackServiceBinary(ctx context.Context, req *pb.RollbackServiceBinaryRequest) (*pb.RollbackServiceBinaryResponse, error) {
+	resp := &pb.RollbackServiceBinaryResponse{}
+	a.upgradeMu.Lock()
+	defer a.upgradeMu.Unlock()
+
+	s, err := clients.NewService(req.GetRole(), req.GetDir(), req.GetInstance())
+	if err != nil {
+		return resp, err
+	}
+	d, err := clients.NewDaemon(s)
+	if err != nil {
+		return resp, fmt.Errorf("create service daemon failed when rollback service binary: %w", err)
+	}
+	b := clients.NewBinaryVersions(s)
+	if err = b.Exclusive(); err != nil {
+		return resp, err
+	}
+	if !b.Exist(req.GetVersion()) {
+		return resp, fmt.Errorf("version %s of %s not found", req.GetVersion(), req.Role)
+	}
+	if resp.PreviousVersion, err = b.SaveCurrent(); err != nil {
+		return resp, err
+	}
+
+	timeout := req.GetReadyTimeoutSeconds()
+	if timeout <= 0 {
+		timeout = defaultReadyTimeoutSeconds
+	}
+	return resp, a.switchBinary(ctx, s, d, b, req.GetVersion(), timeout)
+}
+
+// ListServiceVersions return the binary versions kept in agent machine
+func (a *AgentServer) ListServiceVersions(ctx context.Context, req *pb.ListServiceVersionsRequest) (*pb.ListServiceVersionsResponse, error) {
+	resp := &pb.ListServiceVersionsResponse{}
+
+	s, err := clients.NewService(req.GetRole(), req.GetDir(), "")
+	if err != nil {
+		return resp, err
+	}
+	b := clients.NewBinaryVersions(s)
+	resp.Current = b.Current()
+	resp.Versions, err = b.List()
+	return resp, err
+}
+
+// switchBinary stop the service, install the binary of the version and start it again,
+// the service is watched again by the watchdog even if failed
+func (a *AgentServer) switchBinary(ctx context.Context, s *clients.Service, d clients.Daemon, b *clients.BinaryVersions,
+	version string, readyTimeout int32) (err error) {
+	if a.watchdog != nil {
+		a.watchdog.Pause(s)
+		defer func() { a.resumeWatching(s, err) }()
+	}
+	if err = d.Stop(); err != nil {
+		return fmt.Errorf("stop service failed when switch binary: %w", err)
+	}
+	if err = b.Install(version); err != nil {
+		return err
+	}
+	if err = startService(ctx, s, d, readyTimeout); err != nil {
+		return err
+	}
+	go a.fence.Reapply()
+	return nil
+}
+
+// GetHostInfo return the resources of agent machine and the usage of the dirs of local services
+func (a *AgentServer) GetHostInfo(ctx context.Context, req *pb.GetHostInfoRequest) (*pb.GetHostInfoResponse, error) {
+	resp, err := clients.CollectHostInfo(a.meta.LocalDirs())
+	if err != nil {
+		return &pb.GetHostInfoResponse{}, fmt.Errorf("collect host info failed: %w", err)
+	}
+	return resp, nil
+}
+
+// GetServiceDirs return the root and data dirs of the local services reported by meta heartbeat
+func (a *AgentServer) GetServiceDirs(ctx context.Context, req *pb.GetServiceDirsRequest) (*pb.GetServiceDirsResponse, error) {
+	return &pb.GetServiceDirsResponse{Services: a.meta.ServiceDirs(req.GetRole())}, nil
+}
+
+// CollectDiagnostics build a bundle of the logs, configs and runtime states of the service,
+// and upload it to the target if given
+func (a *AgentServer) CollectDiagnostics(ctx context.Context, req *pb.CollectDiagnosticsRequest) (*pb.CollectDiagnosticsResponse, error) {
+	s, err := clients.FromCollectDiagnosticsReq(req)
 	if err != nil {
-		return resp, fmt.Errorf("create service daemon failed when stop service: %w", err)
+		return &pb.CollectDiagnosticsResponse{}, err
+	}
+	d := clients.NewDiagnostics(s, req)
+	resp, err := d.Collect()
+	if err != nil {
+		return &pb.CollectDiagnosticsResponse{}, fmt.Errorf("collect diagnostics of %s failed: %w", req.GetRole(), err)
+	}
+	if req.GetTarget() == nil {
+		return resp, nil
 	}
 
-	return resp, d.Stop()
+	sto, err := storage.New(req.GetTarget())
+	if err != nil {
+		return resp, err
+	}
+	uri := storage.JoinUri(req.GetTarget().Uri(), d.Name()+".tar.gz")
+	if err = sto.Upload(ctx, uri, resp.Path, false); err != nil {
+		return resp, fmt.Errorf("upload diagnostics bundle to %s failed: %w", uri, err)
+	}
+	if err = os.Remove(resp.Path); err != nil {
+		log.WithError(err).WithField("path", resp.Path).Warn("Remove uploaded diagnostics bundle failed.")
+	}
+	resp.Uri = uri
+	return resp, nil
+}
+
+// CleanLogs apply the log retention policies to the local services now
+func (a *AgentServer) CleanLogs(ctx context.Context, req *pb.CleanLogsRequest) (*pb.CleanLogsResponse, error) {
+	resp := &pb.CleanLogsResponse{}
+	if a.janitor == nil {
+		return resp, fmt.Errorf("log janitor is not enabled, set --log_retention")
+	}
+	resp.Reports = a.janitor.Clean(ctx, req.GetDryRun())
+	return resp, nil
+}
+
+// LogJanitorStatus return the retention policies and the reports of the last cleanup
+func (a *AgentServer) LogJanitorStatus(ctx context.Context, req *pb.LogJanitorStatusRequest) (*pb.LogJanitorStatusResponse, error) {
+	resp := &pb.LogJanitorStatusResponse{}
+	if a.janitor == nil {
+		return resp, nil
+	}
+
+	resp.Enabled = true
+	resp.Config = a.janitor.Config()
+	resp.Reports = a.janitor.Reports()
+	return resp, nil
+}
+
+// ListCheckpoints return the checkpoints in the storaged data paths with their sizes
+func (a *AgentServer) ListCheckpoints(ctx context.Context, req *pb.ListCheckpointsRequest) (*pb.ListCheckpointsResponse, error) {
+	resp := &pb.ListCheckpointsResponse{}
+	paths, err := a.dataPaths(req.GetDataPaths())
+	if err != nil {
+		return resp, err
+	}
+	if len(paths) == 0 {
+		paths = a.meta.StorageSpaceDirs()
+	}
+
+	checkpoints, err := clients.NewCheckpoints(paths).List(req.GetSpaceId())
+	if err != nil {
+		return resp, fmt.Errorf("list checkpoints failed: %w", err)
+	}
+	resp.Checkpoints = checkpoints
+	return resp, nil
+}
+
+// DropCheckpoint remove the checkpoint in the data paths of local storaged in meta,
+// the dirs elsewhere are never touched
+func (a *AgentServer) DropCheckpoint(ctx context.Context, req *pb.DropCheckpointRequest) (*pb.DropCheckpointResponse, error) {
+	resp := &pb.DropCheckpointResponse{}
+	paths := a.meta.StorageSpaceDirs()
+	if len(paths) == 0 {
+		return resp, fmt.Errorf("no local storaged found in meta")
+	}
+
+	dropped, err := clients.NewCheckpoints(paths).Drop(req.GetName(), req.GetSpaceId())
+	resp.Dropped = dropped
+	if err != nil {
+		return resp, err
+	}
+	if len(dropped) == 0 {
+		return resp, status.Errorf(codes.NotFound, "checkpoint %s not found", req.GetName())
+	}
+	return resp, nil
+}
+
+// WatchdogStatus return the services watched by watchdog and their crashes
+func (a *AgentServer) WatchdogStatus(ctx context.Context, req *pb.WatchdogStatusRequest) (*pb.WatchdogStatusResponse, error) {
+	resp := &pb.WatchdogStatusResponse{}
+	if a.watchdog == nil {
+		return resp, nil
+	}
+
+	resp.Enabled = true
+	resp.Services = a.watchdog.Status()
+	return resp, nil
+}
+
+// ListServices return the services in agent machine with their status in meta
+func (a *AgentServer) ListServices(ctx context.Context, req *pb.ListServicesRequest) (*pb.ListServicesResponse, error) {
+	resp := &pb.ListServicesResponse{}
+
+	services, err := a.meta.ListServices()
+	if err != nil {
+		return resp, fmt.Errorf("list services failed: %w", err)
+	}
+	resp.Services = services
+	return resp, nil
+}
+
+// BalanceLeader submit leader balance job to meta for all spaces
+func (a *AgentServer) BalanceLeader(ctx context.Context, req *pb.BalanceLeaderRequest) (*pb.BalanceLeaderResponse, error) {
+	resp := &pb.BalanceLeaderResponse{}
+	return resp, a.meta.BalanceLeader()
 }
 
 // ServiceStatus return the status(exit or running) of metad/storaged/graphd/all service in agent machine
@@ -55,12 +568,16 @@ func (a *AgentServer) ServiceStatus(ctx context.Context, req *pb.ServiceStatusRe
 		Status: pb.Status_UNKNOWN_STATUS,
 	}
 
-	d, err := clients.NewDaemon(clients.FromStatusReq(req))
+	s, err := clients.FromStatusReq(req)
+	if err != nil {
+		return resp, err
+	}
+	d, err := clients.NewDaemon(s)
 	if err != nil {
 		return resp, fmt.Errorf("create service daemon failed when get service status: %w", err)
 	}
 
-	resp.Status, err = d.Status()
+	resp, err = d.Status()
 	if err != nil {
 		return resp, fmt.Errorf("get %s status by daemon failed: %w", req.Role, err)
 	}
@@ -68,20 +585,165 @@ func (a *AgentServer) ServiceStatus(ctx context.Context, req *pb.ServiceStatusRe
 	return resp, nil
 }
 
-// TODO(spw): should call graphd's corresponding interface
-func (a *AgentServer) BanReadWrite(context.Context, *pb.BanReadWriteRequest) (*pb.BanReadWriteResponse, error) {
-	return nil, nil
+// TailLogs stream the log lines of metad/storaged/graphd in agent machine
+func (a *AgentServer) TailLogs(req *pb.TailLogsRequest, stream pb.AgentService_TailLogsServer) error {
+	s, err := clients.FromTailLogsReq(req)
+	if err != nil {
+		return err
+	}
+	t, err := clients.NewLogTailer(s, req)
+	if err != nil {
+		return fmt.Errorf("create log tailer failed: %w", err)
+	}
+	return t.Run(stream.Context(), stream.Send)
 }
 
-// TODO(spw): should call graphd's corresponding interface
-func (a *AgentServer) AllowReadWrite(context.Context, *pb.AllowReadWriteRequest) (*pb.AllowReadWriteResponse, error) {
-	return nil, nil
+// GetServiceConfig return the current or backup config of metad/storaged/graphd in agent machine
+func (a *AgentServer) GetServiceConfig(ctx context.Context, req *pb.GetServiceConfigRequest) (*pb.GetServiceConfigResponse, error) {
+	s, err := clients.FromGetConfigReq(req)
+	if err != nil {
+		return &pb.GetServiceConfigResponse{}, err
+	}
+	resp, err := clients.NewServiceConfig(s).Get(req.GetVersion())
+	if err != nil {
+		return &pb.GetServiceConfigResponse{}, fmt.Errorf("get %s config failed: %w", req.Role, err)
+	}
+	return resp, nil
+}
+
+// UpdateServiceConfig change the flags in config file, the previous config is backed up
+func (a *AgentServer) UpdateServiceConfig(ctx context.Context, req *pb.UpdateServiceConfigRequest) (*pb.UpdateServiceConfigResponse, error) {
+	s, err := clients.FromUpdateConfigReq(req)
+	if err != nil {
+		return &pb.UpdateServiceConfigResponse{}, err
+	}
+	resp, err := clients.NewServiceConfig(s).Update(req.GetSet(), req.GetRemove(), req.GetDryRun())
+	if err != nil {
+		return &pb.UpdateServiceConfigResponse{}, fmt.Errorf("update %s config failed: %w", req.Role, err)
+	}
+	return resp, nil
+}
+
+// RollbackServiceConfig restore the config file to a backup version
+func (a *AgentServer) RollbackServiceConfig(ctx context.Context, req *pb.RollbackServiceConfigRequest) (*pb.RollbackServiceConfigResponse, error) {
+	s, err := clients.FromRollbackConfigReq(req)
+	if err != nil {
+		return &pb.RollbackServiceConfigResponse{}, err
+	}
+	resp, err := clients.NewServiceConfig(s).Rollback(req.GetVersion())
+	if err != nil {
+		return &pb.RollbackServiceConfigResponse{}, fmt.Errorf("rollback %s config failed: %w", req.Role, err)
+	}
+	return resp, nil
+}
+
+// GetFlags return the runtime flags of metad/storaged/graphd in agent machine
+func (a *AgentServer) GetFlags(ctx context.Context, req *pb.GetFlagsRequest) (*pb.GetFlagsResponse, error) {
+	resp := &pb.GetFlagsResponse{}
+
+	s, err := a.meta.FindLocalService(req.GetRole(), req.GetAddr())
+	if err != nil {
+		return resp, err
+	}
+	c, err := clients.NewFlagsClient(s)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Flags, err = c.Get(req.GetNames())
+	return resp, err
+}
+
+// SetFlags change the runtime flags of metad/storaged/graphd, and optionally write them to the config file
+func (a *AgentServer) SetFlags(ctx context.Context, req *pb.SetFlagsRequest) (*pb.SetFlagsResponse, error) {
+	resp := &pb.SetFlagsResponse{}
+
+	s, err := a.meta.FindLocalService(req.GetRole(), req.GetAddr())
+	if err != nil {
+		return resp, err
+	}
+	c, err := clients.NewFlagsClient(s)
+	if err != nil {
+		return resp, err
+	}
+	if err = c.Set(req.GetFlags()); err != nil {
+		return resp, err
+	}
+
+	if req.GetPersist() {
+		cresp, err := clients.NewServiceConfig(s).Update(req.GetFlags(), nil, false)
+		if err != nil {
+			return resp, fmt.Errorf("flags changed at runtime but persist failed: %w", err)
+		}
+		resp.BackupVersion = cresp.BackupVersion
+	}
+	return resp, nil
+}
+
+// BanReadWrite block the writes of storaged or the new connections of graphd in agent machine,
+// the reads and the existing sessions are not blocked. The ban is kept until AllowReadWrite
+// even if the agent or the service restarted
+func (a *AgentServer) BanReadWrite(ctx context.Context, req *pb.BanReadWriteRequest) (*pb.BanReadWriteResponse, error) {
+	resp := &pb.BanReadWriteResponse{}
+	return resp, a.fence.Ban(req.GetRole(), req.GetAddr())
+}
+
+func (a *AgentServer) AllowReadWrite(ctx context.Context, req *pb.AllowReadWriteRequest) (*pb.AllowReadWriteResponse, error) {
+	resp := &pb.AllowReadWriteResponse{}
+	return resp, a.fence.Allow(req.GetRole(), req.GetAddr())
+}
+
+// ReadWriteStatus return the bans in agent machine and what they block
+func (a *AgentServer) ReadWriteStatus(ctx context.Context, req *pb.ReadWriteStatusRequest) (*pb.ReadWriteStatusResponse, error) {
+	return &pb.ReadWriteStatusResponse{
+		Bans: a.fence.Status(),
+	}, nil
 }
 
 func (a *AgentServer) DataPlayBack(ctx context.Context, req *pb.DataPlayBackRequest) (*pb.DataPlayBackResponse, error) {
 	resp := &pb.DataPlayBackResponse{}
 
-	return resp, clients.NewPlayBack(req).PlayBack()
+	if req.GetDir() == "" || req.GetDataPath() == "" {
+		dirs := a.meta.ServiceDirs(pb.ServiceRole_STORAGE)
+		if len(dirs) != 1 || len(dirs[0].DataDirs) != 1 {
+			return resp, fmt.Errorf("dir and data path needed, %d local storaged found in meta", len(dirs))
+		}
+		if req.GetDir() == "" {
+			req.Dir = dirs[0].RootDir
+		}
+		if req.GetDataPath() == "" {
+			req.DataPath = dirs[0].DataDirs[0]
+		}
+	}
+	if err := clients.ValidatePath(req.GetDir()); err != nil {
+		return resp, status.Errorf(codes.InvalidArgument, "bad dir: %v", err)
+	}
+	var known []string
+	for _, d := range a.meta.ServiceDirs(pb.ServiceRole_STORAGE) {
+		known = append(known, d.DataDirs...)
+	}
+	if _, err := validPaths([]string{req.GetDataPath()}, known); err != nil {
+		return resp, err
+	}
+
+	p := clients.NewPlayBack(req)
+	j := job.Jobs.Submit(job.KindDataPlayBack, p.Run)
+	resp.JobId = j.Info().Id
+	resp.LogFile = p.LogFile(resp.JobId)
+	if req.GetAsync() {
+		return resp, nil
+	}
+
+	// the playback goes on if the rpc is cancelled, and could be got by the job id
+	select {
+	case <-j.Done():
+	case <-ctx.Done():
+		return resp, ctx.Err()
+	}
+	if info := j.Info(); info.Status != pb.JobStatus_JOB_SUCCEEDED {
+		return resp, errors.New(info.Error)
+	}
+	return resp, nil
 }
 
 func (a *AgentServer) StopAgent(ctx context.Context, req *pb.StopAgentRequest) (*pb.StopAgentResponse, error) {
@@ -96,5 +758,71 @@ func (a *AgentServer) HealthCheck(ctx context.Context, req *pb.HealthCheckReques
 }
 
 func (a *AgentServer) GetSpaceUsages(ctx context.Context, req *pb.GetSpaceUsagesRequest) (*pb.GetSpaceUsagesResponse, error) {
-	return clients.NewSpaceUsage(req.DataPath).GetSpaceUsages()
+	resp := &pb.GetSpaceUsagesResponse{}
+	paths, err := a.dataPaths(append([]string{req.GetDataPath()}, req.GetDataPaths()...))
+	if err != nil {
+		return resp, err
+	}
+	if len(paths) == 0 {
+		paths = a.meta.StorageSpaceDirs()
+	}
+	if len(paths) == 0 {
+		return resp, fmt.Errorf("no data path given and no local storaged found in meta")
+	}
+
+	// rescan unless the caller accepts the cached usages
+	maxAge := time.Duration(req.GetMaxAgeSeconds()) * time.Second
+	usages, err := a.spaces.GetSpaceUsages(paths, maxAge)
+	if err != nil {
+		return resp, err
+	}
+	return usages, nil
+}
+
+// dataPaths clean and deduplicate the storaged data paths in request, each one should be in the data paths
+// of the local storaged in meta or in the allowed roots
+func (a *AgentServer) dataPaths(paths []string) ([]string, error) {
+	return validPaths(paths, a.meta.StorageSpaceDirs())
+}
+
+// validPaths clean and deduplicate the paths in request, each one should be in the known dirs or in the allowed roots
+func validPaths(paths, known []string) ([]string, error) {
+	res := make([]string, 0, len(paths))
+	seen := make(map[string]bool)
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		p = filepath.Clean(p)
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+
+		if !underAny(realPath(p), known) {
+			if err := clients.ValidatePath(p); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "bad path: %v", err)
+			}
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+func realPath(path string) string {
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		return p
+	}
+	return filepath.Clean(path)
+}
+
+// underAny tell whether the resolved path is one of the dirs or in them
+func underAny(path string, dirs []string) bool {
+	for _, d := range dirs {
+		d = realPath(d)
+		if path == d || strings.HasPrefix(path, d+"/") {
+			return true
+		}
+	}
+	return false
 }
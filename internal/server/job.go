@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+
+	"github.com/vesoft-inc/nebula-agent/v3/internal/job"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+// JobServer query and cancel the background jobs in agent machine
+type JobServer struct{}
+
+func NewJob() *JobServer {
+	return &JobServer{}
+}
+
+func (js *JobServer) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.GetJobResponse, error) {
+	resp := &pb.GetJobResponse{}
+
+	j, err := job.Jobs.Get(req.GetId())
+	if err != nil {
+		return resp, err
+	}
+	resp.Job = j.Info()
+	return resp, nil
+}
+
+func (js *JobServer) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	return &pb.ListJobsResponse{
+		Jobs: job.Jobs.List(req.GetKind()),
+	}, nil
+}
+
+func (js *JobServer) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.CancelJobResponse, error) {
+	resp := &pb.CancelJobResponse{}
+	return resp, job.Jobs.Cancel(req.GetId())
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/golang/groupcache/lru"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/vesoft-inc/nebula-agent/v3/internal/job"
 	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
 	"github.com/vesoft-inc/nebula-agent/v3/pkg/storage"
 )
@@ -19,14 +21,26 @@ import (
 type StorageServer struct {
 	s  *lru.Cache
 	mu sync.Mutex
+
+	host    string
+	version string
 }
 
-func NewStorage() *StorageServer {
+func NewStorage(host, version string) *StorageServer {
 	return &StorageServer{
-		s: lru.New(32),
+		s:       lru.New(32),
+		host:    host,
+		version: version,
 	}
 }
 
+// Sessions return the count of cached storage sessions
+func (ss *StorageServer) Sessions() int {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.s.Len()
+}
+
 // TOOD(spw): need to check same storage info
 func (ss *StorageServer) getStorage(sid string, b *pb.Backend) (storage.ExternalStorage, error) {
 	ss.mu.Lock()
@@ -46,6 +60,18 @@ func (ss *StorageServer) getStorage(sid string, b *pb.Backend) (storage.External
 	return sto, nil
 }
 
+// recordCatalog write the upload entry of the session to its own file in the catalog dir
+func (ss *StorageServer) recordCatalog(ctx context.Context, sid string, sto storage.ExternalStorage, dir *pb.Backend, e *pb.CatalogEntry) error {
+	c := &pb.BackupCatalog{
+		SessionId:    sid,
+		Host:         ss.host,
+		AgentVersion: ss.version,
+		CreateTime:   e.StartTime,
+		Entries:      []*pb.CatalogEntry{e},
+	}
+	return storage.WriteCatalog(ctx, sto, storage.JoinUri(dir.Uri(), storage.CatalogEntryName(ss.host, sid)), c)
+}
+
 // UploadFile upload the file or directory recursively from agent machine to external storage
 func (ss *StorageServer) UploadFile(ctx context.Context, req *pb.UploadFileRequest) (*pb.UploadFileResponse, error) {
 	log.WithFields(
@@ -63,11 +89,35 @@ func (ss *StorageServer) UploadFile(ctx context.Context, req *pb.UploadFileReque
 		return res, err
 	}
 
-	err = sto.Upload(ctx, req.GetTargetBackend().Uri(), req.GetSourcePath(), req.GetRecursively())
+	start := time.Now()
+	var sums *storage.Checksums
+	if req.GetCatalog() != nil {
+		ctx, sums = storage.WithChecksums(ctx)
+	}
+
+	if req.GetDedupPool() != nil {
+		if !req.GetRecursively() {
+			return res, fmt.Errorf("dedup upload only supports directory, %s", req.GetSourcePath())
+		}
+		err = storage.NewDedup(sto, req.GetDedupPool().Uri()).Upload(ctx, req.GetTargetBackend().Uri(), req.GetSourcePath())
+	} else {
+		err = sto.Upload(ctx, req.GetTargetBackend().Uri(), req.GetSourcePath(), req.GetRecursively())
+	}
 	if err != nil {
 		return res, err
 	}
 
+	if req.GetCatalog() != nil {
+		e, err := storage.NewCatalogEntry(req.GetSourcePath(), req.GetTargetBackend().Uri(), sums)
+		if err != nil {
+			return res, err
+		}
+		e.StartTime, e.EndTime = start.Unix(), time.Now().Unix()
+		if err = ss.recordCatalog(ctx, req.GetSessionId(), sto, req.GetCatalog(), e); err != nil {
+			return res, err
+		}
+	}
+
 	return res, nil
 }
 
@@ -88,11 +138,27 @@ func (ss *StorageServer) IncrUploadFile(ctx context.Context, req *pb.IncrUploadF
 		return res, err
 	}
 
+	start := time.Now()
+	var sums *storage.Checksums
+	if req.GetCatalog() != nil {
+		ctx, sums = storage.WithChecksums(ctx)
+	}
 	err = sto.IncrUpload(ctx, req.GetTargetBackend().Uri(), req.GetSourcePath(), req.GetCommitLogId(), req.GetLastLogId())
 	if err != nil {
 		return res, err
 	}
 
+	if req.GetCatalog() != nil {
+		e, err := storage.NewIncrCatalogEntry(ctx, sto, req.GetSourcePath(), req.GetTargetBackend().Uri(), sums)
+		if err != nil {
+			return res, err
+		}
+		e.StartTime, e.EndTime = start.Unix(), time.Now().Unix()
+		if err = ss.recordCatalog(ctx, req.GetSessionId(), sto, req.GetCatalog(), e); err != nil {
+			return res, err
+		}
+	}
+
 	return res, nil
 }
 
@@ -118,6 +184,13 @@ func (ss *StorageServer) DownloadFile(ctx context.Context, req *pb.DownloadFileR
 		return res, err
 	}
 
+	if req.GetDedupPool() != nil {
+		err = storage.NewDedup(sto, req.GetDedupPool().Uri()).Resolve(ctx, req.GetTargetPath())
+		if err != nil {
+			return res, err
+		}
+	}
+
 	return res, nil
 }
 
@@ -171,3 +244,81 @@ func (ss *StorageServer) ExistDir(ctx context.Context, req *pb.ExistDirRequest)
 
 	return res, err
 }
+
+// DescribeBackup read the catalogs in the catalog dir, or the single catalog file
+func (ss *StorageServer) DescribeBackup(ctx context.Context, req *pb.DescribeBackupRequest) (*pb.DescribeBackupResponse, error) {
+	log.WithField("catalog", req.GetCatalog().Uri()).Debug("Describe backup.")
+	res := &pb.DescribeBackupResponse{}
+
+	sto, err := ss.getStorage(req.GetSessionId(), req.GetCatalog())
+	if err != nil {
+		return res, err
+	}
+
+	res.Catalogs, err = storage.ReadCatalogs(ctx, sto, req.GetCatalog().Uri())
+	return res, err
+}
+
+// VerifyBackup start a job to audit the remote backup against its catalogs
+func (ss *StorageServer) VerifyBackup(ctx context.Context, req *pb.VerifyBackupRequest) (*pb.VerifyBackupResponse, error) {
+	log.WithFields(
+		log.Fields{
+			"session_id": req.GetSessionId(),
+			"backend":    req.GetBackend().Uri(),
+			"catalog":    req.GetCatalog().Uri(),
+			"rehash":     req.GetRehash(),
+		},
+	).Debug("Verify backup.")
+	res := &pb.VerifyBackupResponse{}
+
+	sto, err := ss.getStorage(req.GetSessionId(), req.GetBackend())
+	if err != nil {
+		return res, err
+	}
+
+	catalogs, err := storage.ReadCatalogs(ctx, sto, req.GetCatalog().Uri())
+	if err != nil {
+		return res, err
+	}
+	if len(catalogs) == 0 {
+		return res, fmt.Errorf("no catalog found in %s", req.GetCatalog().Uri())
+	}
+
+	j := job.Jobs.Submit(job.KindVerifyBackup, func(ctx context.Context, j *job.Job) error {
+		v := storage.NewVerifier(sto, req.GetBackend().Uri(), catalogs, req.GetRehash())
+		v.Progress = func(checked, total int) {
+			j.SetProgress(float64(checked)/float64(total), fmt.Sprintf("checked %d/%d files", checked, total))
+		}
+
+		report, err := v.Verify(ctx)
+		if err != nil {
+			return err
+		}
+		j.Update(func(info *pb.JobInfo) {
+			info.VerifyReport = report
+		})
+		if n := len(report.Missing) + len(report.Extra) + len(report.Corrupt); n != 0 {
+			return fmt.Errorf("backup %s is inconsistent with catalog, %d missing, %d extra, %d corrupt files",
+				req.GetBackend().Uri(), len(report.Missing), len(report.Extra), len(report.Corrupt))
+		}
+		return nil
+	})
+
+	res.JobId = j.Info().Id
+	return res, nil
+}
+
+// PruneDedupPool garbage-collect the objects in the dedup pool which no backup references
+func (ss *StorageServer) PruneDedupPool(ctx context.Context, req *pb.PruneDedupPoolRequest) (*pb.PruneDedupPoolResponse, error) {
+	log.WithField("pool", req.GetPool().Uri()).Debug("Prune dedup pool.")
+	res := &pb.PruneDedupPoolResponse{}
+
+	sto, err := ss.getStorage(req.GetSessionId(), req.GetPool())
+	if err != nil {
+		return res, err
+	}
+
+	removed, err := storage.NewDedup(sto, req.GetPool().Uri()).Prune(ctx)
+	res.Removed = int64(removed)
+	return res, err
+}
@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var flagNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// FlagFile is the gflags style config file used by nebula services, such as:
+//
+//	########## basics ##########
+//	# Whether to run as a daemon process
+//	--daemonize=true
+//	--pid_file=pids/nebula-metad.pid
+//
+// the comments, blank lines and order are kept when modified
+type FlagFile struct {
+	lines []string
+}
+
+// parseFlagLine return the flag name and value, ok is false for comments and blank lines
+func parseFlagLine(line string) (k, v string, ok bool, err error) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", "", false, nil
+	}
+	if !strings.HasPrefix(line, "-") {
+		return "", "", false, fmt.Errorf("bad flag line: %s", line)
+	}
+
+	line = strings.TrimLeft(line, "-")
+	k, v, found := strings.Cut(line, "=")
+	if !found {
+		// bool flag without value, such as --enable_ssl
+		v = "true"
+	}
+	return strings.TrimSpace(k), strings.TrimSpace(v), true, nil
+}
+
+func NewFlagFile(data []byte) (*FlagFile, error) {
+	f := &FlagFile{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if _, _, _, err := parseFlagLine(line); err != nil {
+			return nil, err
+		}
+		f.lines = append(f.lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func LoadFlagFile(path string) (*FlagFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := NewFlagFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse flag file %s failed: %w", path, err)
+	}
+	return f, nil
+}
+
+// Flags return all flags, the later flag overrides the former one
+func (f *FlagFile) Flags() map[string]string {
+	flags := make(map[string]string)
+	for _, line := range f.lines {
+		if k, v, ok, _ := parseFlagLine(line); ok {
+			flags[k] = v
+		}
+	}
+	return flags
+}
+
+// Set update the last line of the flag, or append it if not found
+func (f *FlagFile) Set(k, v string) error {
+	if !flagNamePattern.MatchString(k) {
+		return fmt.Errorf("bad flag name: %s", k)
+	}
+	if strings.ContainsAny(v, "\r\n") {
+		return fmt.Errorf("bad value of flag %s: should be in one line", k)
+	}
+
+	line := fmt.Sprintf("--%s=%s", k, v)
+	for i := len(f.lines) - 1; i >= 0; i-- {
+		if name, _, ok, _ := parseFlagLine(f.lines[i]); ok && name == k {
+			f.lines[i] = line
+			return nil
+		}
+	}
+	f.lines = append(f.lines, line)
+	return nil
+}
+
+// Remove delete all lines of the flag
+func (f *FlagFile) Remove(k string) {
+	lines := f.lines[:0]
+	for _, line := range f.lines {
+		if name, _, ok, _ := parseFlagLine(line); ok && name == k {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	f.lines = lines
+}
+
+func (f *FlagFile) Bytes() []byte {
+	return []byte(strings.Join(f.lines, "\n") + "\n")
+}
+
+// Save write the flag file atomically by renaming a temp file
+func (f *FlagFile) Save(path string) error {
+	return WriteFileAtomic(path, f.Bytes(), 0644)
+}
+
+// WriteFileAtomic write the data to a temp file in the same dir and rename it to the path
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ParseFlagFile return the flags in the flag file
+func ParseFlagFile(path string) (map[string]string, error) {
+	f, err := LoadFlagFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return f.Flags(), nil
+}
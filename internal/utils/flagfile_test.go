@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlagFile(t *testing.T) {
+	assert := assert.New(t)
+	content := `########## basics ##########
+# Whether to run as a daemon process
+--daemonize=true
+--pid_file=pids/nebula-storaged.pid
+--enable_ssl
+
+--v=0
+--v=1
+`
+	f, err := NewFlagFile([]byte(content))
+	assert.Nil(err)
+	flags := f.Flags()
+	assert.Equal("true", flags["daemonize"])
+	assert.Equal("true", flags["enable_ssl"])
+	assert.Equal("1", flags["v"])
+
+	assert.Nil(f.Set("v", "3"))
+	assert.Nil(f.Set("wal_ttl", "3600"))
+	f.Remove("enable_ssl")
+	assert.NotNil(f.Set("bad-name", "1"))
+	assert.NotNil(f.Set("v", "1\n--daemonize=false"))
+
+	path := filepath.Join(t.TempDir(), "nebula-storaged.conf")
+	assert.Nil(f.Save(path))
+	data, err := os.ReadFile(path)
+	assert.Nil(err)
+	assert.Equal(`########## basics ##########
+# Whether to run as a daemon process
+--daemonize=true
+--pid_file=pids/nebula-storaged.pid
+
+--v=0
+--v=3
+--wal_ttl=3600
+`, string(data))
+
+	_, err = NewFlagFile([]byte("daemonize=true\n"))
+	assert.NotNil(err)
+}
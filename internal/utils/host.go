@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// LoadAvg return the 1, 5 and 15 minutes load average in /proc/loadavg
+func LoadAvg() ([3]float64, error) {
+	var avg [3]float64
+	data, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return avg, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) < 3 {
+		return avg, fmt.Errorf("bad /proc/loadavg: %s", data)
+	}
+	for i := range avg {
+		if avg[i], err = strconv.ParseFloat(fields[i], 64); err != nil {
+			return avg, err
+		}
+	}
+	return avg, nil
+}
+
+// MemInfo return the fields in /proc/meminfo in bytes, such as MemTotal and MemAvailable
+func MemInfo() (map[string]uint64, error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info := make(map[string]uint64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// MemTotal:       16310484 kB
+		k, v, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		fields := strings.Fields(v)
+		if len(fields) == 0 {
+			continue
+		}
+		n, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		if len(fields) > 1 && fields[1] == "kB" {
+			n <<= 10
+		}
+		info[k] = n
+	}
+	return info, scanner.Err()
+}
+
+// ReadProcValues return the integer fields in the /proc file such as /proc/sys/fs/file-nr
+func ReadProcValues(path string) ([]uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]uint64, 0)
+	for _, f := range strings.Fields(string(data)) {
+		v, err := strconv.ParseUint(f, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s: %w", path, err)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// KernelVersion return the release of the running kernel
+func KernelVersion() (string, error) {
+	data, err := os.ReadFile("/proc/sys/kernel/osrelease")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// NofileLimit return the soft and hard limit of open files of current process
+func NofileLimit() (uint64, uint64, error) {
+	var rlim syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlim); err != nil {
+		return 0, 0, err
+	}
+	return rlim.Cur, rlim.Max, nil
+}
+
+// ClockOffset return the offset of system clock to the time source estimated by kernel in microseconds,
+// and whether the clock is synchronized, such as by ntpd or chronyd
+func ClockOffset() (int64, bool, error) {
+	tx := &unix.Timex{}
+	state, err := unix.Adjtimex(tx)
+	if err != nil {
+		return 0, false, err
+	}
+	offset := int64(tx.Offset)
+	if tx.Status&unix.STA_NANO != 0 {
+		offset /= 1000
+	}
+	return offset, state != unix.TIME_ERROR, nil
+}
+
+// Mount is an entry in /proc/mounts
+type Mount struct {
+	Device string
+	Point  string
+	FsType string
+}
+
+// Mounts return the mounted file systems in /proc/mounts
+func Mounts() ([]*Mount, error) {
+	f, err := os.Open("/proc/mounts")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	mounts := make([]*Mount, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+		// the spaces in mount point are escaped as \040
+		point := strings.ReplaceAll(fields[1], `\040`, " ")
+		mounts = append(mounts, &Mount{Device: fields[0], Point: point, FsType: fields[2]})
+	}
+	return mounts, scanner.Err()
+}
+
+// FindMount return the mount containing the path, which is the one with longest mount point
+func FindMount(mounts []*Mount, path string) *Mount {
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		path = p
+	}
+	path = filepath.Clean(path)
+
+	sorted := append([]*Mount{}, mounts...)
+	sort.SliceStable(sorted, func(i, j int) bool { return len(sorted[i].Point) > len(sorted[j].Point) })
+	for _, m := range sorted {
+		if m.Point == "/" || path == m.Point || strings.HasPrefix(path, m.Point+"/") {
+			return m
+		}
+	}
+	return nil
+}
+
+// DiskUsage is the space and inodes usage of a file system
+type DiskUsage struct {
+	TotalBytes  uint64
+	FreeBytes   uint64
+	TotalInodes uint64
+	FreeInodes  uint64
+}
+
+// StatDisk return the usage of the file system containing the path,
+// the free bytes are the ones available to unprivileged users
+func StatDisk(path string) (*DiskUsage, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return nil, fmt.Errorf("statfs %s failed: %w", path, err)
+	}
+	return &DiskUsage{
+		TotalBytes:  st.Blocks * uint64(st.Bsize),
+		FreeBytes:   st.Bavail * uint64(st.Bsize),
+		TotalInodes: st.Files,
+		FreeInodes:  st.Ffree,
+	}, nil
+}
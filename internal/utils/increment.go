@@ -5,6 +5,8 @@ import (
 	"math"
 	"os"
 	"path"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -14,11 +16,12 @@ const (
 	CommitLogFileName = "commitlog.id"
 )
 
-// LoadIncrFiles load incremental wal file and commitlog.id file
-func LoadIncrFiles(srcDir string, commitLogId, lastLogId int64) ([]string, error) {
+// LoadIncrFiles load incremental wal file and commitlog.id file,
+// and return the manifest of log ranges in the wal files after checking their continuity
+func LoadIncrFiles(srcDir string, commitLogId, lastLogId int64) ([]string, *WalManifest, error) {
 	entries, err := os.ReadDir(srcDir)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	var (
 		// splitId is the largest wal start id which smaller than commitLogId
@@ -50,27 +53,76 @@ func LoadIncrFiles(srcDir string, commitLogId, lastLogId int64) ([]string, error
 	}
 
 	if splitId == -1 {
-		return nil, fmt.Errorf("%s dir doesn't have incremental wal, commitlog.id is %d", srcDir, commitLogId)
+		return nil, nil, fmt.Errorf("%s dir doesn't have incremental wal, commitlog.id is %d", srcDir, commitLogId)
 	}
 
 	if lastLogId < minWalStartId {
-		return nil, fmt.Errorf("%s dir's wal is discontinuous, lastLogId is %d and minWalStartId is %d", srcDir, lastLogId, minWalStartId)
+		return nil, nil, fmt.Errorf("%s dir's wal is discontinuous, lastLogId is %d and minWalStartId is %d", srcDir, lastLogId, minWalStartId)
 	}
 
 	filter := make([]string, 0)
 	if !hasCommitFile {
-		return nil, fmt.Errorf("%s dir doesn't have a commitlog.id file", srcDir)
+		return nil, nil, fmt.Errorf("%s dir doesn't have a commitlog.id file", srcDir)
 	}
 	// commitlog.id need upload
 	filter = append(filter, CommitLogFileName)
 
+	walNames := make([]string, 0)
 	for name, id := range walMap {
 		if id >= splitId {
-			filter = append(filter, name)
+			walNames = append(walNames, name)
 		}
 	}
+	sort.Slice(walNames, func(i, j int) bool {
+		return walMap[walNames[i]] < walMap[walNames[j]]
+	})
+
+	manifest, err := loadWalManifest(srcDir, walNames, walMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest.CommitLogId = commitLogId
+	manifest.LastLogId = lastLogId
+
+	return append(filter, walNames...), manifest, nil
+}
+
+// loadWalManifest parse the sorted wal files to get their real log ranges,
+// and check that there is no gap between them
+func loadWalManifest(srcDir string, walNames []string, walMap map[string]int64) (*WalManifest, error) {
+	m := &WalManifest{
+		SourcePath: srcDir,
+		Wals:       make([]*WalInfo, 0, len(walNames)),
+	}
+
+	for i, name := range walNames {
+		info, err := ParseWal(filepath.Join(srcDir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		if info.FirstId == -1 {
+			// only the last wal which is just created could be empty
+			if i != len(walNames)-1 {
+				return nil, fmt.Errorf("%s dir's wal %s has no log, but it is not the last wal", srcDir, name)
+			}
+			continue
+		}
+		if info.FirstId != walMap[name] {
+			return nil, fmt.Errorf("%s dir's wal %s starts at log %d, mismatch with its name", srcDir, name, info.FirstId)
+		}
+		m.Wals = append(m.Wals, info)
+	}
+
+	if err := CheckWalContinuity(srcDir, m.Wals); err != nil {
+		return nil, err
+	}
+	if len(m.Wals) != 0 {
+		m.FirstWalId = m.Wals[0].FirstId
+		m.LastWalId = m.Wals[len(m.Wals)-1].LastId
+	}
 
-	return filter, nil
+	return m, nil
 }
 
 func parseWalName(name string) (int64, error) {
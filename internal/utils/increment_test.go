@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeWal(t *testing.T, dir string, firstId, lastId, term int64) {
+	buf := make([]byte, 0)
+	for id := firstId; id <= lastId; id++ {
+		msg := []byte(fmt.Sprintf("log-%d", id))
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(id))
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(term))
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(msg)))
+		buf = binary.LittleEndian.AppendUint64(buf, 0)
+		buf = append(buf, msg...)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(msg)))
+	}
+
+	name := filepath.Join(dir, fmt.Sprintf("%019d%s", firstId, WalExt))
+	if err := os.WriteFile(name, buf, 0644); err != nil {
+		t.Fatalf("write wal %s failed: %v", name, err)
+	}
+}
+
+func TestLoadIncrFiles(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	writeWal(t, dir, 1, 10, 1)
+	writeWal(t, dir, 11, 20, 1)
+	writeWal(t, dir, 21, 25, 2)
+	assert.Nil(os.WriteFile(filepath.Join(dir, CommitLogFileName), []byte{}, 0644))
+
+	info, err := ParseWal(filepath.Join(dir, fmt.Sprintf("%019d%s", 21, WalExt)))
+	assert.Nil(err)
+	assert.Equal(int64(21), info.FirstId)
+	assert.Equal(int64(25), info.LastId)
+	assert.Equal(int64(2), info.LastTerm)
+
+	names, manifest, err := LoadIncrFiles(dir, 15, 5)
+	assert.Nil(err)
+	assert.Equal(3, len(names)) // commitlog.id and two wals from log 11
+	assert.Equal(int64(11), manifest.FirstWalId)
+	assert.Equal(int64(25), manifest.LastWalId)
+
+	// remove the middle wal
+	assert.Nil(os.Remove(filepath.Join(dir, fmt.Sprintf("%019d%s", 11, WalExt))))
+	_, _, err = LoadIncrFiles(dir, 15, 5)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "logs [11, 20] are missing")
+}
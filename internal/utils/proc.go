@@ -0,0 +1,176 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// clock ticks per second used by /proc/{pid}/stat, it's 100 in almost all linux distributions
+	clockTicks = 100
+
+	tcpListenState = "0A"
+)
+
+// ReadPidFile read the pid in the pid file, return 0 if the file does not exist
+func ReadPidFile(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("bad pid file %s: %w", path, err)
+	}
+	return pid, nil
+}
+
+// WritePidFile write the pid into the pid file, the parent dir will be created if not exist
+func WritePidFile(path string, pid int) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(strconv.Itoa(pid)+"\n"), 0644)
+}
+
+// procStat return the fields after the command name in /proc/{pid}/stat,
+// the first one is the process state
+func procStat(pid int) ([]string, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return nil, err
+	}
+
+	// the command name is in parentheses and may contain spaces
+	s := string(data)
+	i := strings.LastIndex(s, ")")
+	if i < 0 {
+		return nil, fmt.Errorf("bad stat of process %d", pid)
+	}
+	return strings.Fields(s[i+1:]), nil
+}
+
+// ProcessAlive check if the process is running and its command line contains the given name,
+// zombie process is treated as not alive
+func ProcessAlive(pid int, name string) bool {
+	if pid <= 0 || syscall.Kill(pid, 0) != nil {
+		return false
+	}
+
+	stat, err := procStat(pid)
+	if err != nil || len(stat) == 0 || stat[0] == "Z" {
+		return false
+	}
+
+	if name != "" {
+		cmdline, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+		if err != nil || !strings.Contains(string(cmdline), name) {
+			return false
+		}
+	}
+	return true
+}
+
+// BootTime return the system boot time from /proc/stat
+func BootTime() (time.Time, error) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "btime" {
+			sec, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.Unix(sec, 0), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("btime not found in /proc/stat")
+}
+
+// ProcessStartTime return the time when the process started
+func ProcessStartTime(pid int) (time.Time, error) {
+	stat, err := procStat(pid)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// starttime is the 22nd field in stat, which is the 20th after the command name
+	if len(stat) < 20 {
+		return time.Time{}, fmt.Errorf("bad stat of process %d", pid)
+	}
+	ticks, err := strconv.ParseInt(stat[19], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	boot, err := BootTime()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return boot.Add(time.Duration(ticks) * time.Second / clockTicks), nil
+}
+
+// ListeningPorts return the tcp ports the process is listening on
+func ListeningPorts(pid int) ([]int32, error) {
+	fds, err := os.ReadDir(fmt.Sprintf("/proc/%d/fd", pid))
+	if err != nil {
+		return nil, err
+	}
+	inodes := make(map[string]bool)
+	for _, fd := range fds {
+		link, err := os.Readlink(fmt.Sprintf("/proc/%d/fd/%s", pid, fd.Name()))
+		if err != nil {
+			continue
+		}
+		if strings.HasPrefix(link, "socket:[") {
+			inodes[strings.TrimSuffix(strings.TrimPrefix(link, "socket:["), "]")] = true
+		}
+	}
+
+	ports := make(map[int32]bool)
+	for _, name := range []string{"tcp", "tcp6"} {
+		f, err := os.Open(fmt.Sprintf("/proc/%d/net/%s", pid, name))
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(f)
+		scanner.Scan() // skip the header
+		for scanner.Scan() {
+			// sl local_address rem_address st tx_queue:rx_queue tr:tm->when retrnsmt uid timeout inode
+			fields := strings.Fields(scanner.Text())
+			if len(fields) < 10 || fields[3] != tcpListenState || !inodes[fields[9]] {
+				continue
+			}
+			i := strings.LastIndex(fields[1], ":")
+			port, err := strconv.ParseInt(fields[1][i+1:], 16, 32)
+			if err == nil {
+				ports[int32(port)] = true
+			}
+		}
+		f.Close()
+	}
+
+	res := make([]int32, 0, len(ports))
+	for p := range ports {
+		res = append(res, p)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res, nil
+}
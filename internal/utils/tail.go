@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+)
+
+const tailBlockSize = 4096
+
+// TailFile return the last n lines of the file
+func TailFile(path string, n int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	end, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	// read blocks backward until there are enough lines
+	var data []byte
+	for off := end; off > 0 && bytes.Count(data, []byte("\n")) <= n; {
+		size := int64(tailBlockSize)
+		if off < size {
+			size = off
+		}
+		off -= size
+
+		buf := make([]byte, size)
+		if _, err = f.ReadAt(buf, off); err != nil {
+			return nil, err
+		}
+		data = append(buf, data...)
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return []string{}, nil
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines, nil
+}
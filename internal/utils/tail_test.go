@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTailFile(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "test.log")
+
+	assert.Nil(os.WriteFile(path, nil, 0644))
+	lines, err := TailFile(path, 10)
+	assert.Nil(err)
+	assert.Empty(lines)
+
+	// lines cross several blocks
+	all := make([]string, 0)
+	for i := 0; i < 1000; i++ {
+		all = append(all, fmt.Sprintf("I20230101 line %d", i))
+	}
+	assert.Nil(os.WriteFile(path, []byte(strings.Join(all, "\n")+"\n"), 0644))
+
+	lines, err = TailFile(path, 3)
+	assert.Nil(err)
+	assert.Equal(all[997:], lines)
+
+	lines, err = TailFile(path, 2000)
+	assert.Nil(err)
+	assert.Equal(all, lines)
+}
@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	WalManifestFileName = "wal.manifest.json"
+
+	// every wal record is organized as below, in little endian:
+	// | logId(8) | term(8) | msgLen(4) | clusterId(8) | msg(msgLen) | msgLen(4) |
+	walRecordHeadSize = 8 + 8 + 4 + 8
+	walRecordFootSize = 4
+)
+
+// WalInfo is the log range kept in one wal file, parsed from the file content
+type WalInfo struct {
+	Name      string `json:"name"`
+	Size      int64  `json:"size"`
+	FirstId   int64  `json:"first_log_id"`
+	FirstTerm int64  `json:"first_log_term"`
+	LastId    int64  `json:"last_log_id"`
+	LastTerm  int64  `json:"last_log_term"`
+}
+
+// WalManifest describes the log ranges of the wal files uploaded for one partition
+type WalManifest struct {
+	SourcePath  string     `json:"source_path"`
+	CommitLogId int64      `json:"commit_log_id"`
+	LastLogId   int64      `json:"last_log_id"`
+	FirstWalId  int64      `json:"first_wal_log_id"`
+	LastWalId   int64      `json:"last_wal_log_id"`
+	Wals        []*WalInfo `json:"wals"`
+}
+
+// ParseWal scan the whole wal file to get the first and last log id/term in it.
+// A partial record at the tail is treated as the end of the file, as nebula does.
+// The first and last log id will be -1 if there is no complete record in the file.
+func ParseWal(file string) (*WalInfo, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &WalInfo{
+		Name:    filepath.Base(file),
+		Size:    stat.Size(),
+		FirstId: -1,
+		LastId:  -1,
+	}
+
+	r := bufio.NewReader(f)
+	head := make([]byte, walRecordHeadSize)
+	foot := make([]byte, walRecordFootSize)
+	for {
+		if _, err = io.ReadFull(r, head); err != nil {
+			break
+		}
+		id := int64(binary.LittleEndian.Uint64(head[0:8]))
+		term := int64(binary.LittleEndian.Uint64(head[8:16]))
+		msgLen := int32(binary.LittleEndian.Uint32(head[16:20]))
+		if msgLen < 0 {
+			return nil, fmt.Errorf("wal %s has bad record length %d at log %d", file, msgLen, id)
+		}
+		if _, err = r.Discard(int(msgLen)); err != nil {
+			break
+		}
+		if _, err = io.ReadFull(r, foot); err != nil {
+			break
+		}
+		if footLen := int32(binary.LittleEndian.Uint32(foot)); footLen != msgLen {
+			return nil, fmt.Errorf("wal %s is corrupted at log %d, head length %d, foot length %d", file, id, msgLen, footLen)
+		}
+
+		if info.FirstId == -1 {
+			info.FirstId, info.FirstTerm = id, term
+		} else if id != info.LastId+1 {
+			return nil, fmt.Errorf("wal %s is discontinuous inside, log %d follows log %d", file, id, info.LastId)
+		}
+		info.LastId, info.LastTerm = id, term
+	}
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("read wal %s failed: %w", file, err)
+	}
+
+	return info, nil
+}
+
+// CheckWalContinuity check the wals sorted by first log id are continuous one by one
+func CheckWalContinuity(dir string, wals []*WalInfo) error {
+	for i := 1; i < len(wals); i++ {
+		prev, cur := wals[i-1], wals[i]
+		if cur.FirstId != prev.LastId+1 {
+			return fmt.Errorf("%s dir's wal has a gap: %s ends at log %d(term %d) but next %s starts at log %d(term %d), logs [%d, %d] are missing",
+				dir, prev.Name, prev.LastId, prev.LastTerm, cur.Name, cur.FirstId, cur.FirstTerm, prev.LastId+1, cur.FirstId-1)
+		}
+	}
+	return nil
+}
+
+// WriteTemp write the manifest to a temporary file and return the file path,
+// the caller should remove it after using
+func (m *WalManifest) WriteTemp() (string, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.CreateTemp("", "wal-manifest-*.json")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err = f.Write(data); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
@@ -25,8 +26,29 @@ type Client interface {
 	StartService(req *pb.StartServiceRequest) (*pb.StartServiceResponse, error)
 	StopService(req *pb.StopServiceRequest) (*pb.StopServiceResponse, error)
 	ServiceStatus(req *pb.ServiceStatusRequest) (*pb.ServiceStatusResponse, error)
+	RestartService(req *pb.RestartServiceRequest) (*pb.RestartServiceResponse, error)
+	ListServices(req *pb.ListServicesRequest) (*pb.ListServicesResponse, error)
+	BalanceLeader(req *pb.BalanceLeaderRequest) (*pb.BalanceLeaderResponse, error)
+	WatchdogStatus(req *pb.WatchdogStatusRequest) (*pb.WatchdogStatusResponse, error)
+	TailLogs(req *pb.TailLogsRequest) (pb.AgentService_TailLogsClient, error)
+	GetServiceConfig(req *pb.GetServiceConfigRequest) (*pb.GetServiceConfigResponse, error)
+	UpdateServiceConfig(req *pb.UpdateServiceConfigRequest) (*pb.UpdateServiceConfigResponse, error)
+	RollbackServiceConfig(req *pb.RollbackServiceConfigRequest) (*pb.RollbackServiceConfigResponse, error)
+	GetFlags(req *pb.GetFlagsRequest) (*pb.GetFlagsResponse, error)
+	SetFlags(req *pb.SetFlagsRequest) (*pb.SetFlagsResponse, error)
 	BanReadWrite(req *pb.BanReadWriteRequest) (*pb.BanReadWriteResponse, error)
 	AllowReadWrite(req *pb.AllowReadWriteRequest) (*pb.AllowReadWriteResponse, error)
+	ReadWriteStatus(req *pb.ReadWriteStatusRequest) (*pb.ReadWriteStatusResponse, error)
+	UpgradeService(req *pb.UpgradeServiceRequest) (*pb.UpgradeServiceResponse, error)
+	RollbackServiceBinary(req *pb.RollbackServiceBinaryRequest) (*pb.RollbackServiceBinaryResponse, error)
+	ListServiceVersions(req *pb.ListServiceVersionsRequest) (*pb.ListServiceVersionsResponse, error)
+	GetHostInfo(req *pb.GetHostInfoRequest) (*pb.GetHostInfoResponse, error)
+	GetServiceDirs(req *pb.GetServiceDirsRequest) (*pb.GetServiceDirsResponse, error)
+	CollectDiagnostics(req *pb.CollectDiagnosticsRequest) (*pb.CollectDiagnosticsResponse, error)
+	CleanLogs(req *pb.CleanLogsRequest) (*pb.CleanLogsResponse, error)
+	LogJanitorStatus(req *pb.LogJanitorStatusRequest) (*pb.LogJanitorStatusResponse, error)
+	ListCheckpoints(req *pb.ListCheckpointsRequest) (*pb.ListCheckpointsResponse, error)
+	DropCheckpoint(req *pb.DropCheckpointRequest) (*pb.DropCheckpointResponse, error)
 	DataPlayBack(req *pb.DataPlayBackRequest) (*pb.DataPlayBackResponse, error)
 	MoveDir(req *pb.MoveDirRequest) (*pb.MoveDirResponse, error)
 	RemoveDir(req *pb.RemoveDirRequest) (*pb.RemoveDirResponse, error)
@@ -34,6 +56,12 @@ type Client interface {
 	StopAgent(req *pb.StopAgentRequest) (*pb.StopAgentResponse, error)
 	HealthCheck(req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error)
 	GetSpaceUsages(req *pb.GetSpaceUsagesRequest) (*pb.GetSpaceUsagesResponse, error)
+	DescribeBackup(req *pb.DescribeBackupRequest) (*pb.DescribeBackupResponse, error)
+	PruneDedupPool(req *pb.PruneDedupPoolRequest) (*pb.PruneDedupPoolResponse, error)
+	VerifyBackup(req *pb.VerifyBackupRequest) (*pb.VerifyBackupResponse, error)
+	GetJob(req *pb.GetJobRequest) (*pb.GetJobResponse, error)
+	ListJobs(req *pb.ListJobsRequest) (*pb.ListJobsResponse, error)
+	CancelJob(req *pb.CancelJobRequest) (*pb.CancelJobResponse, error)
 	Close() error
 }
 
@@ -47,6 +75,7 @@ type client struct {
 	conn    *grpc.ClientConn
 	storage pb.StorageServiceClient
 	agent   pb.AgentServiceClient
+	job     pb.JobServiceClient
 }
 
 func New(ctx context.Context, cfg *Config) (Client, error) {
@@ -67,6 +96,7 @@ func New(ctx context.Context, cfg *Config) (Client, error) {
 		conn:    conn,
 		storage: pb.NewStorageServiceClient(conn),
 		agent:   pb.NewAgentServiceClient(conn),
+		job:     pb.NewJobServiceClient(conn),
 	}
 
 	return c, nil
@@ -134,9 +164,25 @@ func (c *client) ExistDir(req *pb.ExistDirRequest) (resp *pb.ExistDirResponse, e
 	return c.storage.ExistDir(c.ctx, req)
 }
 
+// RoleResults return the result of each role carried by the error of starting or stopping several services
+func RoleResults(err error) []*pb.RoleResult {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	results := make([]*pb.RoleResult, 0)
+	for _, d := range st.Details() {
+		if r, ok := d.(*pb.RoleResult); ok {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
 func (c *client) StartService(req *pb.StartServiceRequest) (resp *pb.StartServiceResponse, err error) {
 	defer func() {
 		if err != nil {
+			resp = &pb.StartServiceResponse{Results: RoleResults(err)}
 			err = fmt.Errorf("agent, start service failed: %w", err)
 		}
 	}()
@@ -147,6 +193,7 @@ func (c *client) StartService(req *pb.StartServiceRequest) (resp *pb.StartServic
 func (c *client) StopService(req *pb.StopServiceRequest) (resp *pb.StopServiceResponse, err error) {
 	defer func() {
 		if err != nil {
+			resp = &pb.StopServiceResponse{Results: RoleResults(err)}
 			err = fmt.Errorf("agent, stop service failed: %w", err)
 		}
 	}()
@@ -164,6 +211,107 @@ func (c *client) ServiceStatus(req *pb.ServiceStatusRequest) (resp *pb.ServiceSt
 	return c.agent.ServiceStatus(c.ctx, req)
 }
 
+func (c *client) RestartService(req *pb.RestartServiceRequest) (resp *pb.RestartServiceResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, restart service failed: %w", err)
+		}
+	}()
+
+	return c.agent.RestartService(c.ctx, req)
+}
+
+func (c *client) ListServices(req *pb.ListServicesRequest) (resp *pb.ListServicesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, list services failed: %w", err)
+		}
+	}()
+
+	return c.agent.ListServices(c.ctx, req)
+}
+
+func (c *client) BalanceLeader(req *pb.BalanceLeaderRequest) (resp *pb.BalanceLeaderResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, balance leader failed: %w", err)
+		}
+	}()
+
+	return c.agent.BalanceLeader(c.ctx, req)
+}
+
+func (c *client) WatchdogStatus(req *pb.WatchdogStatusRequest) (resp *pb.WatchdogStatusResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, get watchdog status failed: %w", err)
+		}
+	}()
+
+	return c.agent.WatchdogStatus(c.ctx, req)
+}
+
+// TailLogs return the stream of log lines, cancel the client's context to stop following
+func (c *client) TailLogs(req *pb.TailLogsRequest) (stream pb.AgentService_TailLogsClient, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, tail logs failed: %w", err)
+		}
+	}()
+
+	return c.agent.TailLogs(c.ctx, req)
+}
+
+func (c *client) GetServiceConfig(req *pb.GetServiceConfigRequest) (resp *pb.GetServiceConfigResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, get service config failed: %w", err)
+		}
+	}()
+
+	return c.agent.GetServiceConfig(c.ctx, req)
+}
+
+func (c *client) UpdateServiceConfig(req *pb.UpdateServiceConfigRequest) (resp *pb.UpdateServiceConfigResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, update service config failed: %w", err)
+		}
+	}()
+
+	return c.agent.UpdateServiceConfig(c.ctx, req)
+}
+
+func (c *client) RollbackServiceConfig(req *pb.RollbackServiceConfigRequest) (resp *pb.RollbackServiceConfigResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, rollback service config failed: %w", err)
+		}
+	}()
+
+	return c.agent.RollbackServiceConfig(c.ctx, req)
+}
+
+func (c *client) GetFlags(req *pb.GetFlagsRequest) (resp *pb.GetFlagsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, get flags failed: %w", err)
+		}
+	}()
+
+	return c.agent.GetFlags(c.ctx, req)
+}
+
+func (c *client) SetFlags(req *pb.SetFlagsRequest) (resp *pb.SetFlagsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, set flags failed: %w", err)
+		}
+	}()
+
+	return c.agent.SetFlags(c.ctx, req)
+}
+
 func (c *client) BanReadWrite(req *pb.BanReadWriteRequest) (resp *pb.BanReadWriteResponse, err error) {
 	defer func() {
 		if err != nil {
@@ -184,6 +332,116 @@ func (c *client) AllowReadWrite(req *pb.AllowReadWriteRequest) (resp *pb.AllowRe
 	return c.agent.AllowReadWrite(c.ctx, req)
 }
 
+func (c *client) ReadWriteStatus(req *pb.ReadWriteStatusRequest) (resp *pb.ReadWriteStatusResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, get read write status failed: %w", err)
+		}
+	}()
+
+	return c.agent.ReadWriteStatus(c.ctx, req)
+}
+
+func (c *client) UpgradeService(req *pb.UpgradeServiceRequest) (resp *pb.UpgradeServiceResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, upgrade service failed: %w", err)
+		}
+	}()
+
+	return c.agent.UpgradeService(c.ctx, req)
+}
+
+func (c *client) RollbackServiceBinary(req *pb.RollbackServiceBinaryRequest) (resp *pb.RollbackServiceBinaryResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, rollback service binary failed: %w", err)
+		}
+	}()
+
+	return c.agent.RollbackServiceBinary(c.ctx, req)
+}
+
+func (c *client) ListServiceVersions(req *pb.ListServiceVersionsRequest) (resp *pb.ListServiceVersionsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, list service versions failed: %w", err)
+		}
+	}()
+
+	return c.agent.ListServiceVersions(c.ctx, req)
+}
+
+func (c *client) GetHostInfo(req *pb.GetHostInfoRequest) (resp *pb.GetHostInfoResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, get host info failed: %w", err)
+		}
+	}()
+
+	return c.agent.GetHostInfo(c.ctx, req)
+}
+
+func (c *client) GetServiceDirs(req *pb.GetServiceDirsRequest) (resp *pb.GetServiceDirsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, get service dirs failed: %w", err)
+		}
+	}()
+
+	return c.agent.GetServiceDirs(c.ctx, req)
+}
+
+func (c *client) CollectDiagnostics(req *pb.CollectDiagnosticsRequest) (resp *pb.CollectDiagnosticsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, collect diagnostics failed: %w", err)
+		}
+	}()
+
+	return c.agent.CollectDiagnostics(c.ctx, req)
+}
+
+func (c *client) CleanLogs(req *pb.CleanLogsRequest) (resp *pb.CleanLogsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, clean logs failed: %w", err)
+		}
+	}()
+
+	return c.agent.CleanLogs(c.ctx, req)
+}
+
+func (c *client) LogJanitorStatus(req *pb.LogJanitorStatusRequest) (resp *pb.LogJanitorStatusResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, get log janitor status failed: %w", err)
+		}
+	}()
+
+	return c.agent.LogJanitorStatus(c.ctx, req)
+}
+
+func (c *client) ListCheckpoints(req *pb.ListCheckpointsRequest) (resp *pb.ListCheckpointsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, list checkpoints failed: %w", err)
+		}
+	}()
+
+	return c.agent.ListCheckpoints(c.ctx, req)
+}
+
+func (c *client) DropCheckpoint(req *pb.DropCheckpointRequest) (resp *pb.DropCheckpointResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, drop checkpoint failed: %w", err)
+		}
+	}()
+
+	return c.agent.DropCheckpoint(c.ctx, req)
+}
+
 func (c *client) DataPlayBack(req *pb.DataPlayBackRequest) (resp *pb.DataPlayBackResponse, err error) {
 	return c.agent.DataPlayBack(c.ctx, req)
 }
@@ -217,3 +475,57 @@ func (c *client) GetSpaceUsages(req *pb.GetSpaceUsagesRequest) (resp *pb.GetSpac
 
 	return c.agent.GetSpaceUsages(c.ctx, req)
 }
+
+func (c *client) DescribeBackup(req *pb.DescribeBackupRequest) (*pb.DescribeBackupResponse, error) {
+	if c.ctx.Value(storage.SessionKey) == nil {
+		return nil, fmt.Errorf("missing session in context")
+	}
+	req.SessionId = fmt.Sprintf("%v", c.ctx.Value(storage.SessionKey))
+	return c.storage.DescribeBackup(c.ctx, req)
+}
+
+func (c *client) PruneDedupPool(req *pb.PruneDedupPoolRequest) (*pb.PruneDedupPoolResponse, error) {
+	if c.ctx.Value(storage.SessionKey) == nil {
+		return nil, fmt.Errorf("missing session in context")
+	}
+	req.SessionId = fmt.Sprintf("%v", c.ctx.Value(storage.SessionKey))
+	return c.storage.PruneDedupPool(c.ctx, req)
+}
+
+func (c *client) VerifyBackup(req *pb.VerifyBackupRequest) (*pb.VerifyBackupResponse, error) {
+	if c.ctx.Value(storage.SessionKey) == nil {
+		return nil, fmt.Errorf("missing session in context")
+	}
+	req.SessionId = fmt.Sprintf("%v", c.ctx.Value(storage.SessionKey))
+	return c.storage.VerifyBackup(c.ctx, req)
+}
+
+func (c *client) GetJob(req *pb.GetJobRequest) (resp *pb.GetJobResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, get job failed: %w", err)
+		}
+	}()
+
+	return c.job.GetJob(c.ctx, req)
+}
+
+func (c *client) ListJobs(req *pb.ListJobsRequest) (resp *pb.ListJobsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, list jobs failed: %w", err)
+		}
+	}()
+
+	return c.job.ListJobs(c.ctx, req)
+}
+
+func (c *client) CancelJob(req *pb.CancelJobRequest) (resp *pb.CancelJobResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("agent, cancel job failed: %w", err)
+		}
+	}()
+
+	return c.job.CancelJob(c.ctx, req)
+}
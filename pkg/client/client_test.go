@@ -0,0 +1,28 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestRoleResults(t *testing.T) {
+	assert := assert.New(t)
+
+	st, err := status.New(codes.Aborted, "start GRAPH: not ready").WithDetails(
+		&pb.RoleResult{Role: pb.ServiceRole_META, Succeeded: true},
+		&pb.RoleResult{Role: pb.ServiceRole_GRAPH, Error: "not ready"},
+	)
+	assert.Nil(err)
+	results := RoleResults(fmt.Errorf("agent, start service failed: %w", st.Err()))
+	assert.Equal(2, len(results))
+	assert.True(results[0].Succeeded)
+	assert.Equal("not ready", results[1].Error)
+
+	assert.Empty(RoleResults(fmt.Errorf("other error")))
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const hostStatusOnline = "ONLINE"
+
+// RollingRestartConfig is the config of restarting storaged hosts one by one
+type RollingRestartConfig struct {
+	// ReadyTimeout is the time to wait the storaged to be healthy after started
+	ReadyTimeout time.Duration
+	// RejoinTimeout is the time to wait the storaged to be online in meta and get its leaders back
+	RejoinTimeout time.Duration
+	// BalanceLeader submit leader balance job after the storaged rejoined,
+	// or the leaders moved to other hosts when restarting would not come back
+	BalanceLeader bool
+	CheckInterval time.Duration
+}
+
+func DefaultRollingRestartConfig() *RollingRestartConfig {
+	return &RollingRestartConfig{
+		ReadyTimeout:  2 * time.Minute,
+		RejoinTimeout: 5 * time.Minute,
+		BalanceLeader: true,
+		CheckInterval: 5 * time.Second,
+	}
+}
+
+// findService return the service with given role and addr in the agent, nil if not found
+func findService(c Client, role pb.ServiceRole, addr string) (*pb.ServiceInfo, error) {
+	resp, err := c.ListServices(&pb.ListServicesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range resp.GetServices() {
+		if s.GetRole() == role && (addr == "" || s.GetAddr() == addr) {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// RollingRestartStorage restart the storaged in the agents' machines one at a time,
+// wait each one to rejoin the cluster and get its leaders back, and stop on the first failure
+func RollingRestartStorage(agents []Client, cfg *RollingRestartConfig) error {
+	for _, a := range agents {
+		resp, err := a.ListServices(&pb.ListServicesRequest{})
+		if err != nil {
+			return err
+		}
+
+		for _, s := range resp.GetServices() {
+			if s.GetRole() != pb.ServiceRole_STORAGE {
+				continue
+			}
+			if err = restartStorage(a, s, cfg); err != nil {
+				return fmt.Errorf("rolling restart stopped at storaged %s: %w", s.GetAddr(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func restartStorage(a Client, s *pb.ServiceInfo, cfg *RollingRestartConfig) error {
+	logger := log.WithField("addr", s.GetAddr())
+	logger.WithField("leaders", s.GetLeaderParts()).Info("Restart storaged.")
+
+	_, err := a.RestartService(&pb.RestartServiceRequest{
+		Role:                pb.ServiceRole_STORAGE,
+		Dir:                 s.GetRootDir(),
+		Instance:            s.GetConf(),
+		ReadyTimeoutSeconds: int32(cfg.ReadyTimeout.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+
+	balanced := false
+	deadline := time.Now().Add(cfg.RejoinTimeout)
+	for {
+		cur, err := findService(a, pb.ServiceRole_STORAGE, s.GetAddr())
+		if err != nil {
+			logger.WithError(err).Debug("Get storaged info failed.")
+		} else if cur != nil && cur.GetHostStatus() == hostStatusOnline {
+			// the host without leaders before restart need not to wait
+			if s.GetLeaderParts() == 0 || !cfg.BalanceLeader || cur.GetLeaderParts() > 0 {
+				logger.WithField("leaders", cur.GetLeaderParts()).Info("Storaged rejoined.")
+				return nil
+			}
+			if cfg.BalanceLeader && !balanced {
+				if _, err = a.BalanceLeader(&pb.BalanceLeaderRequest{}); err != nil {
+					return err
+				}
+				balanced = true
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("storaged does not rejoin in %v", cfg.RejoinTimeout)
+		}
+		time.Sleep(cfg.CheckInterval)
+	}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+// fakeAgent has one storaged, whose leaders come back only after balance
+type fakeAgent struct {
+	Client
+	addr     string
+	leaders  int64
+	restarts int
+	balances int
+	fail     bool
+	// the instances restarted
+	instances []string
+}
+
+func (a *fakeAgent) ListServices(req *pb.ListServicesRequest) (*pb.ListServicesResponse, error) {
+	return &pb.ListServicesResponse{
+		Services: []*pb.ServiceInfo{
+			{Role: pb.ServiceRole_GRAPH, Addr: a.addr + ":9669", HostStatus: hostStatusOnline},
+			{Role: pb.ServiceRole_STORAGE, Addr: a.addr + ":9779", HostStatus: hostStatusOnline, LeaderParts: a.leaders,
+				Conf: "/usr/local/nebula/etc/nebula-storaged.conf"},
+		},
+	}, nil
+}
+
+func (a *fakeAgent) RestartService(req *pb.RestartServiceRequest) (*pb.RestartServiceResponse, error) {
+	if a.fail {
+		return nil, fmt.Errorf("not ready")
+	}
+	a.restarts++
+	a.instances = append(a.instances, req.GetInstance())
+	a.leaders = 0
+	return &pb.RestartServiceResponse{}, nil
+}
+
+func (a *fakeAgent) BalanceLeader(req *pb.BalanceLeaderRequest) (*pb.BalanceLeaderResponse, error) {
+	a.balances++
+	a.leaders = 3
+	return &pb.BalanceLeaderResponse{}, nil
+}
+
+func TestRollingRestartStorage(t *testing.T) {
+	assert := assert.New(t)
+	cfg := DefaultRollingRestartConfig()
+	cfg.CheckInterval = time.Millisecond
+
+	a1 := &fakeAgent{addr: "192.168.0.1", leaders: 3}
+	a2 := &fakeAgent{addr: "192.168.0.2", leaders: 3}
+	assert.Nil(RollingRestartStorage([]Client{a1, a2}, cfg))
+	assert.Equal(1, a1.restarts)
+	assert.Equal([]string{"/usr/local/nebula/etc/nebula-storaged.conf"}, a1.instances)
+	assert.Equal(1, a1.balances)
+	assert.EqualValues(3, a1.leaders)
+	assert.Equal(1, a2.restarts)
+
+	// stop on the first failure
+	a1 = &fakeAgent{addr: "192.168.0.1", leaders: 3, fail: true}
+	a2 = &fakeAgent{addr: "192.168.0.2", leaders: 3}
+	assert.NotNil(RollingRestartStorage([]Client{a1, a2}, cfg))
+	assert.Equal(0, a2.restarts)
+}
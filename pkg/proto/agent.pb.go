@@ -5,6 +5,7 @@ package proto
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -34,6 +35,8 @@ const (
 	ServiceRole_META         ServiceRole = 1
 	ServiceRole_GRAPH        ServiceRole = 2
 	ServiceRole_STORAGE      ServiceRole = 3
+	// all services installed in the dir
+	ServiceRole_ALL ServiceRole = 4
 )
 
 var ServiceRole_name = map[int32]string{
@@ -41,6 +44,7 @@ var ServiceRole_name = map[int32]string{
 	1: "META",
 	2: "GRAPH",
 	3: "STORAGE",
+	4: "ALL",
 }
 
 var ServiceRole_value = map[string]int32{
@@ -48,6 +52,7 @@ var ServiceRole_value = map[string]int32{
 	"META":         1,
 	"GRAPH":        2,
 	"STORAGE":      3,
+	"ALL":          4,
 }
 
 func (x ServiceRole) String() string {
@@ -86,19 +91,164 @@ func (Status) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_56ede974c0020f77, []int{1}
 }
 
-type StartServiceRequest struct {
+type WatchState int32
+
+const (
+	WatchState_UNKNOWN_WATCH_STATE WatchState = 0
+	// running normally or not seen running yet
+	WatchState_WATCHING WatchState = 1
+	// crashed and waiting to be restarted after backoff
+	WatchState_BACKOFF WatchState = 2
+	// stopped by StopService, will not be restarted
+	WatchState_PAUSED WatchState = 3
+	// crashed too many times, will not be restarted until started by StartService
+	WatchState_CRASH_LOOP WatchState = 4
+)
+
+var WatchState_name = map[int32]string{
+	0: "UNKNOWN_WATCH_STATE",
+	1: "WATCHING",
+	2: "BACKOFF",
+	3: "PAUSED",
+	4: "CRASH_LOOP",
+}
+
+var WatchState_value = map[string]int32{
+	"UNKNOWN_WATCH_STATE": 0,
+	"WATCHING":            1,
+	"BACKOFF":             2,
+	"PAUSED":              3,
+	"CRASH_LOOP":          4,
+}
+
+func (x WatchState) String() string {
+	return proto.EnumName(WatchState_name, int32(x))
+}
+
+func (WatchState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{2}
+}
+
+type LogKind int32
+
+const (
+	LogKind_UNKNOWN_LOG LogKind = 0
+	LogKind_LOG_INFO    LogKind = 1
+	LogKind_LOG_WARNING LogKind = 2
+	LogKind_LOG_ERROR   LogKind = 3
+	LogKind_LOG_STDOUT  LogKind = 4
+	LogKind_LOG_STDERR  LogKind = 5
+)
+
+var LogKind_name = map[int32]string{
+	0: "UNKNOWN_LOG",
+	1: "LOG_INFO",
+	2: "LOG_WARNING",
+	3: "LOG_ERROR",
+	4: "LOG_STDOUT",
+	5: "LOG_STDERR",
+}
+
+var LogKind_value = map[string]int32{
+	"UNKNOWN_LOG": 0,
+	"LOG_INFO":    1,
+	"LOG_WARNING": 2,
+	"LOG_ERROR":   3,
+	"LOG_STDOUT":  4,
+	"LOG_STDERR":  5,
+}
+
+func (x LogKind) String() string {
+	return proto.EnumName(LogKind_name, int32(x))
+}
+
+func (LogKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{3}
+}
+
+type RoleResult struct {
 	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
-	Dir                  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	Succeeded            bool        `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Error                string      `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
 }
 
+func (m *RoleResult) Reset()         { *m = RoleResult{} }
+func (m *RoleResult) String() string { return proto.CompactTextString(m) }
+func (*RoleResult) ProtoMessage()    {}
+func (*RoleResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{0}
+}
+func (m *RoleResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleResult.Merge(m, src)
+}
+func (m *RoleResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleResult proto.InternalMessageInfo
+
+func (m *RoleResult) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *RoleResult) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
+	}
+	return false
+}
+
+func (m *RoleResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type StartServiceRequest struct {
+	Role ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	// wait the service to be healthy if greater than 0
+	ReadyTimeoutSeconds int32 `protobuf:"varint,3,opt,name=ready_timeout_seconds,json=readyTimeoutSeconds,proto3" json:"ready_timeout_seconds,omitempty"`
+	// start several services in meta, storage, graph order, the role is ignored if set
+	Roles []ServiceRole `protobuf:"varint,4,rep,packed,name=roles,proto3,enum=proto.ServiceRole" json:"roles,omitempty"`
+	// the rpc port or the config file in etc of the instance, the default etc/nebula-{role}.conf if empty,
+	// needed only when several instances of the role in the dir
+	Instance             string   `protobuf:"bytes,5,opt,name=instance,proto3" json:"instance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
 func (m *StartServiceRequest) Reset()         { *m = StartServiceRequest{} }
 func (m *StartServiceRequest) String() string { return proto.CompactTextString(m) }
 func (*StartServiceRequest) ProtoMessage()    {}
 func (*StartServiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{0}
+	return fileDescriptor_56ede974c0020f77, []int{1}
 }
 func (m *StartServiceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -141,17 +291,40 @@ func (m *StartServiceRequest) GetDir() string {
 	return ""
 }
 
+func (m *StartServiceRequest) GetReadyTimeoutSeconds() int32 {
+	if m != nil {
+		return m.ReadyTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *StartServiceRequest) GetRoles() []ServiceRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *StartServiceRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
+// results of each role when starting several services
 type StartServiceResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Results              []*RoleResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *StartServiceResponse) Reset()         { *m = StartServiceResponse{} }
 func (m *StartServiceResponse) String() string { return proto.CompactTextString(m) }
 func (*StartServiceResponse) ProtoMessage()    {}
 func (*StartServiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{1}
+	return fileDescriptor_56ede974c0020f77, []int{2}
 }
 func (m *StartServiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,19 +353,29 @@ func (m *StartServiceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StartServiceResponse proto.InternalMessageInfo
 
+func (m *StartServiceResponse) GetResults() []*RoleResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 type StopServiceRequest struct {
-	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
-	Dir                  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Role ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	// stop several services in graph, storage, meta order, the role is ignored if set
+	Roles                []ServiceRole `protobuf:"varint,3,rep,packed,name=roles,proto3,enum=proto.ServiceRole" json:"roles,omitempty"`
+	Instance             string        `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *StopServiceRequest) Reset()         { *m = StopServiceRequest{} }
 func (m *StopServiceRequest) String() string { return proto.CompactTextString(m) }
 func (*StopServiceRequest) ProtoMessage()    {}
 func (*StopServiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{2}
+	return fileDescriptor_56ede974c0020f77, []int{3}
 }
 func (m *StopServiceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,17 +418,33 @@ func (m *StopServiceRequest) GetDir() string {
 	return ""
 }
 
+func (m *StopServiceRequest) GetRoles() []ServiceRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *StopServiceRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
+// results of each role when stopping several services
 type StopServiceResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Results              []*RoleResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *StopServiceResponse) Reset()         { *m = StopServiceResponse{} }
 func (m *StopServiceResponse) String() string { return proto.CompactTextString(m) }
 func (*StopServiceResponse) ProtoMessage()    {}
 func (*StopServiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{3}
+	return fileDescriptor_56ede974c0020f77, []int{4}
 }
 func (m *StopServiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,9 +473,17 @@ func (m *StopServiceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StopServiceResponse proto.InternalMessageInfo
 
+func (m *StopServiceResponse) GetResults() []*RoleResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 type ServiceStatusRequest struct {
 	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
 	Dir                  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	Instance             string      `protobuf:"bytes,3,opt,name=instance,proto3" json:"instance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -286,7 +493,7 @@ func (m *ServiceStatusRequest) Reset()         { *m = ServiceStatusRequest{} }
 func (m *ServiceStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*ServiceStatusRequest) ProtoMessage()    {}
 func (*ServiceStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{4}
+	return fileDescriptor_56ede974c0020f77, []int{5}
 }
 func (m *ServiceStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,8 +536,21 @@ func (m *ServiceStatusRequest) GetDir() string {
 	return ""
 }
 
+func (m *ServiceStatusRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
 type ServiceStatusResponse struct {
-	Status               Status   `protobuf:"varint,1,opt,name=status,proto3,enum=proto.Status" json:"status,omitempty"`
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=proto.Status" json:"status,omitempty"`
+	Pid    int64  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	// seconds since the process started, 0 if not running
+	UptimeSeconds int64 `protobuf:"varint,3,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// exit code of the last run started by agent, -1 if unknown
+	ExitCode             int32    `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	ListeningPorts       []int32  `protobuf:"varint,5,rep,packed,name=listening_ports,json=listeningPorts,proto3" json:"listening_ports,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -340,7 +560,7 @@ func (m *ServiceStatusResponse) Reset()         { *m = ServiceStatusResponse{} }
 func (m *ServiceStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ServiceStatusResponse) ProtoMessage()    {}
 func (*ServiceStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{5}
+	return fileDescriptor_56ede974c0020f77, []int{6}
 }
 func (m *ServiceStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,26 +596,57 @@ func (m *ServiceStatusResponse) GetStatus() Status {
 	return Status_UNKNOWN_STATUS
 }
 
-type BanReadWriteRequest struct {
-	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
-	Addr                 string      `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *ServiceStatusResponse) GetPid() int64 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
 }
 
-func (m *BanReadWriteRequest) Reset()         { *m = BanReadWriteRequest{} }
-func (m *BanReadWriteRequest) String() string { return proto.CompactTextString(m) }
-func (*BanReadWriteRequest) ProtoMessage()    {}
-func (*BanReadWriteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{6}
+func (m *ServiceStatusResponse) GetUptimeSeconds() int64 {
+	if m != nil {
+		return m.UptimeSeconds
+	}
+	return 0
 }
-func (m *BanReadWriteRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ServiceStatusResponse) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ServiceStatusResponse) GetListeningPorts() []int32 {
+	if m != nil {
+		return m.ListeningPorts
+	}
+	return nil
+}
+
+type RestartServiceRequest struct {
+	Role ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	// wait the service to be healthy after started if greater than 0
+	ReadyTimeoutSeconds  int32    `protobuf:"varint,3,opt,name=ready_timeout_seconds,json=readyTimeoutSeconds,proto3" json:"ready_timeout_seconds,omitempty"`
+	Instance             string   `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestartServiceRequest) Reset()         { *m = RestartServiceRequest{} }
+func (m *RestartServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*RestartServiceRequest) ProtoMessage()    {}
+func (*RestartServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{7}
+}
+func (m *RestartServiceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BanReadWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RestartServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BanReadWriteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RestartServiceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -405,50 +656,64 @@ func (m *BanReadWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *BanReadWriteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BanReadWriteRequest.Merge(m, src)
+func (m *RestartServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartServiceRequest.Merge(m, src)
 }
-func (m *BanReadWriteRequest) XXX_Size() int {
+func (m *RestartServiceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BanReadWriteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BanReadWriteRequest.DiscardUnknown(m)
+func (m *RestartServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartServiceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BanReadWriteRequest proto.InternalMessageInfo
+var xxx_messageInfo_RestartServiceRequest proto.InternalMessageInfo
 
-func (m *BanReadWriteRequest) GetRole() ServiceRole {
+func (m *RestartServiceRequest) GetRole() ServiceRole {
 	if m != nil {
 		return m.Role
 	}
 	return ServiceRole_UNKNOWN_ROLE
 }
 
-func (m *BanReadWriteRequest) GetAddr() string {
+func (m *RestartServiceRequest) GetDir() string {
 	if m != nil {
-		return m.Addr
+		return m.Dir
 	}
 	return ""
 }
 
-type BanReadWriteResponse struct {
+func (m *RestartServiceRequest) GetReadyTimeoutSeconds() int32 {
+	if m != nil {
+		return m.ReadyTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *RestartServiceRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
+type RestartServiceResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BanReadWriteResponse) Reset()         { *m = BanReadWriteResponse{} }
-func (m *BanReadWriteResponse) String() string { return proto.CompactTextString(m) }
-func (*BanReadWriteResponse) ProtoMessage()    {}
-func (*BanReadWriteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{7}
+func (m *RestartServiceResponse) Reset()         { *m = RestartServiceResponse{} }
+func (m *RestartServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*RestartServiceResponse) ProtoMessage()    {}
+func (*RestartServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{8}
 }
-func (m *BanReadWriteResponse) XXX_Unmarshal(b []byte) error {
+func (m *RestartServiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BanReadWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RestartServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BanReadWriteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RestartServiceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -458,38 +723,47 @@ func (m *BanReadWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *BanReadWriteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BanReadWriteResponse.Merge(m, src)
+func (m *RestartServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartServiceResponse.Merge(m, src)
 }
-func (m *BanReadWriteResponse) XXX_Size() int {
+func (m *RestartServiceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BanReadWriteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BanReadWriteResponse.DiscardUnknown(m)
+func (m *RestartServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartServiceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BanReadWriteResponse proto.InternalMessageInfo
+var xxx_messageInfo_RestartServiceResponse proto.InternalMessageInfo
 
-type AllowReadWriteRequest struct {
-	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
-	Addr                 string      `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type ServiceInfo struct {
+	Role     ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Addr     string      `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	RootDir  string      `protobuf:"bytes,3,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
+	DataDirs []string    `protobuf:"bytes,4,rep,name=data_dirs,json=dataDirs,proto3" json:"data_dirs,omitempty"`
+	// host status in meta, such as ONLINE/OFFLINE
+	HostStatus string `protobuf:"bytes,5,opt,name=host_status,json=hostStatus,proto3" json:"host_status,omitempty"`
+	// partitions count of storaged, summed over all spaces
+	LeaderParts int64 `protobuf:"varint,6,opt,name=leader_parts,json=leaderParts,proto3" json:"leader_parts,omitempty"`
+	TotalParts  int64 `protobuf:"varint,7,opt,name=total_parts,json=totalParts,proto3" json:"total_parts,omitempty"`
+	// the config file of the instance in agent machine, empty if not found
+	Conf                 string   `protobuf:"bytes,8,opt,name=conf,proto3" json:"conf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AllowReadWriteRequest) Reset()         { *m = AllowReadWriteRequest{} }
-func (m *AllowReadWriteRequest) String() string { return proto.CompactTextString(m) }
-func (*AllowReadWriteRequest) ProtoMessage()    {}
-func (*AllowReadWriteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{8}
+func (m *ServiceInfo) Reset()         { *m = ServiceInfo{} }
+func (m *ServiceInfo) String() string { return proto.CompactTextString(m) }
+func (*ServiceInfo) ProtoMessage()    {}
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{9}
 }
-func (m *AllowReadWriteRequest) XXX_Unmarshal(b []byte) error {
+func (m *ServiceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllowReadWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ServiceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllowReadWriteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ServiceInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -499,92 +773,93 @@ func (m *AllowReadWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *AllowReadWriteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllowReadWriteRequest.Merge(m, src)
+func (m *ServiceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceInfo.Merge(m, src)
 }
-func (m *AllowReadWriteRequest) XXX_Size() int {
+func (m *ServiceInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllowReadWriteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllowReadWriteRequest.DiscardUnknown(m)
+func (m *ServiceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllowReadWriteRequest proto.InternalMessageInfo
+var xxx_messageInfo_ServiceInfo proto.InternalMessageInfo
 
-func (m *AllowReadWriteRequest) GetRole() ServiceRole {
+func (m *ServiceInfo) GetRole() ServiceRole {
 	if m != nil {
 		return m.Role
 	}
 	return ServiceRole_UNKNOWN_ROLE
 }
 
-func (m *AllowReadWriteRequest) GetAddr() string {
+func (m *ServiceInfo) GetAddr() string {
 	if m != nil {
 		return m.Addr
 	}
 	return ""
 }
 
-type AllowReadWriteResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ServiceInfo) GetRootDir() string {
+	if m != nil {
+		return m.RootDir
+	}
+	return ""
 }
 
-func (m *AllowReadWriteResponse) Reset()         { *m = AllowReadWriteResponse{} }
-func (m *AllowReadWriteResponse) String() string { return proto.CompactTextString(m) }
-func (*AllowReadWriteResponse) ProtoMessage()    {}
-func (*AllowReadWriteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{9}
+func (m *ServiceInfo) GetDataDirs() []string {
+	if m != nil {
+		return m.DataDirs
+	}
+	return nil
 }
-func (m *AllowReadWriteResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *ServiceInfo) GetHostStatus() string {
+	if m != nil {
+		return m.HostStatus
+	}
+	return ""
 }
-func (m *AllowReadWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AllowReadWriteResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *ServiceInfo) GetLeaderParts() int64 {
+	if m != nil {
+		return m.LeaderParts
 	}
+	return 0
 }
-func (m *AllowReadWriteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllowReadWriteResponse.Merge(m, src)
-}
-func (m *AllowReadWriteResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *AllowReadWriteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllowReadWriteResponse.DiscardUnknown(m)
+
+func (m *ServiceInfo) GetTotalParts() int64 {
+	if m != nil {
+		return m.TotalParts
+	}
+	return 0
 }
 
-var xxx_messageInfo_AllowReadWriteResponse proto.InternalMessageInfo
+func (m *ServiceInfo) GetConf() string {
+	if m != nil {
+		return m.Conf
+	}
+	return ""
+}
 
-type DataPlayBackRequest struct {
-	Dir                  string   `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
-	DataPath             string   `protobuf:"bytes,2,opt,name=data_path,json=dataPath,proto3" json:"data_path,omitempty"`
-	MetaAddr             string   `protobuf:"bytes,3,opt,name=meta_addr,json=metaAddr,proto3" json:"meta_addr,omitempty"`
+// list the services in the agent machine learned from meta heartbeat
+type ListServicesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DataPlayBackRequest) Reset()         { *m = DataPlayBackRequest{} }
-func (m *DataPlayBackRequest) String() string { return proto.CompactTextString(m) }
-func (*DataPlayBackRequest) ProtoMessage()    {}
-func (*DataPlayBackRequest) Descriptor() ([]byte, []int) {
+func (m *ListServicesRequest) Reset()         { *m = ListServicesRequest{} }
+func (m *ListServicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListServicesRequest) ProtoMessage()    {}
+func (*ListServicesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_56ede974c0020f77, []int{10}
 }
-func (m *DataPlayBackRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListServicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataPlayBackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListServicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataPlayBackRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListServicesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -594,57 +869,37 @@ func (m *DataPlayBackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DataPlayBackRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataPlayBackRequest.Merge(m, src)
+func (m *ListServicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServicesRequest.Merge(m, src)
 }
-func (m *DataPlayBackRequest) XXX_Size() int {
+func (m *ListServicesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataPlayBackRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataPlayBackRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DataPlayBackRequest proto.InternalMessageInfo
-
-func (m *DataPlayBackRequest) GetDir() string {
-	if m != nil {
-		return m.Dir
-	}
-	return ""
-}
-
-func (m *DataPlayBackRequest) GetDataPath() string {
-	if m != nil {
-		return m.DataPath
-	}
-	return ""
+func (m *ListServicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServicesRequest.DiscardUnknown(m)
 }
 
-func (m *DataPlayBackRequest) GetMetaAddr() string {
-	if m != nil {
-		return m.MetaAddr
-	}
-	return ""
-}
+var xxx_messageInfo_ListServicesRequest proto.InternalMessageInfo
 
-type DataPlayBackResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListServicesResponse struct {
+	Services             []*ServiceInfo `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *DataPlayBackResponse) Reset()         { *m = DataPlayBackResponse{} }
-func (m *DataPlayBackResponse) String() string { return proto.CompactTextString(m) }
-func (*DataPlayBackResponse) ProtoMessage()    {}
-func (*DataPlayBackResponse) Descriptor() ([]byte, []int) {
+func (m *ListServicesResponse) Reset()         { *m = ListServicesResponse{} }
+func (m *ListServicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListServicesResponse) ProtoMessage()    {}
+func (*ListServicesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_56ede974c0020f77, []int{11}
 }
-func (m *DataPlayBackResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListServicesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataPlayBackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListServicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataPlayBackResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListServicesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -654,36 +909,44 @@ func (m *DataPlayBackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DataPlayBackResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataPlayBackResponse.Merge(m, src)
+func (m *ListServicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServicesResponse.Merge(m, src)
 }
-func (m *DataPlayBackResponse) XXX_Size() int {
+func (m *ListServicesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataPlayBackResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataPlayBackResponse.DiscardUnknown(m)
+func (m *ListServicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServicesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataPlayBackResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListServicesResponse proto.InternalMessageInfo
 
-type StopAgentRequest struct {
+func (m *ListServicesResponse) GetServices() []*ServiceInfo {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+// submit leader balance job to meta for all spaces
+type BalanceLeaderRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopAgentRequest) Reset()         { *m = StopAgentRequest{} }
-func (m *StopAgentRequest) String() string { return proto.CompactTextString(m) }
-func (*StopAgentRequest) ProtoMessage()    {}
-func (*StopAgentRequest) Descriptor() ([]byte, []int) {
+func (m *BalanceLeaderRequest) Reset()         { *m = BalanceLeaderRequest{} }
+func (m *BalanceLeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*BalanceLeaderRequest) ProtoMessage()    {}
+func (*BalanceLeaderRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_56ede974c0020f77, []int{12}
 }
-func (m *StopAgentRequest) XXX_Unmarshal(b []byte) error {
+func (m *BalanceLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StopAgentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BalanceLeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StopAgentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BalanceLeaderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -693,36 +956,36 @@ func (m *StopAgentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *StopAgentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopAgentRequest.Merge(m, src)
+func (m *BalanceLeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceLeaderRequest.Merge(m, src)
 }
-func (m *StopAgentRequest) XXX_Size() int {
+func (m *BalanceLeaderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StopAgentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopAgentRequest.DiscardUnknown(m)
+func (m *BalanceLeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceLeaderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StopAgentRequest proto.InternalMessageInfo
+var xxx_messageInfo_BalanceLeaderRequest proto.InternalMessageInfo
 
-type StopAgentResponse struct {
+type BalanceLeaderResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopAgentResponse) Reset()         { *m = StopAgentResponse{} }
-func (m *StopAgentResponse) String() string { return proto.CompactTextString(m) }
-func (*StopAgentResponse) ProtoMessage()    {}
-func (*StopAgentResponse) Descriptor() ([]byte, []int) {
+func (m *BalanceLeaderResponse) Reset()         { *m = BalanceLeaderResponse{} }
+func (m *BalanceLeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*BalanceLeaderResponse) ProtoMessage()    {}
+func (*BalanceLeaderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_56ede974c0020f77, []int{13}
 }
-func (m *StopAgentResponse) XXX_Unmarshal(b []byte) error {
+func (m *BalanceLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StopAgentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BalanceLeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StopAgentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BalanceLeaderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -732,36 +995,42 @@ func (m *StopAgentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *StopAgentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopAgentResponse.Merge(m, src)
+func (m *BalanceLeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceLeaderResponse.Merge(m, src)
 }
-func (m *StopAgentResponse) XXX_Size() int {
+func (m *BalanceLeaderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StopAgentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopAgentResponse.DiscardUnknown(m)
+func (m *BalanceLeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceLeaderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StopAgentResponse proto.InternalMessageInfo
+var xxx_messageInfo_BalanceLeaderResponse proto.InternalMessageInfo
 
-type HealthCheckRequest struct {
+type CrashRecord struct {
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// -1 if unknown
+	ExitCode             int32    `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	LogFile              string   `protobuf:"bytes,3,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
+	LogTail              []string `protobuf:"bytes,4,rep,name=log_tail,json=logTail,proto3" json:"log_tail,omitempty"`
+	CoreFiles            []string `protobuf:"bytes,5,rep,name=core_files,json=coreFiles,proto3" json:"core_files,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HealthCheckRequest) Reset()         { *m = HealthCheckRequest{} }
-func (m *HealthCheckRequest) String() string { return proto.CompactTextString(m) }
-func (*HealthCheckRequest) ProtoMessage()    {}
-func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+func (m *CrashRecord) Reset()         { *m = CrashRecord{} }
+func (m *CrashRecord) String() string { return proto.CompactTextString(m) }
+func (*CrashRecord) ProtoMessage()    {}
+func (*CrashRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_56ede974c0020f77, []int{14}
 }
-func (m *HealthCheckRequest) XXX_Unmarshal(b []byte) error {
+func (m *CrashRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HealthCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CrashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HealthCheckRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CrashRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -771,37 +1040,81 @@ func (m *HealthCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *HealthCheckRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HealthCheckRequest.Merge(m, src)
+func (m *CrashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrashRecord.Merge(m, src)
 }
-func (m *HealthCheckRequest) XXX_Size() int {
+func (m *CrashRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *HealthCheckRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HealthCheckRequest.DiscardUnknown(m)
+func (m *CrashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrashRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HealthCheckRequest proto.InternalMessageInfo
+var xxx_messageInfo_CrashRecord proto.InternalMessageInfo
 
-type HealthCheckResponse struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CrashRecord) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
 
-func (m *HealthCheckResponse) Reset()         { *m = HealthCheckResponse{} }
-func (m *HealthCheckResponse) String() string { return proto.CompactTextString(m) }
-func (*HealthCheckResponse) ProtoMessage()    {}
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+func (m *CrashRecord) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *CrashRecord) GetLogFile() string {
+	if m != nil {
+		return m.LogFile
+	}
+	return ""
+}
+
+func (m *CrashRecord) GetLogTail() []string {
+	if m != nil {
+		return m.LogTail
+	}
+	return nil
+}
+
+func (m *CrashRecord) GetCoreFiles() []string {
+	if m != nil {
+		return m.CoreFiles
+	}
+	return nil
+}
+
+type WatchedService struct {
+	Role            ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir             string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	State           WatchState  `protobuf:"varint,3,opt,name=state,proto3,enum=proto.WatchState" json:"state,omitempty"`
+	RestartCount    int64       `protobuf:"varint,4,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	LastRestartTime int64       `protobuf:"varint,5,opt,name=last_restart_time,json=lastRestartTime,proto3" json:"last_restart_time,omitempty"`
+	// the next restart time in BACKOFF state
+	NextRestartTime int64 `protobuf:"varint,6,opt,name=next_restart_time,json=nextRestartTime,proto3" json:"next_restart_time,omitempty"`
+	// recent crashes, the latest one is the last
+	Crashes              []*CrashRecord `protobuf:"bytes,7,rep,name=crashes,proto3" json:"crashes,omitempty"`
+	Conf                 string         `protobuf:"bytes,8,opt,name=conf,proto3" json:"conf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WatchedService) Reset()         { *m = WatchedService{} }
+func (m *WatchedService) String() string { return proto.CompactTextString(m) }
+func (*WatchedService) ProtoMessage()    {}
+func (*WatchedService) Descriptor() ([]byte, []int) {
 	return fileDescriptor_56ede974c0020f77, []int{15}
 }
-func (m *HealthCheckResponse) XXX_Unmarshal(b []byte) error {
+func (m *WatchedService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HealthCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchedService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HealthCheckResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchedService.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -811,44 +1124,92 @@ func (m *HealthCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *HealthCheckResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HealthCheckResponse.Merge(m, src)
+func (m *WatchedService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchedService.Merge(m, src)
 }
-func (m *HealthCheckResponse) XXX_Size() int {
+func (m *WatchedService) XXX_Size() int {
 	return m.Size()
 }
-func (m *HealthCheckResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_HealthCheckResponse.DiscardUnknown(m)
+func (m *WatchedService) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchedService.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HealthCheckResponse proto.InternalMessageInfo
+var xxx_messageInfo_WatchedService proto.InternalMessageInfo
 
-func (m *HealthCheckResponse) GetStatus() string {
+func (m *WatchedService) GetRole() ServiceRole {
 	if m != nil {
-		return m.Status
+		return m.Role
+	}
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *WatchedService) GetDir() string {
+	if m != nil {
+		return m.Dir
 	}
 	return ""
 }
 
-type GetSpaceUsagesRequest struct {
-	DataPath             string   `protobuf:"bytes,1,opt,name=data_path,json=dataPath,proto3" json:"data_path,omitempty"`
+func (m *WatchedService) GetState() WatchState {
+	if m != nil {
+		return m.State
+	}
+	return WatchState_UNKNOWN_WATCH_STATE
+}
+
+func (m *WatchedService) GetRestartCount() int64 {
+	if m != nil {
+		return m.RestartCount
+	}
+	return 0
+}
+
+func (m *WatchedService) GetLastRestartTime() int64 {
+	if m != nil {
+		return m.LastRestartTime
+	}
+	return 0
+}
+
+func (m *WatchedService) GetNextRestartTime() int64 {
+	if m != nil {
+		return m.NextRestartTime
+	}
+	return 0
+}
+
+func (m *WatchedService) GetCrashes() []*CrashRecord {
+	if m != nil {
+		return m.Crashes
+	}
+	return nil
+}
+
+func (m *WatchedService) GetConf() string {
+	if m != nil {
+		return m.Conf
+	}
+	return ""
+}
+
+type WatchdogStatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSpaceUsagesRequest) Reset()         { *m = GetSpaceUsagesRequest{} }
-func (m *GetSpaceUsagesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSpaceUsagesRequest) ProtoMessage()    {}
-func (*GetSpaceUsagesRequest) Descriptor() ([]byte, []int) {
+func (m *WatchdogStatusRequest) Reset()         { *m = WatchdogStatusRequest{} }
+func (m *WatchdogStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchdogStatusRequest) ProtoMessage()    {}
+func (*WatchdogStatusRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_56ede974c0020f77, []int{16}
 }
-func (m *GetSpaceUsagesRequest) XXX_Unmarshal(b []byte) error {
+func (m *WatchdogStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetSpaceUsagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchdogStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetSpaceUsagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchdogStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -858,44 +1219,38 @@ func (m *GetSpaceUsagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetSpaceUsagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSpaceUsagesRequest.Merge(m, src)
+func (m *WatchdogStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchdogStatusRequest.Merge(m, src)
 }
-func (m *GetSpaceUsagesRequest) XXX_Size() int {
+func (m *WatchdogStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetSpaceUsagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSpaceUsagesRequest.DiscardUnknown(m)
+func (m *WatchdogStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchdogStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSpaceUsagesRequest proto.InternalMessageInfo
-
-func (m *GetSpaceUsagesRequest) GetDataPath() string {
-	if m != nil {
-		return m.DataPath
-	}
-	return ""
-}
+var xxx_messageInfo_WatchdogStatusRequest proto.InternalMessageInfo
 
-type GetSpaceUsagesResponse struct {
-	SpaceUsages          []*GetSpaceUsagesResponse_SpaceUsageItem `protobuf:"bytes,1,rep,name=SpaceUsages,proto3" json:"SpaceUsages,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
-	XXX_unrecognized     []byte                                   `json:"-"`
-	XXX_sizecache        int32                                    `json:"-"`
+type WatchdogStatusResponse struct {
+	Enabled              bool              `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Services             []*WatchedService `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetSpaceUsagesResponse) Reset()         { *m = GetSpaceUsagesResponse{} }
-func (m *GetSpaceUsagesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSpaceUsagesResponse) ProtoMessage()    {}
-func (*GetSpaceUsagesResponse) Descriptor() ([]byte, []int) {
+func (m *WatchdogStatusResponse) Reset()         { *m = WatchdogStatusResponse{} }
+func (m *WatchdogStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchdogStatusResponse) ProtoMessage()    {}
+func (*WatchdogStatusResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_56ede974c0020f77, []int{17}
 }
-func (m *GetSpaceUsagesResponse) XXX_Unmarshal(b []byte) error {
+func (m *WatchdogStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetSpaceUsagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchdogStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetSpaceUsagesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchdogStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -905,45 +1260,63 @@ func (m *GetSpaceUsagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetSpaceUsagesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSpaceUsagesResponse.Merge(m, src)
+func (m *WatchdogStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchdogStatusResponse.Merge(m, src)
 }
-func (m *GetSpaceUsagesResponse) XXX_Size() int {
+func (m *WatchdogStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetSpaceUsagesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSpaceUsagesResponse.DiscardUnknown(m)
+func (m *WatchdogStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchdogStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSpaceUsagesResponse proto.InternalMessageInfo
+var xxx_messageInfo_WatchdogStatusResponse proto.InternalMessageInfo
 
-func (m *GetSpaceUsagesResponse) GetSpaceUsages() []*GetSpaceUsagesResponse_SpaceUsageItem {
+func (m *WatchdogStatusResponse) GetEnabled() bool {
 	if m != nil {
-		return m.SpaceUsages
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *WatchdogStatusResponse) GetServices() []*WatchedService {
+	if m != nil {
+		return m.Services
 	}
 	return nil
 }
 
-type GetSpaceUsagesResponse_SpaceUsageItem struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Usage                int64    `protobuf:"varint,2,opt,name=usage,proto3" json:"usage,omitempty"`
+type TailLogsRequest struct {
+	Role ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	// LOG_INFO if empty
+	Kinds []LogKind `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=proto.LogKind" json:"kinds,omitempty"`
+	// the last lines of each log to return at first, 100 if 0
+	Lines int32 `protobuf:"varint,4,opt,name=lines,proto3" json:"lines,omitempty"`
+	// keep sending the new lines until cancelled
+	Follow bool `protobuf:"varint,5,opt,name=follow,proto3" json:"follow,omitempty"`
+	// only return the lines matching the regular expression
+	Grep string `protobuf:"bytes,6,opt,name=grep,proto3" json:"grep,omitempty"`
+	// only return the lines logged after the unix time, the whole log is scanned if set
+	Since                int64    `protobuf:"varint,7,opt,name=since,proto3" json:"since,omitempty"`
+	Instance             string   `protobuf:"bytes,8,opt,name=instance,proto3" json:"instance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) Reset()         { *m = GetSpaceUsagesResponse_SpaceUsageItem{} }
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) String() string { return proto.CompactTextString(m) }
-func (*GetSpaceUsagesResponse_SpaceUsageItem) ProtoMessage()    {}
-func (*GetSpaceUsagesResponse_SpaceUsageItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56ede974c0020f77, []int{17, 0}
+func (m *TailLogsRequest) Reset()         { *m = TailLogsRequest{} }
+func (m *TailLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*TailLogsRequest) ProtoMessage()    {}
+func (*TailLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{18}
 }
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_Unmarshal(b []byte) error {
+func (m *TailLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TailLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetSpaceUsagesResponse_SpaceUsageItem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TailLogsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -953,1425 +1326,19427 @@ func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSpaceUsagesResponse_SpaceUsageItem.Merge(m, src)
+func (m *TailLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TailLogsRequest.Merge(m, src)
 }
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_Size() int {
+func (m *TailLogsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSpaceUsagesResponse_SpaceUsageItem.DiscardUnknown(m)
+func (m *TailLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TailLogsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSpaceUsagesResponse_SpaceUsageItem proto.InternalMessageInfo
+var xxx_messageInfo_TailLogsRequest proto.InternalMessageInfo
 
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) GetId() int64 {
+func (m *TailLogsRequest) GetRole() ServiceRole {
 	if m != nil {
-		return m.Id
+		return m.Role
 	}
-	return 0
+	return ServiceRole_UNKNOWN_ROLE
 }
 
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) GetUsage() int64 {
+func (m *TailLogsRequest) GetDir() string {
 	if m != nil {
-		return m.Usage
+		return m.Dir
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("proto.ServiceRole", ServiceRole_name, ServiceRole_value)
-	proto.RegisterEnum("proto.Status", Status_name, Status_value)
-	proto.RegisterType((*StartServiceRequest)(nil), "proto.StartServiceRequest")
-	proto.RegisterType((*StartServiceResponse)(nil), "proto.StartServiceResponse")
-	proto.RegisterType((*StopServiceRequest)(nil), "proto.StopServiceRequest")
-	proto.RegisterType((*StopServiceResponse)(nil), "proto.StopServiceResponse")
-	proto.RegisterType((*ServiceStatusRequest)(nil), "proto.ServiceStatusRequest")
-	proto.RegisterType((*ServiceStatusResponse)(nil), "proto.ServiceStatusResponse")
-	proto.RegisterType((*BanReadWriteRequest)(nil), "proto.BanReadWriteRequest")
-	proto.RegisterType((*BanReadWriteResponse)(nil), "proto.BanReadWriteResponse")
-	proto.RegisterType((*AllowReadWriteRequest)(nil), "proto.AllowReadWriteRequest")
-	proto.RegisterType((*AllowReadWriteResponse)(nil), "proto.AllowReadWriteResponse")
-	proto.RegisterType((*DataPlayBackRequest)(nil), "proto.DataPlayBackRequest")
-	proto.RegisterType((*DataPlayBackResponse)(nil), "proto.DataPlayBackResponse")
-	proto.RegisterType((*StopAgentRequest)(nil), "proto.StopAgentRequest")
-	proto.RegisterType((*StopAgentResponse)(nil), "proto.StopAgentResponse")
-	proto.RegisterType((*HealthCheckRequest)(nil), "proto.HealthCheckRequest")
-	proto.RegisterType((*HealthCheckResponse)(nil), "proto.HealthCheckResponse")
-	proto.RegisterType((*GetSpaceUsagesRequest)(nil), "proto.GetSpaceUsagesRequest")
-	proto.RegisterType((*GetSpaceUsagesResponse)(nil), "proto.GetSpaceUsagesResponse")
-	proto.RegisterType((*GetSpaceUsagesResponse_SpaceUsageItem)(nil), "proto.GetSpaceUsagesResponse.SpaceUsageItem")
+func (m *TailLogsRequest) GetKinds() []LogKind {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("agent.proto", fileDescriptor_56ede974c0020f77) }
-
-var fileDescriptor_56ede974c0020f77 = []byte{
-	// 672 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xed, 0x24, 0x4d, 0xbe, 0xe6, 0x26, 0x8d, 0xfc, 0xdd, 0xfc, 0x10, 0x5c, 0x88, 0x2a, 0x4b,
-	0xa0, 0xaa, 0x82, 0x2e, 0xca, 0xcf, 0xb2, 0x92, 0x4b, 0xa3, 0xb4, 0x40, 0x9d, 0x60, 0x27, 0x2a,
-	0xbb, 0x6a, 0xa8, 0x47, 0x4d, 0x44, 0x5a, 0x87, 0x64, 0x0a, 0xe2, 0x4d, 0xd8, 0xc1, 0xe3, 0xb0,
-	0xe4, 0x11, 0x50, 0x79, 0x11, 0x64, 0x7b, 0x3c, 0x99, 0x49, 0x87, 0x05, 0xa2, 0xab, 0x38, 0xf7,
-	0xcc, 0x9c, 0x73, 0x7f, 0xe6, 0x5c, 0x28, 0xd3, 0x73, 0x76, 0xc9, 0x77, 0xa6, 0xb3, 0x88, 0x47,
-	0x58, 0x48, 0x7e, 0x9c, 0x1e, 0xd4, 0x02, 0x4e, 0x67, 0x3c, 0x60, 0xb3, 0x8f, 0xe3, 0x33, 0xe6,
-	0xb3, 0x0f, 0x57, 0x6c, 0xce, 0xf1, 0x21, 0xac, 0xce, 0xa2, 0x09, 0x6b, 0x91, 0x4d, 0xb2, 0x55,
-	0xdd, 0xc5, 0xf4, 0xce, 0x4e, 0x76, 0x28, 0x9a, 0x30, 0x3f, 0xc1, 0xd1, 0x82, 0x7c, 0x38, 0x9e,
-	0xb5, 0x72, 0x9b, 0x64, 0xab, 0xe4, 0xc7, 0x9f, 0x4e, 0x13, 0xea, 0x3a, 0xe1, 0x7c, 0x1a, 0x5d,
-	0xce, 0x99, 0xe3, 0x01, 0x06, 0x3c, 0x9a, 0xde, 0x9a, 0x4e, 0x23, 0x4e, 0x5c, 0xe1, 0x13, 0x32,
-	0x7d, 0xa8, 0x8b, 0x50, 0xc0, 0x29, 0xbf, 0x9a, 0xff, 0xbb, 0xd0, 0x1e, 0x34, 0x96, 0x18, 0x53,
-	0x29, 0x7c, 0x00, 0xc5, 0x79, 0x12, 0x11, 0xa4, 0xeb, 0x19, 0x69, 0x7a, 0x4c, 0x80, 0xce, 0x1b,
-	0xa8, 0xed, 0xd3, 0x4b, 0x9f, 0xd1, 0xf0, 0x64, 0x36, 0xe6, 0x7f, 0x5d, 0x39, 0xc2, 0x2a, 0x0d,
-	0xc3, 0x2c, 0xa3, 0xe4, 0x3b, 0xee, 0xb1, 0x4e, 0x29, 0x8a, 0x0f, 0xa0, 0xe1, 0x4e, 0x26, 0xd1,
-	0xa7, 0x5b, 0x15, 0x6b, 0x41, 0x73, 0x99, 0x54, 0xc8, 0x51, 0xa8, 0x1d, 0x50, 0x4e, 0xfb, 0x13,
-	0xfa, 0x79, 0x9f, 0x9e, 0xbd, 0xcf, 0xc4, 0x44, 0x0b, 0x89, 0x6c, 0x21, 0x6e, 0x40, 0x29, 0xa4,
-	0x9c, 0x9e, 0x4e, 0x29, 0x1f, 0x09, 0xee, 0xb5, 0x38, 0xd0, 0xa7, 0x7c, 0x14, 0x83, 0x17, 0x8c,
-	0xd3, 0xd3, 0x44, 0x38, 0x9f, 0x82, 0x71, 0xc0, 0x15, 0x95, 0xea, 0x12, 0x42, 0x1a, 0xc1, 0x8a,
-	0xa7, 0xef, 0xc6, 0x0f, 0x5a, 0xe8, 0x3a, 0x35, 0xf8, 0x5f, 0x89, 0x89, 0x83, 0x75, 0xc0, 0x43,
-	0x46, 0x27, 0x7c, 0xf4, 0x62, 0xc4, 0x64, 0x8a, 0xce, 0x63, 0xa8, 0x69, 0x51, 0x31, 0xd1, 0xa6,
-	0x36, 0xd1, 0x92, 0x1c, 0xe1, 0x53, 0x68, 0x74, 0x19, 0x0f, 0xa6, 0xf4, 0x8c, 0x0d, 0xe7, 0xf4,
-	0x9c, 0xc9, 0x57, 0xa5, 0x15, 0x46, 0xf4, 0xc2, 0x9c, 0x6f, 0x04, 0x9a, 0xcb, 0xd7, 0x84, 0x90,
-	0x07, 0x65, 0x25, 0xdc, 0x22, 0x9b, 0xf9, 0xad, 0xf2, 0xee, 0x23, 0x31, 0x16, 0xf3, 0x9d, 0x9d,
-	0x45, 0xec, 0x88, 0xb3, 0x0b, 0x5f, 0x25, 0xb0, 0x9f, 0x43, 0x55, 0x87, 0xb1, 0x0a, 0xb9, 0x71,
-	0x98, 0xa4, 0x94, 0xf7, 0x73, 0xe3, 0x10, 0xeb, 0x50, 0xb8, 0x8a, 0xc1, 0xa4, 0xfd, 0x79, 0x3f,
-	0xfd, 0xb3, 0xed, 0x42, 0x59, 0x79, 0x04, 0x68, 0x41, 0x65, 0xe8, 0xbd, 0xf2, 0x7a, 0x27, 0xde,
-	0xa9, 0xdf, 0x7b, 0xdd, 0xb1, 0x56, 0x70, 0x0d, 0x56, 0x8f, 0x3b, 0x03, 0xd7, 0x22, 0x58, 0x82,
-	0x42, 0xd7, 0x77, 0xfb, 0x87, 0x56, 0x0e, 0xcb, 0xf0, 0x5f, 0x30, 0xe8, 0xf9, 0x6e, 0xb7, 0x63,
-	0xe5, 0xb7, 0x9f, 0x41, 0x31, 0x7d, 0xf0, 0x88, 0x50, 0xcd, 0x6e, 0x07, 0x03, 0x77, 0x30, 0x0c,
-	0xac, 0x95, 0xf8, 0xa8, 0x3f, 0xf4, 0xbc, 0x23, 0xaf, 0x6b, 0x11, 0x04, 0x28, 0x76, 0xde, 0x1e,
-	0x0d, 0x3a, 0x07, 0x56, 0x6e, 0xf7, 0x6b, 0x01, 0x2a, 0xc9, 0xa4, 0x84, 0x3e, 0x76, 0xa1, 0xa2,
-	0xee, 0x0d, 0xb4, 0x17, 0x6e, 0x5a, 0xde, 0x4e, 0xf6, 0x86, 0x11, 0x13, 0xbd, 0x3d, 0x80, 0xb2,
-	0xb2, 0x18, 0xf0, 0xae, 0x3c, 0xbb, 0xbc, 0x7c, 0x6c, 0xdb, 0x04, 0x09, 0x96, 0x97, 0xb0, 0xae,
-	0xb9, 0x1e, 0x37, 0x74, 0xd3, 0x68, 0xdb, 0xc5, 0xbe, 0x67, 0x06, 0x05, 0x57, 0x17, 0x2a, 0xaa,
-	0x5d, 0x65, 0x69, 0x86, 0xb5, 0x20, 0x4b, 0x33, 0xf9, 0x1b, 0x8f, 0xa1, 0xaa, 0x5b, 0x11, 0x33,
-	0x61, 0xa3, 0xed, 0xed, 0xfb, 0x7f, 0x40, 0x17, 0x79, 0xa9, 0xe6, 0x92, 0x79, 0x19, 0x4c, 0x2d,
-	0xf3, 0x32, 0xb9, 0x11, 0xf7, 0xa0, 0x24, 0x9d, 0x87, 0x77, 0x94, 0xae, 0xaa, 0xfe, 0xb4, 0x5b,
-	0x37, 0x81, 0xc5, 0xc8, 0x14, 0x3b, 0xca, 0x91, 0xdd, 0x34, 0xae, 0x1c, 0x99, 0xc9, 0xbd, 0xc7,
-	0x50, 0xd5, 0xad, 0x23, 0xbb, 0x63, 0x34, 0xaf, 0xec, 0x8e, 0xd9, 0x6f, 0xfb, 0xd6, 0xf7, 0xeb,
-	0x36, 0xf9, 0x71, 0xdd, 0x26, 0x3f, 0xaf, 0xdb, 0xe4, 0xcb, 0xaf, 0xf6, 0xca, 0xbb, 0x62, 0x72,
-	0xfe, 0xc9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x6f, 0x9b, 0x9c, 0x48, 0x07, 0x00, 0x00,
+func (m *TailLogsRequest) GetLines() int32 {
+	if m != nil {
+		return m.Lines
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *TailLogsRequest) GetFollow() bool {
+	if m != nil {
+		return m.Follow
+	}
+	return false
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *TailLogsRequest) GetGrep() string {
+	if m != nil {
+		return m.Grep
+	}
+	return ""
+}
 
-// AgentServiceClient is the client API for AgentService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AgentServiceClient interface {
-	StartService(ctx context.Context, in *StartServiceRequest, opts ...grpc.CallOption) (*StartServiceResponse, error)
-	StopService(ctx context.Context, in *StopServiceRequest, opts ...grpc.CallOption) (*StopServiceResponse, error)
-	ServiceStatus(ctx context.Context, in *ServiceStatusRequest, opts ...grpc.CallOption) (*ServiceStatusResponse, error)
-	BanReadWrite(ctx context.Context, in *BanReadWriteRequest, opts ...grpc.CallOption) (*BanReadWriteResponse, error)
-	AllowReadWrite(ctx context.Context, in *AllowReadWriteRequest, opts ...grpc.CallOption) (*AllowReadWriteResponse, error)
-	DataPlayBack(ctx context.Context, in *DataPlayBackRequest, opts ...grpc.CallOption) (*DataPlayBackResponse, error)
-	StopAgent(ctx context.Context, in *StopAgentRequest, opts ...grpc.CallOption) (*StopAgentResponse, error)
-	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
-	GetSpaceUsages(ctx context.Context, in *GetSpaceUsagesRequest, opts ...grpc.CallOption) (*GetSpaceUsagesResponse, error)
+func (m *TailLogsRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
 }
 
-type agentServiceClient struct {
-	cc *grpc.ClientConn
+func (m *TailLogsRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
 }
 
-func NewAgentServiceClient(cc *grpc.ClientConn) AgentServiceClient {
-	return &agentServiceClient{cc}
+type TailLogsResponse struct {
+	Kind                 LogKind  `protobuf:"varint,1,opt,name=kind,proto3,enum=proto.LogKind" json:"kind,omitempty"`
+	File                 string   `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Lines                []string `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *agentServiceClient) StartService(ctx context.Context, in *StartServiceRequest, opts ...grpc.CallOption) (*StartServiceResponse, error) {
-	out := new(StartServiceResponse)
-	err := c.cc.Invoke(ctx, "/proto.AgentService/StartService", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TailLogsResponse) Reset()         { *m = TailLogsResponse{} }
+func (m *TailLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*TailLogsResponse) ProtoMessage()    {}
+func (*TailLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{19}
+}
+func (m *TailLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TailLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TailLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TailLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TailLogsResponse.Merge(m, src)
+}
+func (m *TailLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TailLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TailLogsResponse.DiscardUnknown(m)
 }
 
-func (c *agentServiceClient) StopService(ctx context.Context, in *StopServiceRequest, opts ...grpc.CallOption) (*StopServiceResponse, error) {
-	out := new(StopServiceResponse)
-	err := c.cc.Invoke(ctx, "/proto.AgentService/StopService", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TailLogsResponse proto.InternalMessageInfo
+
+func (m *TailLogsResponse) GetKind() LogKind {
+	if m != nil {
+		return m.Kind
 	}
-	return out, nil
+	return LogKind_UNKNOWN_LOG
 }
 
-func (c *agentServiceClient) ServiceStatus(ctx context.Context, in *ServiceStatusRequest, opts ...grpc.CallOption) (*ServiceStatusResponse, error) {
-	out := new(ServiceStatusResponse)
-	err := c.cc.Invoke(ctx, "/proto.AgentService/ServiceStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TailLogsResponse) GetFile() string {
+	if m != nil {
+		return m.File
 	}
-	return out, nil
+	return ""
 }
 
-func (c *agentServiceClient) BanReadWrite(ctx context.Context, in *BanReadWriteRequest, opts ...grpc.CallOption) (*BanReadWriteResponse, error) {
-	out := new(BanReadWriteResponse)
-	err := c.cc.Invoke(ctx, "/proto.AgentService/BanReadWrite", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TailLogsResponse) GetLines() []string {
+	if m != nil {
+		return m.Lines
 	}
-	return out, nil
+	return nil
 }
 
-func (c *agentServiceClient) AllowReadWrite(ctx context.Context, in *AllowReadWriteRequest, opts ...grpc.CallOption) (*AllowReadWriteResponse, error) {
-	out := new(AllowReadWriteResponse)
-	err := c.cc.Invoke(ctx, "/proto.AgentService/AllowReadWrite", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetServiceConfigRequest struct {
+	Role ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	// the backup version to read, the current config if 0
+	Version              int64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Instance             string   `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *agentServiceClient) DataPlayBack(ctx context.Context, in *DataPlayBackRequest, opts ...grpc.CallOption) (*DataPlayBackResponse, error) {
-	out := new(DataPlayBackResponse)
-	err := c.cc.Invoke(ctx, "/proto.AgentService/DataPlayBack", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetServiceConfigRequest) Reset()         { *m = GetServiceConfigRequest{} }
+func (m *GetServiceConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetServiceConfigRequest) ProtoMessage()    {}
+func (*GetServiceConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{20}
+}
+func (m *GetServiceConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetServiceConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetServiceConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetServiceConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServiceConfigRequest.Merge(m, src)
+}
+func (m *GetServiceConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetServiceConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServiceConfigRequest.DiscardUnknown(m)
 }
 
-func (c *agentServiceClient) StopAgent(ctx context.Context, in *StopAgentRequest, opts ...grpc.CallOption) (*StopAgentResponse, error) {
-	out := new(StopAgentResponse)
-	err := c.cc.Invoke(ctx, "/proto.AgentService/StopAgent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetServiceConfigRequest proto.InternalMessageInfo
+
+func (m *GetServiceConfigRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
 	}
-	return out, nil
+	return ServiceRole_UNKNOWN_ROLE
 }
 
-func (c *agentServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, "/proto.AgentService/HealthCheck", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetServiceConfigRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
 	}
-	return out, nil
+	return ""
 }
 
-func (c *agentServiceClient) GetSpaceUsages(ctx context.Context, in *GetSpaceUsagesRequest, opts ...grpc.CallOption) (*GetSpaceUsagesResponse, error) {
-	out := new(GetSpaceUsagesResponse)
-	err := c.cc.Invoke(ctx, "/proto.AgentService/GetSpaceUsages", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetServiceConfigRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return out, nil
+	return 0
 }
 
-// AgentServiceServer is the server API for AgentService service.
-type AgentServiceServer interface {
-	StartService(context.Context, *StartServiceRequest) (*StartServiceResponse, error)
-	StopService(context.Context, *StopServiceRequest) (*StopServiceResponse, error)
-	ServiceStatus(context.Context, *ServiceStatusRequest) (*ServiceStatusResponse, error)
-	BanReadWrite(context.Context, *BanReadWriteRequest) (*BanReadWriteResponse, error)
-	AllowReadWrite(context.Context, *AllowReadWriteRequest) (*AllowReadWriteResponse, error)
-	DataPlayBack(context.Context, *DataPlayBackRequest) (*DataPlayBackResponse, error)
-	StopAgent(context.Context, *StopAgentRequest) (*StopAgentResponse, error)
-	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	GetSpaceUsages(context.Context, *GetSpaceUsagesRequest) (*GetSpaceUsagesResponse, error)
+func (m *GetServiceConfigRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
 }
 
-// UnimplementedAgentServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAgentServiceServer struct {
+type GetServiceConfigResponse struct {
+	Flags map[string]string `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the raw content of the config file
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// the backup versions could be rolled back to, the latest one is the last
+	Versions             []int64  `protobuf:"varint,3,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedAgentServiceServer) StartService(ctx context.Context, req *StartServiceRequest) (*StartServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartService not implemented")
+func (m *GetServiceConfigResponse) Reset()         { *m = GetServiceConfigResponse{} }
+func (m *GetServiceConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetServiceConfigResponse) ProtoMessage()    {}
+func (*GetServiceConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{21}
 }
-func (*UnimplementedAgentServiceServer) StopService(ctx context.Context, req *StopServiceRequest) (*StopServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopService not implemented")
+func (m *GetServiceConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAgentServiceServer) ServiceStatus(ctx context.Context, req *ServiceStatusRequest) (*ServiceStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceStatus not implemented")
+func (m *GetServiceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetServiceConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAgentServiceServer) BanReadWrite(ctx context.Context, req *BanReadWriteRequest) (*BanReadWriteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BanReadWrite not implemented")
+func (m *GetServiceConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServiceConfigResponse.Merge(m, src)
 }
-func (*UnimplementedAgentServiceServer) AllowReadWrite(ctx context.Context, req *AllowReadWriteRequest) (*AllowReadWriteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowReadWrite not implemented")
+func (m *GetServiceConfigResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAgentServiceServer) DataPlayBack(ctx context.Context, req *DataPlayBackRequest) (*DataPlayBackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DataPlayBack not implemented")
+func (m *GetServiceConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServiceConfigResponse.DiscardUnknown(m)
 }
-func (*UnimplementedAgentServiceServer) StopAgent(ctx context.Context, req *StopAgentRequest) (*StopAgentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopAgent not implemented")
+
+var xxx_messageInfo_GetServiceConfigResponse proto.InternalMessageInfo
+
+func (m *GetServiceConfigResponse) GetFlags() map[string]string {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
 }
-func (*UnimplementedAgentServiceServer) HealthCheck(ctx context.Context, req *HealthCheckRequest) (*HealthCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+
+func (m *GetServiceConfigResponse) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
 }
-func (*UnimplementedAgentServiceServer) GetSpaceUsages(ctx context.Context, req *GetSpaceUsagesRequest) (*GetSpaceUsagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSpaceUsages not implemented")
+
+func (m *GetServiceConfigResponse) GetVersions() []int64 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
 }
 
-func RegisterAgentServiceServer(s *grpc.Server, srv AgentServiceServer) {
-	s.RegisterService(&_AgentService_serviceDesc, srv)
+type UpdateServiceConfigRequest struct {
+	Role   ServiceRole       `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir    string            `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	Set    map[string]string `protobuf:"bytes,3,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Remove []string          `protobuf:"bytes,4,rep,name=remove,proto3" json:"remove,omitempty"`
+	// only validate the changes without writing the config file
+	DryRun               bool     `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Instance             string   `protobuf:"bytes,6,opt,name=instance,proto3" json:"instance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AgentService_StartService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartServiceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *UpdateServiceConfigRequest) Reset()         { *m = UpdateServiceConfigRequest{} }
+func (m *UpdateServiceConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateServiceConfigRequest) ProtoMessage()    {}
+func (*UpdateServiceConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{22}
+}
+func (m *UpdateServiceConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateServiceConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateServiceConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).StartService(ctx, in)
+}
+func (m *UpdateServiceConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateServiceConfigRequest.Merge(m, src)
+}
+func (m *UpdateServiceConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateServiceConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateServiceConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateServiceConfigRequest proto.InternalMessageInfo
+
+func (m *UpdateServiceConfigRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.AgentService/StartService",
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *UpdateServiceConfigRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).StartService(ctx, req.(*StartServiceRequest))
+	return ""
+}
+
+func (m *UpdateServiceConfigRequest) GetSet() map[string]string {
+	if m != nil {
+		return m.Set
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AgentService_StopService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopServiceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *UpdateServiceConfigRequest) GetRemove() []string {
+	if m != nil {
+		return m.Remove
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).StopService(ctx, in)
+	return nil
+}
+
+func (m *UpdateServiceConfigRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.AgentService/StopService",
+	return false
+}
+
+func (m *UpdateServiceConfigRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).StopService(ctx, req.(*StopServiceRequest))
+	return ""
+}
+
+type UpdateServiceConfigResponse struct {
+	// the version of the backup of the previous config
+	BackupVersion   int64 `protobuf:"varint,1,opt,name=backup_version,json=backupVersion,proto3" json:"backup_version,omitempty"`
+	RestartRequired bool  `protobuf:"varint,2,opt,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	// the changed flags which could not take effect at runtime
+	RestartFlags         []string `protobuf:"bytes,3,rep,name=restart_flags,json=restartFlags,proto3" json:"restart_flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateServiceConfigResponse) Reset()         { *m = UpdateServiceConfigResponse{} }
+func (m *UpdateServiceConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateServiceConfigResponse) ProtoMessage()    {}
+func (*UpdateServiceConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{23}
+}
+func (m *UpdateServiceConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateServiceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateServiceConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UpdateServiceConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateServiceConfigResponse.Merge(m, src)
+}
+func (m *UpdateServiceConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateServiceConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateServiceConfigResponse.DiscardUnknown(m)
 }
 
-func _AgentService_ServiceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateServiceConfigResponse proto.InternalMessageInfo
+
+func (m *UpdateServiceConfigResponse) GetBackupVersion() int64 {
+	if m != nil {
+		return m.BackupVersion
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).ServiceStatus(ctx, in)
+	return 0
+}
+
+func (m *UpdateServiceConfigResponse) GetRestartRequired() bool {
+	if m != nil {
+		return m.RestartRequired
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.AgentService/ServiceStatus",
+	return false
+}
+
+func (m *UpdateServiceConfigResponse) GetRestartFlags() []string {
+	if m != nil {
+		return m.RestartFlags
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).ServiceStatus(ctx, req.(*ServiceStatusRequest))
+	return nil
+}
+
+type RollbackServiceConfigRequest struct {
+	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir                  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	Version              int64       `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Instance             string      `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RollbackServiceConfigRequest) Reset()         { *m = RollbackServiceConfigRequest{} }
+func (m *RollbackServiceConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackServiceConfigRequest) ProtoMessage()    {}
+func (*RollbackServiceConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{24}
+}
+func (m *RollbackServiceConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackServiceConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackServiceConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RollbackServiceConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackServiceConfigRequest.Merge(m, src)
+}
+func (m *RollbackServiceConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackServiceConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackServiceConfigRequest.DiscardUnknown(m)
 }
 
-func _AgentService_BanReadWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BanReadWriteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_RollbackServiceConfigRequest proto.InternalMessageInfo
+
+func (m *RollbackServiceConfigRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).BanReadWrite(ctx, in)
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *RollbackServiceConfigRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.AgentService/BanReadWrite",
+	return ""
+}
+
+func (m *RollbackServiceConfigRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).BanReadWrite(ctx, req.(*BanReadWriteRequest))
+	return 0
+}
+
+func (m *RollbackServiceConfigRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AgentService_AllowReadWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllowReadWriteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type RollbackServiceConfigResponse struct {
+	// the version of the backup of the config before rollback
+	BackupVersion        int64    `protobuf:"varint,1,opt,name=backup_version,json=backupVersion,proto3" json:"backup_version,omitempty"`
+	RestartRequired      bool     `protobuf:"varint,2,opt,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	RestartFlags         []string `protobuf:"bytes,3,rep,name=restart_flags,json=restartFlags,proto3" json:"restart_flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackServiceConfigResponse) Reset()         { *m = RollbackServiceConfigResponse{} }
+func (m *RollbackServiceConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackServiceConfigResponse) ProtoMessage()    {}
+func (*RollbackServiceConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{25}
+}
+func (m *RollbackServiceConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackServiceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackServiceConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).AllowReadWrite(ctx, in)
+}
+func (m *RollbackServiceConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackServiceConfigResponse.Merge(m, src)
+}
+func (m *RollbackServiceConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackServiceConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackServiceConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackServiceConfigResponse proto.InternalMessageInfo
+
+func (m *RollbackServiceConfigResponse) GetBackupVersion() int64 {
+	if m != nil {
+		return m.BackupVersion
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.AgentService/AllowReadWrite",
+	return 0
+}
+
+func (m *RollbackServiceConfigResponse) GetRestartRequired() bool {
+	if m != nil {
+		return m.RestartRequired
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).AllowReadWrite(ctx, req.(*AllowReadWriteRequest))
+	return false
+}
+
+func (m *RollbackServiceConfigResponse) GetRestartFlags() []string {
+	if m != nil {
+		return m.RestartFlags
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AgentService_DataPlayBack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DataPlayBackRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type FlagValue struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//	*FlagValue_BoolValue
+	//	*FlagValue_IntValue
+	//	*FlagValue_DoubleValue
+	//	*FlagValue_StringValue
+	Value                isFlagValue_Value `protobuf_oneof:"value"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FlagValue) Reset()         { *m = FlagValue{} }
+func (m *FlagValue) String() string { return proto.CompactTextString(m) }
+func (*FlagValue) ProtoMessage()    {}
+func (*FlagValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{26}
+}
+func (m *FlagValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlagValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlagValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).DataPlayBack(ctx, in)
+}
+func (m *FlagValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlagValue.Merge(m, src)
+}
+func (m *FlagValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlagValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlagValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlagValue proto.InternalMessageInfo
+
+type isFlagValue_Value interface {
+	isFlagValue_Value()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type FlagValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,2,opt,name=bool_value,json=boolValue,proto3,oneof" json:"bool_value,omitempty"`
+}
+type FlagValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof" json:"int_value,omitempty"`
+}
+type FlagValue_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,4,opt,name=double_value,json=doubleValue,proto3,oneof" json:"double_value,omitempty"`
+}
+type FlagValue_StringValue struct {
+	StringValue string `protobuf:"bytes,5,opt,name=string_value,json=stringValue,proto3,oneof" json:"string_value,omitempty"`
+}
+
+func (*FlagValue_BoolValue) isFlagValue_Value()   {}
+func (*FlagValue_IntValue) isFlagValue_Value()    {}
+func (*FlagValue_DoubleValue) isFlagValue_Value() {}
+func (*FlagValue_StringValue) isFlagValue_Value() {}
+
+func (m *FlagValue) GetValue() isFlagValue_Value {
+	if m != nil {
+		return m.Value
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.AgentService/DataPlayBack",
+	return nil
+}
+
+func (m *FlagValue) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).DataPlayBack(ctx, req.(*DataPlayBackRequest))
+	return ""
+}
+
+func (m *FlagValue) GetBoolValue() bool {
+	if x, ok := m.GetValue().(*FlagValue_BoolValue); ok {
+		return x.BoolValue
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AgentService_StopAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopAgentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *FlagValue) GetIntValue() int64 {
+	if x, ok := m.GetValue().(*FlagValue_IntValue); ok {
+		return x.IntValue
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).StopAgent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.AgentService/StopAgent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).StopAgent(ctx, req.(*StopAgentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AgentService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HealthCheckRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *FlagValue) GetDoubleValue() float64 {
+	if x, ok := m.GetValue().(*FlagValue_DoubleValue); ok {
+		return x.DoubleValue
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).HealthCheck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.AgentService/HealthCheck",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AgentService_GetSpaceUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSpaceUsagesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *FlagValue) GetStringValue() string {
+	if x, ok := m.GetValue().(*FlagValue_StringValue); ok {
+		return x.StringValue
 	}
-	if interceptor == nil {
-		return srv.(AgentServiceServer).GetSpaceUsages(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.AgentService/GetSpaceUsages",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).GetSpaceUsages(ctx, req.(*GetSpaceUsagesRequest))
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FlagValue) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FlagValue_BoolValue)(nil),
+		(*FlagValue_IntValue)(nil),
+		(*FlagValue_DoubleValue)(nil),
+		(*FlagValue_StringValue)(nil),
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-var _AgentService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.AgentService",
-	HandlerType: (*AgentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StartService",
-			Handler:    _AgentService_StartService_Handler,
-		},
-		{
-			MethodName: "StopService",
-			Handler:    _AgentService_StopService_Handler,
-		},
-		{
-			MethodName: "ServiceStatus",
-			Handler:    _AgentService_ServiceStatus_Handler,
-		},
-		{
-			MethodName: "BanReadWrite",
-			Handler:    _AgentService_BanReadWrite_Handler,
-		},
-		{
-			MethodName: "AllowReadWrite",
-			Handler:    _AgentService_AllowReadWrite_Handler,
-		},
-		{
-			MethodName: "DataPlayBack",
-			Handler:    _AgentService_DataPlayBack_Handler,
-		},
-		{
-			MethodName: "StopAgent",
-			Handler:    _AgentService_StopAgent_Handler,
-		},
-		{
-			MethodName: "HealthCheck",
-			Handler:    _AgentService_HealthCheck_Handler,
-		},
-		{
-			MethodName: "GetSpaceUsages",
-			Handler:    _AgentService_GetSpaceUsages_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agent.proto",
+type GetFlagsRequest struct {
+	Role ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	// the rpc address of the service, needed only when several services of the role in agent machine
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// all flags if empty
+	Names                []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StartServiceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetFlagsRequest) Reset()         { *m = GetFlagsRequest{} }
+func (m *GetFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFlagsRequest) ProtoMessage()    {}
+func (*GetFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{27}
+}
+func (m *GetFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFlagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *StartServiceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlagsRequest.Merge(m, src)
+}
+func (m *GetFlagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlagsRequest.DiscardUnknown(m)
 }
 
-func (m *StartServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_GetFlagsRequest proto.InternalMessageInfo
+
+func (m *GetFlagsRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
 	}
-	if m.Role != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x8
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *GetFlagsRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *StartServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetFlagsRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StartServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetFlagsResponse struct {
+	Flags                []*FlagValue `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *StartServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GetFlagsResponse) Reset()         { *m = GetFlagsResponse{} }
+func (m *GetFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFlagsResponse) ProtoMessage()    {}
+func (*GetFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{28}
+}
+func (m *GetFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlagsResponse.Merge(m, src)
+}
+func (m *GetFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlagsResponse.DiscardUnknown(m)
 }
 
-func (m *StopServiceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetFlagsResponse proto.InternalMessageInfo
+
+func (m *GetFlagsResponse) GetFlags() []*FlagValue {
+	if m != nil {
+		return m.Flags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StopServiceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetFlagsRequest struct {
+	Role  ServiceRole       `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Addr  string            `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Flags map[string]string `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// also write the flags to the config file to survive restart
+	Persist              bool     `protobuf:"varint,4,opt,name=persist,proto3" json:"persist,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SetFlagsRequest) Reset()         { *m = SetFlagsRequest{} }
+func (m *SetFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetFlagsRequest) ProtoMessage()    {}
+func (*SetFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{29}
+}
+func (m *SetFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetFlagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SetFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFlagsRequest.Merge(m, src)
+}
+func (m *SetFlagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFlagsRequest proto.InternalMessageInfo
+
+func (m *SetFlagsRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
 	}
-	if m.Role != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x8
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *SetFlagsRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *StopServiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetFlagsRequest) GetFlags() map[string]string {
+	if m != nil {
+		return m.Flags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StopServiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetFlagsRequest) GetPersist() bool {
+	if m != nil {
+		return m.Persist
+	}
+	return false
 }
 
-func (m *StopServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ServiceStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type SetFlagsResponse struct {
+	// the version of the config backup if persisted
+	BackupVersion        int64    `protobuf:"varint,1,opt,name=backup_version,json=backupVersion,proto3" json:"backup_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServiceStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetFlagsResponse) Reset()         { *m = SetFlagsResponse{} }
+func (m *SetFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetFlagsResponse) ProtoMessage()    {}
+func (*SetFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{30}
 }
-
-func (m *ServiceStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Role != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *SetFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ServiceStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ServiceStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFlagsResponse.Merge(m, src)
 }
-
-func (m *ServiceStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *SetFlagsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *BanReadWriteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SetFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFlagsResponse.DiscardUnknown(m)
 }
 
-func (m *BanReadWriteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_SetFlagsResponse proto.InternalMessageInfo
 
-func (m *BanReadWriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Role != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x8
+func (m *SetFlagsResponse) GetBackupVersion() int64 {
+	if m != nil {
+		return m.BackupVersion
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BanReadWriteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// only the writes of storaged and the new connections of graphd are blocked
+type BanReadWriteRequest struct {
+	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Addr                 string      `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *BanReadWriteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BanReadWriteRequest) Reset()         { *m = BanReadWriteRequest{} }
+func (m *BanReadWriteRequest) String() string { return proto.CompactTextString(m) }
+func (*BanReadWriteRequest) ProtoMessage()    {}
+func (*BanReadWriteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{31}
 }
-
-func (m *BanReadWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+func (m *BanReadWriteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *AllowReadWriteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BanReadWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BanReadWriteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *AllowReadWriteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BanReadWriteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanReadWriteRequest.Merge(m, src)
 }
-
-func (m *AllowReadWriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Role != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *BanReadWriteRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *AllowReadWriteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BanReadWriteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanReadWriteRequest.DiscardUnknown(m)
 }
 
-func (m *AllowReadWriteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_BanReadWriteRequest proto.InternalMessageInfo
 
-func (m *AllowReadWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *BanReadWriteRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
 	}
-	return len(dAtA) - i, nil
+	return ServiceRole_UNKNOWN_ROLE
 }
 
-func (m *DataPlayBackRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BanReadWriteRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DataPlayBackRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BanReadWriteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DataPlayBackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.MetaAddr) > 0 {
-		i -= len(m.MetaAddr)
-		copy(dAtA[i:], m.MetaAddr)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.MetaAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DataPath) > 0 {
-		i -= len(m.DataPath)
-		copy(dAtA[i:], m.DataPath)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.DataPath)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0xa
+func (m *BanReadWriteResponse) Reset()         { *m = BanReadWriteResponse{} }
+func (m *BanReadWriteResponse) String() string { return proto.CompactTextString(m) }
+func (*BanReadWriteResponse) ProtoMessage()    {}
+func (*BanReadWriteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{32}
+}
+func (m *BanReadWriteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BanReadWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BanReadWriteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BanReadWriteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanReadWriteResponse.Merge(m, src)
+}
+func (m *BanReadWriteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BanReadWriteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanReadWriteResponse.DiscardUnknown(m)
 }
 
-func (m *DataPlayBackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_BanReadWriteResponse proto.InternalMessageInfo
+
+type AllowReadWriteRequest struct {
+	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Addr                 string      `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *DataPlayBackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AllowReadWriteRequest) Reset()         { *m = AllowReadWriteRequest{} }
+func (m *AllowReadWriteRequest) String() string { return proto.CompactTextString(m) }
+func (*AllowReadWriteRequest) ProtoMessage()    {}
+func (*AllowReadWriteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{33}
+}
+func (m *AllowReadWriteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowReadWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowReadWriteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowReadWriteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowReadWriteRequest.Merge(m, src)
+}
+func (m *AllowReadWriteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowReadWriteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowReadWriteRequest.DiscardUnknown(m)
 }
 
-func (m *DataPlayBackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_AllowReadWriteRequest proto.InternalMessageInfo
+
+func (m *AllowReadWriteRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
 	}
-	return len(dAtA) - i, nil
+	return ServiceRole_UNKNOWN_ROLE
 }
 
-func (m *StopAgentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AllowReadWriteRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StopAgentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AllowReadWriteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopAgentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AllowReadWriteResponse) Reset()         { *m = AllowReadWriteResponse{} }
+func (m *AllowReadWriteResponse) String() string { return proto.CompactTextString(m) }
+func (*AllowReadWriteResponse) ProtoMessage()    {}
+func (*AllowReadWriteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{34}
+}
+func (m *AllowReadWriteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowReadWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowReadWriteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AllowReadWriteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowReadWriteResponse.Merge(m, src)
+}
+func (m *AllowReadWriteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowReadWriteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowReadWriteResponse.DiscardUnknown(m)
 }
 
-func (m *StopAgentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_AllowReadWriteResponse proto.InternalMessageInfo
+
+// the ban of writes or new connections, see BanReadWrite
+type ReadWriteBan struct {
+	Role ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Addr string      `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// unix time when banned
+	Since int64 `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	// whether the ban is applied to the service in the last check
+	Applied bool   `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	Error   string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// what the ban blocks, "writes" of storaged or "new_connections" of graphd,
+	// the reads of storaged and the existing sessions of graphd are not blocked
+	Blocked              string   `protobuf:"bytes,6,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopAgentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReadWriteBan) Reset()         { *m = ReadWriteBan{} }
+func (m *ReadWriteBan) String() string { return proto.CompactTextString(m) }
+func (*ReadWriteBan) ProtoMessage()    {}
+func (*ReadWriteBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{35}
+}
+func (m *ReadWriteBan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadWriteBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadWriteBan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadWriteBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadWriteBan.Merge(m, src)
+}
+func (m *ReadWriteBan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadWriteBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadWriteBan.DiscardUnknown(m)
 }
 
-func (m *StopAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ReadWriteBan proto.InternalMessageInfo
+
+func (m *ReadWriteBan) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
 	}
-	return len(dAtA) - i, nil
+	return ServiceRole_UNKNOWN_ROLE
 }
 
-func (m *HealthCheckRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReadWriteBan) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *HealthCheckRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReadWriteBan) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
 }
 
-func (m *HealthCheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ReadWriteBan) GetApplied() bool {
+	if m != nil {
+		return m.Applied
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *HealthCheckResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReadWriteBan) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *HealthCheckResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReadWriteBan) GetBlocked() string {
+	if m != nil {
+		return m.Blocked
+	}
+	return ""
 }
 
-func (m *HealthCheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type ReadWriteStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSpaceUsagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReadWriteStatusRequest) Reset()         { *m = ReadWriteStatusRequest{} }
+func (m *ReadWriteStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadWriteStatusRequest) ProtoMessage()    {}
+func (*ReadWriteStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{36}
+}
+func (m *ReadWriteStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadWriteStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadWriteStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ReadWriteStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadWriteStatusRequest.Merge(m, src)
+}
+func (m *ReadWriteStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadWriteStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadWriteStatusRequest.DiscardUnknown(m)
 }
 
-func (m *GetSpaceUsagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReadWriteStatusRequest proto.InternalMessageInfo
+
+type ReadWriteStatusResponse struct {
+	Bans                 []*ReadWriteBan `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetSpaceUsagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DataPath) > 0 {
-		i -= len(m.DataPath)
-		copy(dAtA[i:], m.DataPath)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.DataPath)))
-		i--
-		dAtA[i] = 0xa
+func (m *ReadWriteStatusResponse) Reset()         { *m = ReadWriteStatusResponse{} }
+func (m *ReadWriteStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadWriteStatusResponse) ProtoMessage()    {}
+func (*ReadWriteStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{37}
+}
+func (m *ReadWriteStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadWriteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadWriteStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ReadWriteStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadWriteStatusResponse.Merge(m, src)
+}
+func (m *ReadWriteStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadWriteStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadWriteStatusResponse.DiscardUnknown(m)
 }
 
-func (m *GetSpaceUsagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReadWriteStatusResponse proto.InternalMessageInfo
+
+func (m *ReadWriteStatusResponse) GetBans() []*ReadWriteBan {
+	if m != nil {
+		return m.Bans
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetSpaceUsagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DataPlayBackRequest struct {
+	// the root dir and data path of the only local storaged in meta if empty
+	Dir      string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	DataPath string `protobuf:"bytes,2,opt,name=data_path,json=dataPath,proto3" json:"data_path,omitempty"`
+	MetaAddr string `protobuf:"bytes,3,opt,name=meta_addr,json=metaAddr,proto3" json:"meta_addr,omitempty"`
+	// kill the playback if not finished in the seconds, the --playback_timeout of agent if 0
+	TimeoutSeconds int32 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// return the job id without waiting the playback finished
+	Async                bool     `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSpaceUsagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.SpaceUsages) > 0 {
-		for iNdEx := len(m.SpaceUsages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpaceUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DataPlayBackRequest) Reset()         { *m = DataPlayBackRequest{} }
+func (m *DataPlayBackRequest) String() string { return proto.CompactTextString(m) }
+func (*DataPlayBackRequest) ProtoMessage()    {}
+func (*DataPlayBackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{38}
+}
+func (m *DataPlayBackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataPlayBackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataPlayBackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DataPlayBackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataPlayBackRequest.Merge(m, src)
 }
-
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DataPlayBackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataPlayBackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataPlayBackRequest.DiscardUnknown(m)
 }
 
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Usage != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_DataPlayBackRequest proto.InternalMessageInfo
+
+func (m *DataPlayBackRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintAgent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAgent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DataPlayBackRequest) GetDataPath() string {
+	if m != nil {
+		return m.DataPath
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *StartServiceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Role != 0 {
-		n += 1 + sovAgent(uint64(m.Role))
-	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *DataPlayBackRequest) GetMetaAddr() string {
+	if m != nil {
+		return m.MetaAddr
 	}
-	return n
+	return ""
 }
 
-func (m *StartServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DataPlayBackRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
 	}
-	return n
+	return 0
 }
 
-func (m *StopServiceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Role != 0 {
-		n += 1 + sovAgent(uint64(m.Role))
-	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DataPlayBackRequest) GetAsync() bool {
+	if m != nil {
+		return m.Async
 	}
-	return n
+	return false
 }
 
-func (m *StopServiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// the playback runs as a job of kind data_playback, which could be queried and cancelled in JobService
+type DataPlayBackResponse struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	LogFile              string   `protobuf:"bytes,2,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServiceStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Role != 0 {
-		n += 1 + sovAgent(uint64(m.Role))
-	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *DataPlayBackResponse) Reset()         { *m = DataPlayBackResponse{} }
+func (m *DataPlayBackResponse) String() string { return proto.CompactTextString(m) }
+func (*DataPlayBackResponse) ProtoMessage()    {}
+func (*DataPlayBackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{39}
 }
-
-func (m *ServiceStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovAgent(uint64(m.Status))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *DataPlayBackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *BanReadWriteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Role != 0 {
-		n += 1 + sovAgent(uint64(m.Role))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DataPlayBackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataPlayBackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *BanReadWriteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *DataPlayBackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataPlayBackResponse.Merge(m, src)
 }
-
-func (m *AllowReadWriteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Role != 0 {
-		n += 1 + sovAgent(uint64(m.Role))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *DataPlayBackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataPlayBackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataPlayBackResponse.DiscardUnknown(m)
 }
 
-func (m *AllowReadWriteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_DataPlayBackResponse proto.InternalMessageInfo
+
+func (m *DataPlayBackResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return n
+	return ""
 }
 
-func (m *DataPlayBackRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.DataPath)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.MetaAddr)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DataPlayBackResponse) GetLogFile() string {
+	if m != nil {
+		return m.LogFile
 	}
-	return n
+	return ""
 }
 
-func (m *DataPlayBackResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type StopAgentRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopAgentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StopAgentRequest) Reset()         { *m = StopAgentRequest{} }
+func (m *StopAgentRequest) String() string { return proto.CompactTextString(m) }
+func (*StopAgentRequest) ProtoMessage()    {}
+func (*StopAgentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{40}
+}
+func (m *StopAgentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopAgentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopAgentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *StopAgentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopAgentRequest.Merge(m, src)
+}
+func (m *StopAgentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopAgentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopAgentRequest.DiscardUnknown(m)
 }
 
-func (m *StopAgentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var xxx_messageInfo_StopAgentRequest proto.InternalMessageInfo
+
+type StopAgentResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HealthCheckRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StopAgentResponse) Reset()         { *m = StopAgentResponse{} }
+func (m *StopAgentResponse) String() string { return proto.CompactTextString(m) }
+func (*StopAgentResponse) ProtoMessage()    {}
+func (*StopAgentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{41}
+}
+func (m *StopAgentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopAgentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopAgentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *StopAgentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopAgentResponse.Merge(m, src)
+}
+func (m *StopAgentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopAgentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopAgentResponse.DiscardUnknown(m)
 }
 
-func (m *HealthCheckResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var xxx_messageInfo_StopAgentResponse proto.InternalMessageInfo
+
+type HealthCheckRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSpaceUsagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HealthCheckRequest) Reset()         { *m = HealthCheckRequest{} }
+func (m *HealthCheckRequest) String() string { return proto.CompactTextString(m) }
+func (*HealthCheckRequest) ProtoMessage()    {}
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{42}
+}
+func (m *HealthCheckRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthCheckRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.DataPath)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+}
+func (m *HealthCheckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheckRequest.Merge(m, src)
+}
+func (m *HealthCheckRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthCheckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheckRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheckRequest proto.InternalMessageInfo
+
+type HealthCheckResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthCheckResponse) Reset()         { *m = HealthCheckResponse{} }
+func (m *HealthCheckResponse) String() string { return proto.CompactTextString(m) }
+func (*HealthCheckResponse) ProtoMessage()    {}
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{43}
+}
+func (m *HealthCheckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthCheckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *HealthCheckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheckResponse.Merge(m, src)
+}
+func (m *HealthCheckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthCheckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheckResponse proto.InternalMessageInfo
+
+func (m *HealthCheckResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return n
+	return ""
 }
 
-func (m *GetSpaceUsagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type GetSpaceUsagesRequest struct {
+	// the dir containing the space dirs, such as {storaged data_path}/nebula,
+	// the ones of the local storaged in meta are used if both data_path and data_paths are empty
+	DataPath string `protobuf:"bytes,1,opt,name=data_path,json=dataPath,proto3" json:"data_path,omitempty"`
+	// more data paths of storaged, the usages of the same space are summed, the duplicated ones are scanned once.
+	// The paths should be in the data paths of the local storaged in meta or in the allowed roots
+	DataPaths []string `protobuf:"bytes,2,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	// the cached usages scanned within the seconds are returned, always rescan if 0 or negative
+	MaxAgeSeconds        int32    `protobuf:"varint,3,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSpaceUsagesRequest) Reset()         { *m = GetSpaceUsagesRequest{} }
+func (m *GetSpaceUsagesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSpaceUsagesRequest) ProtoMessage()    {}
+func (*GetSpaceUsagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{44}
+}
+func (m *GetSpaceUsagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSpaceUsagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSpaceUsagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.SpaceUsages) > 0 {
-		for _, e := range m.SpaceUsages {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+}
+func (m *GetSpaceUsagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSpaceUsagesRequest.Merge(m, src)
+}
+func (m *GetSpaceUsagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSpaceUsagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSpaceUsagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSpaceUsagesRequest proto.InternalMessageInfo
+
+func (m *GetSpaceUsagesRequest) GetDataPath() string {
+	if m != nil {
+		return m.DataPath
+	}
+	return ""
+}
+
+func (m *GetSpaceUsagesRequest) GetDataPaths() []string {
+	if m != nil {
+		return m.DataPaths
+	}
+	return nil
+}
+
+func (m *GetSpaceUsagesRequest) GetMaxAgeSeconds() int32 {
+	if m != nil {
+		return m.MaxAgeSeconds
+	}
+	return 0
+}
+
+type GetSpaceUsagesResponse struct {
+	SpaceUsages []*GetSpaceUsagesResponse_SpaceUsageItem `protobuf:"bytes,1,rep,name=SpaceUsages,proto3" json:"SpaceUsages,omitempty"`
+	// the unix time of the oldest scan of the data paths
+	ScanTime             int64    `protobuf:"varint,2,opt,name=scan_time,json=scanTime,proto3" json:"scan_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSpaceUsagesResponse) Reset()         { *m = GetSpaceUsagesResponse{} }
+func (m *GetSpaceUsagesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSpaceUsagesResponse) ProtoMessage()    {}
+func (*GetSpaceUsagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{45}
+}
+func (m *GetSpaceUsagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSpaceUsagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSpaceUsagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *GetSpaceUsagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSpaceUsagesResponse.Merge(m, src)
+}
+func (m *GetSpaceUsagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSpaceUsagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSpaceUsagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSpaceUsagesResponse proto.InternalMessageInfo
+
+func (m *GetSpaceUsagesResponse) GetSpaceUsages() []*GetSpaceUsagesResponse_SpaceUsageItem {
+	if m != nil {
+		return m.SpaceUsages
 	}
-	return n
+	return nil
 }
 
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetSpaceUsagesResponse) GetScanTime() int64 {
+	if m != nil {
+		return m.ScanTime
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovAgent(uint64(m.Id))
+	return 0
+}
+
+// only the wal is per partition, the data and checkpoints are shared by the partitions of the space
+type GetSpaceUsagesResponse_PartUsageItem struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WalBytes             int64    `protobuf:"varint,2,opt,name=wal_bytes,json=walBytes,proto3" json:"wal_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSpaceUsagesResponse_PartUsageItem) Reset()         { *m = GetSpaceUsagesResponse_PartUsageItem{} }
+func (m *GetSpaceUsagesResponse_PartUsageItem) String() string { return proto.CompactTextString(m) }
+func (*GetSpaceUsagesResponse_PartUsageItem) ProtoMessage()    {}
+func (*GetSpaceUsagesResponse_PartUsageItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{45, 0}
+}
+func (m *GetSpaceUsagesResponse_PartUsageItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSpaceUsagesResponse_PartUsageItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSpaceUsagesResponse_PartUsageItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Usage != 0 {
-		n += 1 + sovAgent(uint64(m.Usage))
+}
+func (m *GetSpaceUsagesResponse_PartUsageItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSpaceUsagesResponse_PartUsageItem.Merge(m, src)
+}
+func (m *GetSpaceUsagesResponse_PartUsageItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSpaceUsagesResponse_PartUsageItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSpaceUsagesResponse_PartUsageItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSpaceUsagesResponse_PartUsageItem proto.InternalMessageInfo
+
+func (m *GetSpaceUsagesResponse_PartUsageItem) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *GetSpaceUsagesResponse_PartUsageItem) GetWalBytes() int64 {
+	if m != nil {
+		return m.WalBytes
 	}
-	return n
+	return 0
 }
 
-func sovAgent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type GetSpaceUsagesResponse_SpaceUsageItem struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the total bytes of all components
+	Usage int64 `protobuf:"varint,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	// the sst and other files of rocksdb, which is shared by the partitions
+	DataBytes int64 `protobuf:"varint,3,opt,name=data_bytes,json=dataBytes,proto3" json:"data_bytes,omitempty"`
+	WalBytes  int64 `protobuf:"varint,4,opt,name=wal_bytes,json=walBytes,proto3" json:"wal_bytes,omitempty"`
+	// the files only in checkpoints, the ones hard linked to data or wal are not counted,
+	// only per space since a checkpoint contains the data of all partitions
+	CheckpointBytes int64 `protobuf:"varint,5,opt,name=checkpoint_bytes,json=checkpointBytes,proto3" json:"checkpoint_bytes,omitempty"`
+	OtherBytes      int64 `protobuf:"varint,6,opt,name=other_bytes,json=otherBytes,proto3" json:"other_bytes,omitempty"`
+	// the wal bytes of each partition
+	Parts                []*GetSpaceUsagesResponse_PartUsageItem `protobuf:"bytes,7,rep,name=parts,proto3" json:"parts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
 }
-func sozAgent(x uint64) (n int) {
-	return sovAgent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) Reset()         { *m = GetSpaceUsagesResponse_SpaceUsageItem{} }
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) String() string { return proto.CompactTextString(m) }
+func (*GetSpaceUsagesResponse_SpaceUsageItem) ProtoMessage()    {}
+func (*GetSpaceUsagesResponse_SpaceUsageItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{45, 1}
+}
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSpaceUsagesResponse_SpaceUsageItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSpaceUsagesResponse_SpaceUsageItem.Merge(m, src)
+}
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSpaceUsagesResponse_SpaceUsageItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSpaceUsagesResponse_SpaceUsageItem proto.InternalMessageInfo
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) GetUsage() int64 {
+	if m != nil {
+		return m.Usage
+	}
+	return 0
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) GetDataBytes() int64 {
+	if m != nil {
+		return m.DataBytes
+	}
+	return 0
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) GetWalBytes() int64 {
+	if m != nil {
+		return m.WalBytes
+	}
+	return 0
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) GetCheckpointBytes() int64 {
+	if m != nil {
+		return m.CheckpointBytes
+	}
+	return 0
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) GetOtherBytes() int64 {
+	if m != nil {
+		return m.OtherBytes
+	}
+	return 0
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) GetParts() []*GetSpaceUsagesResponse_PartUsageItem {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+type UpgradeServiceRequest struct {
+	Role ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	// the instance to restart, the binary is shared by the instances of the role in the dir,
+	// so the upgrade is refused if there are several instances of the role in the dir
+	Instance string `protobuf:"bytes,3,opt,name=instance,proto3" json:"instance,omitempty"`
+	// the release package in tar.gz or the binary itself
+	Source *Backend `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// sha256 checksum of the package in hex
+	Sha256 string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// the name to keep the binary, the package name without ext if empty, the kept one is reused
+	// if staged from the package with the same sha256, e.g. retrying a failed upgrade, refused otherwise
+	Version string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// wait the service to be healthy after started, 120 if 0
+	ReadyTimeoutSeconds int32 `protobuf:"varint,7,opt,name=ready_timeout_seconds,json=readyTimeoutSeconds,proto3" json:"ready_timeout_seconds,omitempty"`
+	// the binary versions to keep for rollback, 3 if 0
+	KeepVersions         int32    `protobuf:"varint,8,opt,name=keep_versions,json=keepVersions,proto3" json:"keep_versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpgradeServiceRequest) Reset()         { *m = UpgradeServiceRequest{} }
+func (m *UpgradeServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*UpgradeServiceRequest) ProtoMessage()    {}
+func (*UpgradeServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{46}
+}
+func (m *UpgradeServiceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeServiceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeServiceRequest.Merge(m, src)
+}
+func (m *UpgradeServiceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeServiceRequest proto.InternalMessageInfo
+
+func (m *UpgradeServiceRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *UpgradeServiceRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *UpgradeServiceRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
+func (m *UpgradeServiceRequest) GetSource() *Backend {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *UpgradeServiceRequest) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+func (m *UpgradeServiceRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *UpgradeServiceRequest) GetReadyTimeoutSeconds() int32 {
+	if m != nil {
+		return m.ReadyTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *UpgradeServiceRequest) GetKeepVersions() int32 {
+	if m != nil {
+		return m.KeepVersions
+	}
+	return 0
+}
+
+type UpgradeServiceResponse struct {
+	PreviousVersion string `protobuf:"bytes,1,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	// the kept versions, the latest installed one is the last
+	Versions             []string `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpgradeServiceResponse) Reset()         { *m = UpgradeServiceResponse{} }
+func (m *UpgradeServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*UpgradeServiceResponse) ProtoMessage()    {}
+func (*UpgradeServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{47}
+}
+func (m *UpgradeServiceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeServiceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeServiceResponse.Merge(m, src)
+}
+func (m *UpgradeServiceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeServiceResponse proto.InternalMessageInfo
+
+func (m *UpgradeServiceResponse) GetPreviousVersion() string {
+	if m != nil {
+		return m.PreviousVersion
+	}
+	return ""
+}
+
+func (m *UpgradeServiceResponse) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// switch to a kept binary version and restart the service
+type RollbackServiceBinaryRequest struct {
+	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir                  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	Instance             string      `protobuf:"bytes,3,opt,name=instance,proto3" json:"instance,omitempty"`
+	Version              string      `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	ReadyTimeoutSeconds  int32       `protobuf:"varint,5,opt,name=ready_timeout_seconds,json=readyTimeoutSeconds,proto3" json:"ready_timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RollbackServiceBinaryRequest) Reset()         { *m = RollbackServiceBinaryRequest{} }
+func (m *RollbackServiceBinaryRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackServiceBinaryRequest) ProtoMessage()    {}
+func (*RollbackServiceBinaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{48}
+}
+func (m *RollbackServiceBinaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackServiceBinaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackServiceBinaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackServiceBinaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackServiceBinaryRequest.Merge(m, src)
+}
+func (m *RollbackServiceBinaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackServiceBinaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackServiceBinaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackServiceBinaryRequest proto.InternalMessageInfo
+
+func (m *RollbackServiceBinaryRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *RollbackServiceBinaryRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *RollbackServiceBinaryRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
+func (m *RollbackServiceBinaryRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *RollbackServiceBinaryRequest) GetReadyTimeoutSeconds() int32 {
+	if m != nil {
+		return m.ReadyTimeoutSeconds
+	}
+	return 0
+}
+
+type RollbackServiceBinaryResponse struct {
+	PreviousVersion      string   `protobuf:"bytes,1,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackServiceBinaryResponse) Reset()         { *m = RollbackServiceBinaryResponse{} }
+func (m *RollbackServiceBinaryResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackServiceBinaryResponse) ProtoMessage()    {}
+func (*RollbackServiceBinaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{49}
+}
+func (m *RollbackServiceBinaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackServiceBinaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackServiceBinaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackServiceBinaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackServiceBinaryResponse.Merge(m, src)
+}
+func (m *RollbackServiceBinaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackServiceBinaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackServiceBinaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackServiceBinaryResponse proto.InternalMessageInfo
+
+func (m *RollbackServiceBinaryResponse) GetPreviousVersion() string {
+	if m != nil {
+		return m.PreviousVersion
+	}
+	return ""
+}
+
+type ListServiceVersionsRequest struct {
+	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir                  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListServiceVersionsRequest) Reset()         { *m = ListServiceVersionsRequest{} }
+func (m *ListServiceVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListServiceVersionsRequest) ProtoMessage()    {}
+func (*ListServiceVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{50}
+}
+func (m *ListServiceVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListServiceVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListServiceVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListServiceVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceVersionsRequest.Merge(m, src)
+}
+func (m *ListServiceVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListServiceVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServiceVersionsRequest proto.InternalMessageInfo
+
+func (m *ListServiceVersionsRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *ListServiceVersionsRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+type ListServiceVersionsResponse struct {
+	// empty if never installed by agent
+	Current              string   `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	Versions             []string `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListServiceVersionsResponse) Reset()         { *m = ListServiceVersionsResponse{} }
+func (m *ListServiceVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListServiceVersionsResponse) ProtoMessage()    {}
+func (*ListServiceVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{51}
+}
+func (m *ListServiceVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListServiceVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListServiceVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListServiceVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceVersionsResponse.Merge(m, src)
+}
+func (m *ListServiceVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListServiceVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServiceVersionsResponse proto.InternalMessageInfo
+
+func (m *ListServiceVersionsResponse) GetCurrent() string {
+	if m != nil {
+		return m.Current
+	}
+	return ""
+}
+
+func (m *ListServiceVersionsResponse) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type GetServiceDirsRequest struct {
+	// all the local services if UNKNOWN_ROLE or ALL
+	Role                 ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetServiceDirsRequest) Reset()         { *m = GetServiceDirsRequest{} }
+func (m *GetServiceDirsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetServiceDirsRequest) ProtoMessage()    {}
+func (*GetServiceDirsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{52}
+}
+func (m *GetServiceDirsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetServiceDirsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetServiceDirsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetServiceDirsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServiceDirsRequest.Merge(m, src)
+}
+func (m *GetServiceDirsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetServiceDirsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServiceDirsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServiceDirsRequest proto.InternalMessageInfo
+
+func (m *GetServiceDirsRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+type ServiceDirs struct {
+	Role    ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Addr    string      `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	RootDir string      `protobuf:"bytes,3,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
+	// the --data_path of metad and storaged
+	DataDirs []string `protobuf:"bytes,4,rep,name=data_dirs,json=dataDirs,proto3" json:"data_dirs,omitempty"`
+	// the dirs containing the space dirs, i.e. {data_dir}/nebula
+	SpaceDirs []string `protobuf:"bytes,5,rep,name=space_dirs,json=spaceDirs,proto3" json:"space_dirs,omitempty"`
+	// the config file of the instance in agent machine, empty if not found
+	Conf                 string   `protobuf:"bytes,6,opt,name=conf,proto3" json:"conf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceDirs) Reset()         { *m = ServiceDirs{} }
+func (m *ServiceDirs) String() string { return proto.CompactTextString(m) }
+func (*ServiceDirs) ProtoMessage()    {}
+func (*ServiceDirs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{53}
+}
+func (m *ServiceDirs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceDirs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceDirs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceDirs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDirs.Merge(m, src)
+}
+func (m *ServiceDirs) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceDirs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDirs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDirs proto.InternalMessageInfo
+
+func (m *ServiceDirs) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *ServiceDirs) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ServiceDirs) GetRootDir() string {
+	if m != nil {
+		return m.RootDir
+	}
+	return ""
+}
+
+func (m *ServiceDirs) GetDataDirs() []string {
+	if m != nil {
+		return m.DataDirs
+	}
+	return nil
+}
+
+func (m *ServiceDirs) GetSpaceDirs() []string {
+	if m != nil {
+		return m.SpaceDirs
+	}
+	return nil
+}
+
+func (m *ServiceDirs) GetConf() string {
+	if m != nil {
+		return m.Conf
+	}
+	return ""
+}
+
+// the dirs of the local services reported by meta heartbeat
+type GetServiceDirsResponse struct {
+	Services             []*ServiceDirs `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetServiceDirsResponse) Reset()         { *m = GetServiceDirsResponse{} }
+func (m *GetServiceDirsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetServiceDirsResponse) ProtoMessage()    {}
+func (*GetServiceDirsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{54}
+}
+func (m *GetServiceDirsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetServiceDirsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetServiceDirsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetServiceDirsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServiceDirsResponse.Merge(m, src)
+}
+func (m *GetServiceDirsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetServiceDirsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServiceDirsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServiceDirsResponse proto.InternalMessageInfo
+
+func (m *GetServiceDirsResponse) GetServices() []*ServiceDirs {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type CheckpointInfo struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SpaceId int64  `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// the dir containing the space dirs, i.e. {data_path}/nebula
+	DataPath string `protobuf:"bytes,3,opt,name=data_path,json=dataPath,proto3" json:"data_path,omitempty"`
+	// the checkpoint dirs of the space and its partitions
+	Paths      []string `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+	TotalBytes int64    `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// the bytes only in the checkpoint, which are freed when dropped
+	ExclusiveBytes int64 `protobuf:"varint,6,opt,name=exclusive_bytes,json=exclusiveBytes,proto3" json:"exclusive_bytes,omitempty"`
+	// the unix time of the last modification of the checkpoint dirs
+	CreateTime           int64    `protobuf:"varint,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointInfo) Reset()         { *m = CheckpointInfo{} }
+func (m *CheckpointInfo) String() string { return proto.CompactTextString(m) }
+func (*CheckpointInfo) ProtoMessage()    {}
+func (*CheckpointInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{55}
+}
+func (m *CheckpointInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointInfo.Merge(m, src)
+}
+func (m *CheckpointInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointInfo proto.InternalMessageInfo
+
+func (m *CheckpointInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CheckpointInfo) GetSpaceId() int64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *CheckpointInfo) GetDataPath() string {
+	if m != nil {
+		return m.DataPath
+	}
+	return ""
+}
+
+func (m *CheckpointInfo) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *CheckpointInfo) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *CheckpointInfo) GetExclusiveBytes() int64 {
+	if m != nil {
+		return m.ExclusiveBytes
+	}
+	return 0
+}
+
+func (m *CheckpointInfo) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type ListCheckpointsRequest struct {
+	// the dirs containing the space dirs, the ones of the local storaged in meta if empty,
+	// which should be in the data paths of the local storaged or in the allowed roots
+	DataPaths []string `protobuf:"bytes,1,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	// all spaces if 0
+	SpaceId              int64    `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCheckpointsRequest) Reset()         { *m = ListCheckpointsRequest{} }
+func (m *ListCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCheckpointsRequest) ProtoMessage()    {}
+func (*ListCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{56}
+}
+func (m *ListCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCheckpointsRequest.Merge(m, src)
+}
+func (m *ListCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCheckpointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCheckpointsRequest proto.InternalMessageInfo
+
+func (m *ListCheckpointsRequest) GetDataPaths() []string {
+	if m != nil {
+		return m.DataPaths
+	}
+	return nil
+}
+
+func (m *ListCheckpointsRequest) GetSpaceId() int64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+type ListCheckpointsResponse struct {
+	Checkpoints          []*CheckpointInfo `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListCheckpointsResponse) Reset()         { *m = ListCheckpointsResponse{} }
+func (m *ListCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCheckpointsResponse) ProtoMessage()    {}
+func (*ListCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{57}
+}
+func (m *ListCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCheckpointsResponse.Merge(m, src)
+}
+func (m *ListCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCheckpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCheckpointsResponse proto.InternalMessageInfo
+
+func (m *ListCheckpointsResponse) GetCheckpoints() []*CheckpointInfo {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+// drop the checkpoint of the name in all the local storaged data paths in meta
+type DropCheckpointRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// all spaces if 0
+	SpaceId              int64    `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropCheckpointRequest) Reset()         { *m = DropCheckpointRequest{} }
+func (m *DropCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*DropCheckpointRequest) ProtoMessage()    {}
+func (*DropCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{58}
+}
+func (m *DropCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DropCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DropCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DropCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropCheckpointRequest.Merge(m, src)
+}
+func (m *DropCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DropCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropCheckpointRequest proto.InternalMessageInfo
+
+func (m *DropCheckpointRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DropCheckpointRequest) GetSpaceId() int64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+type DropCheckpointResponse struct {
+	Dropped              []*CheckpointInfo `protobuf:"bytes,1,rep,name=dropped,proto3" json:"dropped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropCheckpointResponse) Reset()         { *m = DropCheckpointResponse{} }
+func (m *DropCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*DropCheckpointResponse) ProtoMessage()    {}
+func (*DropCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{59}
+}
+func (m *DropCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DropCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DropCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DropCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropCheckpointResponse.Merge(m, src)
+}
+func (m *DropCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DropCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropCheckpointResponse proto.InternalMessageInfo
+
+func (m *DropCheckpointResponse) GetDropped() []*CheckpointInfo {
+	if m != nil {
+		return m.Dropped
+	}
+	return nil
+}
+
+type CollectDiagnosticsRequest struct {
+	Role ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	Dir  string      `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	// the rpc port or the config file in {dir}/etc
+	Instance string `protobuf:"bytes,3,opt,name=instance,proto3" json:"instance,omitempty"`
+	// the log lines in [start_time, end_time] in unix seconds are collected, the last hour if 0
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// upload the bundle into the dir if set, the bundle is kept in {dir}/diagnostics of agent machine otherwise,
+	// where only the last 5 bundles are kept
+	Target *Backend `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	// the max bytes of files in the bundle before compression, 256MiB if 0
+	MaxBytes             int64    `protobuf:"varint,7,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectDiagnosticsRequest) Reset()         { *m = CollectDiagnosticsRequest{} }
+func (m *CollectDiagnosticsRequest) String() string { return proto.CompactTextString(m) }
+func (*CollectDiagnosticsRequest) ProtoMessage()    {}
+func (*CollectDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{60}
+}
+func (m *CollectDiagnosticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectDiagnosticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectDiagnosticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectDiagnosticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectDiagnosticsRequest.Merge(m, src)
+}
+func (m *CollectDiagnosticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectDiagnosticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectDiagnosticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectDiagnosticsRequest proto.InternalMessageInfo
+
+func (m *CollectDiagnosticsRequest) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *CollectDiagnosticsRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *CollectDiagnosticsRequest) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
+func (m *CollectDiagnosticsRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *CollectDiagnosticsRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *CollectDiagnosticsRequest) GetTarget() *Backend {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *CollectDiagnosticsRequest) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+type CollectDiagnosticsResponse struct {
+	// the bundle in agent machine, removed after uploaded
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// the uri of the uploaded bundle
+	Uri         string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	BundleBytes int64  `protobuf:"varint,3,opt,name=bundle_bytes,json=bundleBytes,proto3" json:"bundle_bytes,omitempty"`
+	// the files in the bundle
+	Files []string `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	// the files not collected with the reason, such as exceeding the size cap
+	Skipped              []string `protobuf:"bytes,5,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectDiagnosticsResponse) Reset()         { *m = CollectDiagnosticsResponse{} }
+func (m *CollectDiagnosticsResponse) String() string { return proto.CompactTextString(m) }
+func (*CollectDiagnosticsResponse) ProtoMessage()    {}
+func (*CollectDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{61}
+}
+func (m *CollectDiagnosticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectDiagnosticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectDiagnosticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectDiagnosticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectDiagnosticsResponse.Merge(m, src)
+}
+func (m *CollectDiagnosticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectDiagnosticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectDiagnosticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectDiagnosticsResponse proto.InternalMessageInfo
+
+func (m *CollectDiagnosticsResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CollectDiagnosticsResponse) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *CollectDiagnosticsResponse) GetBundleBytes() int64 {
+	if m != nil {
+		return m.BundleBytes
+	}
+	return 0
+}
+
+func (m *CollectDiagnosticsResponse) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *CollectDiagnosticsResponse) GetSkipped() []string {
+	if m != nil {
+		return m.Skipped
+	}
+	return nil
+}
+
+type LogRetentionPolicy struct {
+	// remove the log files not modified in the seconds, no limit if 0
+	MaxAgeSeconds int64 `protobuf:"varint,1,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	// remove the oldest files when the log files of the service exceed the bytes, no limit if 0
+	MaxTotalBytes int64 `protobuf:"varint,2,opt,name=max_total_bytes,json=maxTotalBytes,proto3" json:"max_total_bytes,omitempty"`
+	// remove the oldest files when the log files of the service exceed the count, no limit if 0
+	MaxFiles int32 `protobuf:"varint,3,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	// gzip the files not modified in the seconds, never if 0
+	CompressAfterSeconds int64 `protobuf:"varint,4,opt,name=compress_after_seconds,json=compressAfterSeconds,proto3" json:"compress_after_seconds,omitempty"`
+	// upload the files into the dir before removing if set, the files failed to upload are kept
+	Archive              *Backend `protobuf:"bytes,5,opt,name=archive,proto3" json:"archive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogRetentionPolicy) Reset()         { *m = LogRetentionPolicy{} }
+func (m *LogRetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*LogRetentionPolicy) ProtoMessage()    {}
+func (*LogRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{62}
+}
+func (m *LogRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogRetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogRetentionPolicy.Merge(m, src)
+}
+func (m *LogRetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogRetentionPolicy proto.InternalMessageInfo
+
+func (m *LogRetentionPolicy) GetMaxAgeSeconds() int64 {
+	if m != nil {
+		return m.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (m *LogRetentionPolicy) GetMaxTotalBytes() int64 {
+	if m != nil {
+		return m.MaxTotalBytes
+	}
+	return 0
+}
+
+func (m *LogRetentionPolicy) GetMaxFiles() int32 {
+	if m != nil {
+		return m.MaxFiles
+	}
+	return 0
+}
+
+func (m *LogRetentionPolicy) GetCompressAfterSeconds() int64 {
+	if m != nil {
+		return m.CompressAfterSeconds
+	}
+	return 0
+}
+
+func (m *LogRetentionPolicy) GetArchive() *Backend {
+	if m != nil {
+		return m.Archive
+	}
+	return nil
+}
+
+// the log retention of local services, which is loaded from the json file of --log_retention
+type LogRetentionConfig struct {
+	// the interval between cleanups, 3600 if 0
+	IntervalSeconds int32 `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// used when the role has no policy
+	Default *LogRetentionPolicy `protobuf:"bytes,2,opt,name=default,proto3" json:"default,omitempty"`
+	// the policies by role name, i.e. metad/storaged/graphd
+	Roles                map[string]*LogRetentionPolicy `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *LogRetentionConfig) Reset()         { *m = LogRetentionConfig{} }
+func (m *LogRetentionConfig) String() string { return proto.CompactTextString(m) }
+func (*LogRetentionConfig) ProtoMessage()    {}
+func (*LogRetentionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{63}
+}
+func (m *LogRetentionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogRetentionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogRetentionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogRetentionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogRetentionConfig.Merge(m, src)
+}
+func (m *LogRetentionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogRetentionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogRetentionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogRetentionConfig proto.InternalMessageInfo
+
+func (m *LogRetentionConfig) GetIntervalSeconds() int32 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *LogRetentionConfig) GetDefault() *LogRetentionPolicy {
+	if m != nil {
+		return m.Default
+	}
+	return nil
+}
+
+func (m *LogRetentionConfig) GetRoles() map[string]*LogRetentionPolicy {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type LogFileAction struct {
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// compressed, archived or removed
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Bytes  int64  `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// the policy field causing the action, such as max_age_seconds
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the uri of the archived file
+	Uri                  string   `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogFileAction) Reset()         { *m = LogFileAction{} }
+func (m *LogFileAction) String() string { return proto.CompactTextString(m) }
+func (*LogFileAction) ProtoMessage()    {}
+func (*LogFileAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{64}
+}
+func (m *LogFileAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogFileAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogFileAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogFileAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogFileAction.Merge(m, src)
+}
+func (m *LogFileAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogFileAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogFileAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogFileAction proto.InternalMessageInfo
+
+func (m *LogFileAction) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *LogFileAction) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *LogFileAction) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *LogFileAction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *LogFileAction) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *LogFileAction) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// the log files of a service cleaned in one run
+type LogCleanReport struct {
+	Role   ServiceRole `protobuf:"varint,1,opt,name=role,proto3,enum=proto.ServiceRole" json:"role,omitempty"`
+	LogDir string      `protobuf:"bytes,2,opt,name=log_dir,json=logDir,proto3" json:"log_dir,omitempty"`
+	// unix time of the run
+	Time       int64            `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	DryRun     bool             `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Actions    []*LogFileAction `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	FreedBytes int64            `protobuf:"varint,6,opt,name=freed_bytes,json=freedBytes,proto3" json:"freed_bytes,omitempty"`
+	// the log files left, including the ones being written
+	RemainingBytes       int64    `protobuf:"varint,7,opt,name=remaining_bytes,json=remainingBytes,proto3" json:"remaining_bytes,omitempty"`
+	RemainingFiles       int32    `protobuf:"varint,8,opt,name=remaining_files,json=remainingFiles,proto3" json:"remaining_files,omitempty"`
+	Error                string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogCleanReport) Reset()         { *m = LogCleanReport{} }
+func (m *LogCleanReport) String() string { return proto.CompactTextString(m) }
+func (*LogCleanReport) ProtoMessage()    {}
+func (*LogCleanReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{65}
+}
+func (m *LogCleanReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogCleanReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogCleanReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogCleanReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogCleanReport.Merge(m, src)
+}
+func (m *LogCleanReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogCleanReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogCleanReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogCleanReport proto.InternalMessageInfo
+
+func (m *LogCleanReport) GetRole() ServiceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ServiceRole_UNKNOWN_ROLE
+}
+
+func (m *LogCleanReport) GetLogDir() string {
+	if m != nil {
+		return m.LogDir
+	}
+	return ""
+}
+
+func (m *LogCleanReport) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *LogCleanReport) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *LogCleanReport) GetActions() []*LogFileAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *LogCleanReport) GetFreedBytes() int64 {
+	if m != nil {
+		return m.FreedBytes
+	}
+	return 0
+}
+
+func (m *LogCleanReport) GetRemainingBytes() int64 {
+	if m != nil {
+		return m.RemainingBytes
+	}
+	return 0
+}
+
+func (m *LogCleanReport) GetRemainingFiles() int32 {
+	if m != nil {
+		return m.RemainingFiles
+	}
+	return 0
+}
+
+func (m *LogCleanReport) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CleanLogsRequest struct {
+	// report the actions without changing any file
+	DryRun               bool     `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CleanLogsRequest) Reset()         { *m = CleanLogsRequest{} }
+func (m *CleanLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*CleanLogsRequest) ProtoMessage()    {}
+func (*CleanLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{66}
+}
+func (m *CleanLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CleanLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanLogsRequest.Merge(m, src)
+}
+func (m *CleanLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanLogsRequest proto.InternalMessageInfo
+
+func (m *CleanLogsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type CleanLogsResponse struct {
+	Reports              []*LogCleanReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CleanLogsResponse) Reset()         { *m = CleanLogsResponse{} }
+func (m *CleanLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*CleanLogsResponse) ProtoMessage()    {}
+func (*CleanLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{67}
+}
+func (m *CleanLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CleanLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanLogsResponse.Merge(m, src)
+}
+func (m *CleanLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanLogsResponse proto.InternalMessageInfo
+
+func (m *CleanLogsResponse) GetReports() []*LogCleanReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+type LogJanitorStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogJanitorStatusRequest) Reset()         { *m = LogJanitorStatusRequest{} }
+func (m *LogJanitorStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*LogJanitorStatusRequest) ProtoMessage()    {}
+func (*LogJanitorStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{68}
+}
+func (m *LogJanitorStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogJanitorStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogJanitorStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogJanitorStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogJanitorStatusRequest.Merge(m, src)
+}
+func (m *LogJanitorStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogJanitorStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogJanitorStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogJanitorStatusRequest proto.InternalMessageInfo
+
+type LogJanitorStatusResponse struct {
+	Enabled bool                `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Config  *LogRetentionConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// the reports of the last run
+	Reports              []*LogCleanReport `protobuf:"bytes,3,rep,name=reports,proto3" json:"reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LogJanitorStatusResponse) Reset()         { *m = LogJanitorStatusResponse{} }
+func (m *LogJanitorStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*LogJanitorStatusResponse) ProtoMessage()    {}
+func (*LogJanitorStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{69}
+}
+func (m *LogJanitorStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogJanitorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogJanitorStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogJanitorStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogJanitorStatusResponse.Merge(m, src)
+}
+func (m *LogJanitorStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogJanitorStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogJanitorStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogJanitorStatusResponse proto.InternalMessageInfo
+
+func (m *LogJanitorStatusResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *LogJanitorStatusResponse) GetConfig() *LogRetentionConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *LogJanitorStatusResponse) GetReports() []*LogCleanReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+type GetHostInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetHostInfoRequest) Reset()         { *m = GetHostInfoRequest{} }
+func (m *GetHostInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHostInfoRequest) ProtoMessage()    {}
+func (*GetHostInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{70}
+}
+func (m *GetHostInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHostInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHostInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHostInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHostInfoRequest.Merge(m, src)
+}
+func (m *GetHostInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHostInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHostInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHostInfoRequest proto.InternalMessageInfo
+
+type MountUsage struct {
+	MountPoint string `protobuf:"bytes,1,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	Device     string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	FsType     string `protobuf:"bytes,3,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	// the root and data dirs of local services on the mount
+	Dirs       []string `protobuf:"bytes,4,rep,name=dirs,proto3" json:"dirs,omitempty"`
+	TotalBytes uint64   `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// the space available to unprivileged users
+	FreeBytes            uint64   `protobuf:"varint,6,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	TotalInodes          uint64   `protobuf:"varint,7,opt,name=total_inodes,json=totalInodes,proto3" json:"total_inodes,omitempty"`
+	FreeInodes           uint64   `protobuf:"varint,8,opt,name=free_inodes,json=freeInodes,proto3" json:"free_inodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MountUsage) Reset()         { *m = MountUsage{} }
+func (m *MountUsage) String() string { return proto.CompactTextString(m) }
+func (*MountUsage) ProtoMessage()    {}
+func (*MountUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{71}
+}
+func (m *MountUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MountUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MountUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MountUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MountUsage.Merge(m, src)
+}
+func (m *MountUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MountUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MountUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MountUsage proto.InternalMessageInfo
+
+func (m *MountUsage) GetMountPoint() string {
+	if m != nil {
+		return m.MountPoint
+	}
+	return ""
+}
+
+func (m *MountUsage) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *MountUsage) GetFsType() string {
+	if m != nil {
+		return m.FsType
+	}
+	return ""
+}
+
+func (m *MountUsage) GetDirs() []string {
+	if m != nil {
+		return m.Dirs
+	}
+	return nil
+}
+
+func (m *MountUsage) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *MountUsage) GetFreeBytes() uint64 {
+	if m != nil {
+		return m.FreeBytes
+	}
+	return 0
+}
+
+func (m *MountUsage) GetTotalInodes() uint64 {
+	if m != nil {
+		return m.TotalInodes
+	}
+	return 0
+}
+
+func (m *MountUsage) GetFreeInodes() uint64 {
+	if m != nil {
+		return m.FreeInodes
+	}
+	return 0
+}
+
+type GetHostInfoResponse struct {
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	CpuCount int32  `protobuf:"varint,2,opt,name=cpu_count,json=cpuCount,proto3" json:"cpu_count,omitempty"`
+	// the 1, 5 and 15 minutes load average
+	LoadAvg           []float64     `protobuf:"fixed64,3,rep,packed,name=load_avg,json=loadAvg,proto3" json:"load_avg,omitempty"`
+	MemTotalBytes     uint64        `protobuf:"varint,4,opt,name=mem_total_bytes,json=memTotalBytes,proto3" json:"mem_total_bytes,omitempty"`
+	MemAvailableBytes uint64        `protobuf:"varint,5,opt,name=mem_available_bytes,json=memAvailableBytes,proto3" json:"mem_available_bytes,omitempty"`
+	Mounts            []*MountUsage `protobuf:"bytes,6,rep,name=mounts,proto3" json:"mounts,omitempty"`
+	// the open files limits of agent, which are inherited by the services started by agent
+	NofileSoftLimit uint64 `protobuf:"varint,7,opt,name=nofile_soft_limit,json=nofileSoftLimit,proto3" json:"nofile_soft_limit,omitempty"`
+	NofileHardLimit uint64 `protobuf:"varint,8,opt,name=nofile_hard_limit,json=nofileHardLimit,proto3" json:"nofile_hard_limit,omitempty"`
+	// the system wide max and allocated file handles
+	FileMax       uint64 `protobuf:"varint,9,opt,name=file_max,json=fileMax,proto3" json:"file_max,omitempty"`
+	FileAllocated uint64 `protobuf:"varint,10,opt,name=file_allocated,json=fileAllocated,proto3" json:"file_allocated,omitempty"`
+	KernelVersion string `protobuf:"bytes,11,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	// the offset to the time source estimated by kernel, only meaningful when synchronized
+	ClockOffsetUs     int64 `protobuf:"varint,12,opt,name=clock_offset_us,json=clockOffsetUs,proto3" json:"clock_offset_us,omitempty"`
+	ClockSynchronized bool  `protobuf:"varint,13,opt,name=clock_synchronized,json=clockSynchronized,proto3" json:"clock_synchronized,omitempty"`
+	// unix time in milliseconds of agent machine when collected
+	TimeMs               int64    `protobuf:"varint,14,opt,name=time_ms,json=timeMs,proto3" json:"time_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetHostInfoResponse) Reset()         { *m = GetHostInfoResponse{} }
+func (m *GetHostInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHostInfoResponse) ProtoMessage()    {}
+func (*GetHostInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56ede974c0020f77, []int{72}
+}
+func (m *GetHostInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHostInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHostInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHostInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHostInfoResponse.Merge(m, src)
+}
+func (m *GetHostInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHostInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHostInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHostInfoResponse proto.InternalMessageInfo
+
+func (m *GetHostInfoResponse) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *GetHostInfoResponse) GetCpuCount() int32 {
+	if m != nil {
+		return m.CpuCount
+	}
+	return 0
+}
+
+func (m *GetHostInfoResponse) GetLoadAvg() []float64 {
+	if m != nil {
+		return m.LoadAvg
+	}
+	return nil
+}
+
+func (m *GetHostInfoResponse) GetMemTotalBytes() uint64 {
+	if m != nil {
+		return m.MemTotalBytes
+	}
+	return 0
+}
+
+func (m *GetHostInfoResponse) GetMemAvailableBytes() uint64 {
+	if m != nil {
+		return m.MemAvailableBytes
+	}
+	return 0
+}
+
+func (m *GetHostInfoResponse) GetMounts() []*MountUsage {
+	if m != nil {
+		return m.Mounts
+	}
+	return nil
+}
+
+func (m *GetHostInfoResponse) GetNofileSoftLimit() uint64 {
+	if m != nil {
+		return m.NofileSoftLimit
+	}
+	return 0
+}
+
+func (m *GetHostInfoResponse) GetNofileHardLimit() uint64 {
+	if m != nil {
+		return m.NofileHardLimit
+	}
+	return 0
+}
+
+func (m *GetHostInfoResponse) GetFileMax() uint64 {
+	if m != nil {
+		return m.FileMax
+	}
+	return 0
+}
+
+func (m *GetHostInfoResponse) GetFileAllocated() uint64 {
+	if m != nil {
+		return m.FileAllocated
+	}
+	return 0
+}
+
+func (m *GetHostInfoResponse) GetKernelVersion() string {
+	if m != nil {
+		return m.KernelVersion
+	}
+	return ""
+}
+
+func (m *GetHostInfoResponse) GetClockOffsetUs() int64 {
+	if m != nil {
+		return m.ClockOffsetUs
+	}
+	return 0
+}
+
+func (m *GetHostInfoResponse) GetClockSynchronized() bool {
+	if m != nil {
+		return m.ClockSynchronized
+	}
+	return false
+}
+
+func (m *GetHostInfoResponse) GetTimeMs() int64 {
+	if m != nil {
+		return m.TimeMs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("proto.ServiceRole", ServiceRole_name, ServiceRole_value)
+	proto.RegisterEnum("proto.Status", Status_name, Status_value)
+	proto.RegisterEnum("proto.WatchState", WatchState_name, WatchState_value)
+	proto.RegisterEnum("proto.LogKind", LogKind_name, LogKind_value)
+	proto.RegisterType((*RoleResult)(nil), "proto.RoleResult")
+	proto.RegisterType((*StartServiceRequest)(nil), "proto.StartServiceRequest")
+	proto.RegisterType((*StartServiceResponse)(nil), "proto.StartServiceResponse")
+	proto.RegisterType((*StopServiceRequest)(nil), "proto.StopServiceRequest")
+	proto.RegisterType((*StopServiceResponse)(nil), "proto.StopServiceResponse")
+	proto.RegisterType((*ServiceStatusRequest)(nil), "proto.ServiceStatusRequest")
+	proto.RegisterType((*ServiceStatusResponse)(nil), "proto.ServiceStatusResponse")
+	proto.RegisterType((*RestartServiceRequest)(nil), "proto.RestartServiceRequest")
+	proto.RegisterType((*RestartServiceResponse)(nil), "proto.RestartServiceResponse")
+	proto.RegisterType((*ServiceInfo)(nil), "proto.ServiceInfo")
+	proto.RegisterType((*ListServicesRequest)(nil), "proto.ListServicesRequest")
+	proto.RegisterType((*ListServicesResponse)(nil), "proto.ListServicesResponse")
+	proto.RegisterType((*BalanceLeaderRequest)(nil), "proto.BalanceLeaderRequest")
+	proto.RegisterType((*BalanceLeaderResponse)(nil), "proto.BalanceLeaderResponse")
+	proto.RegisterType((*CrashRecord)(nil), "proto.CrashRecord")
+	proto.RegisterType((*WatchedService)(nil), "proto.WatchedService")
+	proto.RegisterType((*WatchdogStatusRequest)(nil), "proto.WatchdogStatusRequest")
+	proto.RegisterType((*WatchdogStatusResponse)(nil), "proto.WatchdogStatusResponse")
+	proto.RegisterType((*TailLogsRequest)(nil), "proto.TailLogsRequest")
+	proto.RegisterType((*TailLogsResponse)(nil), "proto.TailLogsResponse")
+	proto.RegisterType((*GetServiceConfigRequest)(nil), "proto.GetServiceConfigRequest")
+	proto.RegisterType((*GetServiceConfigResponse)(nil), "proto.GetServiceConfigResponse")
+	proto.RegisterMapType((map[string]string)(nil), "proto.GetServiceConfigResponse.FlagsEntry")
+	proto.RegisterType((*UpdateServiceConfigRequest)(nil), "proto.UpdateServiceConfigRequest")
+	proto.RegisterMapType((map[string]string)(nil), "proto.UpdateServiceConfigRequest.SetEntry")
+	proto.RegisterType((*UpdateServiceConfigResponse)(nil), "proto.UpdateServiceConfigResponse")
+	proto.RegisterType((*RollbackServiceConfigRequest)(nil), "proto.RollbackServiceConfigRequest")
+	proto.RegisterType((*RollbackServiceConfigResponse)(nil), "proto.RollbackServiceConfigResponse")
+	proto.RegisterType((*FlagValue)(nil), "proto.FlagValue")
+	proto.RegisterType((*GetFlagsRequest)(nil), "proto.GetFlagsRequest")
+	proto.RegisterType((*GetFlagsResponse)(nil), "proto.GetFlagsResponse")
+	proto.RegisterType((*SetFlagsRequest)(nil), "proto.SetFlagsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "proto.SetFlagsRequest.FlagsEntry")
+	proto.RegisterType((*SetFlagsResponse)(nil), "proto.SetFlagsResponse")
+	proto.RegisterType((*BanReadWriteRequest)(nil), "proto.BanReadWriteRequest")
+	proto.RegisterType((*BanReadWriteResponse)(nil), "proto.BanReadWriteResponse")
+	proto.RegisterType((*AllowReadWriteRequest)(nil), "proto.AllowReadWriteRequest")
+	proto.RegisterType((*AllowReadWriteResponse)(nil), "proto.AllowReadWriteResponse")
+	proto.RegisterType((*ReadWriteBan)(nil), "proto.ReadWriteBan")
+	proto.RegisterType((*ReadWriteStatusRequest)(nil), "proto.ReadWriteStatusRequest")
+	proto.RegisterType((*ReadWriteStatusResponse)(nil), "proto.ReadWriteStatusResponse")
+	proto.RegisterType((*DataPlayBackRequest)(nil), "proto.DataPlayBackRequest")
+	proto.RegisterType((*DataPlayBackResponse)(nil), "proto.DataPlayBackResponse")
+	proto.RegisterType((*StopAgentRequest)(nil), "proto.StopAgentRequest")
+	proto.RegisterType((*StopAgentResponse)(nil), "proto.StopAgentResponse")
+	proto.RegisterType((*HealthCheckRequest)(nil), "proto.HealthCheckRequest")
+	proto.RegisterType((*HealthCheckResponse)(nil), "proto.HealthCheckResponse")
+	proto.RegisterType((*GetSpaceUsagesRequest)(nil), "proto.GetSpaceUsagesRequest")
+	proto.RegisterType((*GetSpaceUsagesResponse)(nil), "proto.GetSpaceUsagesResponse")
+	proto.RegisterType((*GetSpaceUsagesResponse_PartUsageItem)(nil), "proto.GetSpaceUsagesResponse.PartUsageItem")
+	proto.RegisterType((*GetSpaceUsagesResponse_SpaceUsageItem)(nil), "proto.GetSpaceUsagesResponse.SpaceUsageItem")
+	proto.RegisterType((*UpgradeServiceRequest)(nil), "proto.UpgradeServiceRequest")
+	proto.RegisterType((*UpgradeServiceResponse)(nil), "proto.UpgradeServiceResponse")
+	proto.RegisterType((*RollbackServiceBinaryRequest)(nil), "proto.RollbackServiceBinaryRequest")
+	proto.RegisterType((*RollbackServiceBinaryResponse)(nil), "proto.RollbackServiceBinaryResponse")
+	proto.RegisterType((*ListServiceVersionsRequest)(nil), "proto.ListServiceVersionsRequest")
+	proto.RegisterType((*ListServiceVersionsResponse)(nil), "proto.ListServiceVersionsResponse")
+	proto.RegisterType((*GetServiceDirsRequest)(nil), "proto.GetServiceDirsRequest")
+	proto.RegisterType((*ServiceDirs)(nil), "proto.ServiceDirs")
+	proto.RegisterType((*GetServiceDirsResponse)(nil), "proto.GetServiceDirsResponse")
+	proto.RegisterType((*CheckpointInfo)(nil), "proto.CheckpointInfo")
+	proto.RegisterType((*ListCheckpointsRequest)(nil), "proto.ListCheckpointsRequest")
+	proto.RegisterType((*ListCheckpointsResponse)(nil), "proto.ListCheckpointsResponse")
+	proto.RegisterType((*DropCheckpointRequest)(nil), "proto.DropCheckpointRequest")
+	proto.RegisterType((*DropCheckpointResponse)(nil), "proto.DropCheckpointResponse")
+	proto.RegisterType((*CollectDiagnosticsRequest)(nil), "proto.CollectDiagnosticsRequest")
+	proto.RegisterType((*CollectDiagnosticsResponse)(nil), "proto.CollectDiagnosticsResponse")
+	proto.RegisterType((*LogRetentionPolicy)(nil), "proto.LogRetentionPolicy")
+	proto.RegisterType((*LogRetentionConfig)(nil), "proto.LogRetentionConfig")
+	proto.RegisterMapType((map[string]*LogRetentionPolicy)(nil), "proto.LogRetentionConfig.RolesEntry")
+	proto.RegisterType((*LogFileAction)(nil), "proto.LogFileAction")
+	proto.RegisterType((*LogCleanReport)(nil), "proto.LogCleanReport")
+	proto.RegisterType((*CleanLogsRequest)(nil), "proto.CleanLogsRequest")
+	proto.RegisterType((*CleanLogsResponse)(nil), "proto.CleanLogsResponse")
+	proto.RegisterType((*LogJanitorStatusRequest)(nil), "proto.LogJanitorStatusRequest")
+	proto.RegisterType((*LogJanitorStatusResponse)(nil), "proto.LogJanitorStatusResponse")
+	proto.RegisterType((*GetHostInfoRequest)(nil), "proto.GetHostInfoRequest")
+	proto.RegisterType((*MountUsage)(nil), "proto.MountUsage")
+	proto.RegisterType((*GetHostInfoResponse)(nil), "proto.GetHostInfoResponse")
+}
+
+func init() { proto.RegisterFile("agent.proto", fileDescriptor_56ede974c0020f77) }
+
+var fileDescriptor_56ede974c0020f77 = []byte{
+	// 3804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4b, 0x8c, 0x1c, 0x4b,
+	0x52, 0xae, 0xfe, 0x77, 0xf4, 0x4c, 0x77, 0xbb, 0xe6, 0xd7, 0x53, 0xf6, 0xf8, 0x53, 0xef, 0xad,
+	0xed, 0xf5, 0xdb, 0xf5, 0x82, 0x97, 0xfd, 0xf0, 0x58, 0x3e, 0x3d, 0x1f, 0xcf, 0xcc, 0x7b, 0x63,
+	0xcf, 0x90, 0x3d, 0x63, 0x83, 0xb4, 0x52, 0x93, 0x53, 0x95, 0xd3, 0x53, 0xeb, 0xea, 0xaa, 0xde,
+	0xaa, 0xea, 0x79, 0x33, 0x70, 0xe2, 0xc0, 0x81, 0x0b, 0x12, 0x62, 0x41, 0xcb, 0x01, 0x69, 0xb9,
+	0xc2, 0x6d, 0x85, 0xc4, 0x89, 0x0b, 0x17, 0x40, 0xe2, 0xc0, 0x19, 0x09, 0x09, 0x1e, 0xe2, 0xce,
+	0x89, 0x03, 0x27, 0x14, 0xf9, 0xa9, 0x5f, 0x57, 0xcf, 0xda, 0xd8, 0x4f, 0x6f, 0x4f, 0xdd, 0xf1,
+	0xc9, 0xcc, 0xc8, 0x88, 0xc8, 0xc8, 0x8c, 0x88, 0x82, 0x16, 0x1d, 0x31, 0x2f, 0x7a, 0x32, 0x09,
+	0xfc, 0xc8, 0xd7, 0xab, 0xfc, 0xc7, 0x58, 0x0c, 0x23, 0x3f, 0xa0, 0x23, 0x26, 0xb0, 0xe6, 0x39,
+	0x00, 0xf1, 0x5d, 0x46, 0x58, 0x38, 0x75, 0x23, 0xfd, 0x01, 0x54, 0x02, 0xdf, 0x65, 0x3d, 0xed,
+	0x9e, 0xf6, 0xa8, 0xfd, 0x54, 0x17, 0x3c, 0x4f, 0x06, 0x2c, 0xb8, 0x70, 0x2c, 0xc6, 0xf9, 0x38,
+	0x5d, 0xbf, 0x0d, 0xcd, 0x70, 0x6a, 0x59, 0x8c, 0xd9, 0xcc, 0xee, 0x95, 0xee, 0x69, 0x8f, 0x1a,
+	0x24, 0x41, 0xe8, 0xcb, 0x50, 0x65, 0x41, 0xe0, 0x07, 0xbd, 0xf2, 0x3d, 0xed, 0x51, 0x93, 0x08,
+	0xc0, 0xfc, 0x27, 0x0d, 0x96, 0x06, 0x11, 0x0d, 0x22, 0x35, 0x1d, 0xfb, 0xe1, 0x94, 0x85, 0x6f,
+	0xbe, 0x66, 0x17, 0xca, 0xb6, 0x13, 0xf0, 0xd5, 0x9a, 0x04, 0xff, 0xea, 0x4f, 0x61, 0x25, 0x60,
+	0xd4, 0xbe, 0x1a, 0x46, 0xce, 0x98, 0xf9, 0xd3, 0x68, 0x18, 0x32, 0xcb, 0xf7, 0xec, 0x90, 0xaf,
+	0x5b, 0x25, 0x4b, 0x9c, 0x78, 0x2c, 0x68, 0x03, 0x41, 0xd2, 0x1f, 0x41, 0x15, 0x67, 0x0b, 0x7b,
+	0x95, 0x7b, 0xe5, 0x39, 0xcb, 0x09, 0x06, 0xdd, 0x80, 0x86, 0xe3, 0x85, 0x11, 0xf5, 0x2c, 0xd6,
+	0xab, 0xf2, 0x45, 0x63, 0xd8, 0xdc, 0x82, 0xe5, 0xec, 0x56, 0xc2, 0x89, 0xef, 0x85, 0x4c, 0xff,
+	0x08, 0xea, 0x01, 0xd7, 0x64, 0xd8, 0xd3, 0xee, 0x95, 0x1f, 0xb5, 0x9e, 0xde, 0x94, 0xf3, 0x27,
+	0x3a, 0x26, 0x8a, 0xc3, 0xfc, 0x91, 0x06, 0xfa, 0x20, 0xf2, 0x27, 0xef, 0x4d, 0x1f, 0xf1, 0xde,
+	0xca, 0x6f, 0xb3, 0xb7, 0x4a, 0x6e, 0x6f, 0x9b, 0xb0, 0x94, 0x91, 0xea, 0xff, 0xb3, 0x35, 0x17,
+	0x96, 0xe5, 0xf8, 0x41, 0x44, 0xa3, 0x69, 0xf8, 0xee, 0x7b, 0x4b, 0x4b, 0x5c, 0xce, 0x49, 0xfc,
+	0x77, 0x1a, 0xac, 0xe4, 0x96, 0x93, 0x42, 0x7f, 0x05, 0x6a, 0x21, 0xc7, 0xc8, 0x15, 0x17, 0xd5,
+	0x8a, 0x82, 0x4d, 0x12, 0x71, 0xb9, 0x89, 0x23, 0x1c, 0xb9, 0x4c, 0xf0, 0xaf, 0xfe, 0x15, 0x68,
+	0x4f, 0x27, 0xe8, 0x56, 0x19, 0x9f, 0x2a, 0x93, 0x45, 0x81, 0x55, 0xde, 0x74, 0x0b, 0x9a, 0xec,
+	0xd2, 0x89, 0x86, 0x96, 0x6f, 0x0b, 0x45, 0x56, 0x49, 0x03, 0x11, 0x5b, 0xbe, 0xcd, 0xf4, 0x87,
+	0xd0, 0x71, 0x9d, 0x30, 0x62, 0x9e, 0xe3, 0x8d, 0x86, 0x13, 0x3f, 0x88, 0xc2, 0x5e, 0xf5, 0x5e,
+	0xf9, 0x51, 0x95, 0xb4, 0x63, 0xf4, 0x11, 0x62, 0xcd, 0xbf, 0xd4, 0x60, 0x85, 0xb0, 0xf0, 0x4b,
+	0x3f, 0x1b, 0xd7, 0x79, 0x45, 0x0f, 0x56, 0xf3, 0x22, 0x0a, 0x1d, 0x9b, 0xff, 0xab, 0x41, 0x4b,
+	0xe2, 0xf6, 0xbd, 0x33, 0xff, 0x8d, 0x65, 0xd6, 0xa1, 0x42, 0x6d, 0x5b, 0x09, 0xcd, 0xff, 0xeb,
+	0xeb, 0xd0, 0x08, 0x7c, 0x3f, 0x1a, 0xe2, 0x66, 0x84, 0x95, 0xeb, 0x08, 0x6f, 0x3b, 0x01, 0xaa,
+	0xda, 0xa6, 0x11, 0x45, 0x92, 0x38, 0xbc, 0x4d, 0xd2, 0x40, 0xc4, 0xb6, 0x13, 0x84, 0xfa, 0x5d,
+	0x68, 0x9d, 0xfb, 0x61, 0x34, 0x94, 0xc6, 0x16, 0xc7, 0x15, 0x10, 0x25, 0x2c, 0xad, 0xdf, 0x87,
+	0x05, 0x97, 0x51, 0x9b, 0x05, 0xc3, 0x09, 0x45, 0x43, 0xd4, 0xb8, 0x35, 0x5b, 0x02, 0x77, 0x84,
+	0x28, 0x9c, 0x23, 0xf2, 0x23, 0xea, 0x4a, 0x8e, 0x3a, 0xe7, 0x00, 0x8e, 0x12, 0x0c, 0x3a, 0x54,
+	0x2c, 0xdf, 0x3b, 0xeb, 0x35, 0x84, 0xc0, 0xf8, 0xdf, 0x5c, 0x81, 0xa5, 0x03, 0x27, 0x54, 0x3a,
+	0x51, 0x7e, 0x6e, 0x3e, 0x83, 0xe5, 0x2c, 0x5a, 0xfa, 0xe3, 0x13, 0x68, 0x84, 0x12, 0x27, 0x4f,
+	0x51, 0x4e, 0x3f, 0xa8, 0x41, 0x12, 0xf3, 0x98, 0xab, 0xb0, 0xbc, 0x49, 0x5d, 0x34, 0xc0, 0x01,
+	0x97, 0x54, 0xcd, 0xbf, 0x06, 0x2b, 0x39, 0xbc, 0x34, 0xc6, 0x9f, 0x68, 0xd0, 0xda, 0x0a, 0x68,
+	0x78, 0x4e, 0x98, 0xe5, 0x07, 0x36, 0xca, 0x8c, 0x0e, 0xc0, 0x8d, 0x51, 0x26, 0xfc, 0x7f, 0xd6,
+	0x69, 0x4b, 0x39, 0xa7, 0x5d, 0x87, 0x86, 0xeb, 0x8f, 0x86, 0x67, 0x8e, 0xab, 0xce, 0x59, 0xdd,
+	0xf5, 0x47, 0xcf, 0x1c, 0x37, 0x26, 0x45, 0xd4, 0x71, 0xa5, 0x01, 0x90, 0x74, 0x4c, 0x1d, 0x57,
+	0xdf, 0x00, 0xb0, 0xfc, 0x80, 0xf1, 0x61, 0xc2, 0xcb, 0x9b, 0xa4, 0x89, 0x18, 0x1c, 0x18, 0x9a,
+	0x3f, 0x2d, 0x41, 0xfb, 0x15, 0x8d, 0xac, 0x73, 0x66, 0xcb, 0x7d, 0xbe, 0x83, 0x67, 0x3f, 0x84,
+	0x2a, 0x9a, 0x59, 0x88, 0xd7, 0x8e, 0xc3, 0x10, 0x9f, 0x1f, 0xad, 0xcd, 0x88, 0xa0, 0xeb, 0x1f,
+	0xc0, 0x62, 0x20, 0x5c, 0x76, 0x68, 0xf9, 0x53, 0x2f, 0xe2, 0x3e, 0x5d, 0x26, 0x0b, 0x12, 0xb9,
+	0x85, 0x38, 0xfd, 0x31, 0xdc, 0x74, 0x69, 0x18, 0x0d, 0x15, 0x27, 0xd7, 0x56, 0x95, 0x33, 0x76,
+	0x90, 0x20, 0x9d, 0x1e, 0x4f, 0x0a, 0xf2, 0x7a, 0xec, 0x32, 0xc7, 0x2b, 0x3c, 0xa9, 0x83, 0x84,
+	0x34, 0xef, 0xd7, 0xa0, 0x6e, 0xa1, 0x1d, 0x18, 0x7a, 0x52, 0xda, 0xd0, 0x29, 0xeb, 0x10, 0xc5,
+	0x52, 0xe8, 0x5a, 0x6b, 0xb0, 0xc2, 0xf7, 0x64, 0xfb, 0xa3, 0x4c, 0x10, 0x35, 0x19, 0xac, 0xe6,
+	0x09, 0xd2, 0xbd, 0x7a, 0x50, 0x67, 0x1e, 0x3d, 0x75, 0x99, 0xcd, 0xf5, 0xda, 0x20, 0x0a, 0xd4,
+	0x7f, 0x31, 0xe5, 0x78, 0x25, 0x2e, 0xcf, 0x4a, 0x5a, 0x6f, 0xb1, 0x5d, 0x52, 0xbe, 0xf7, 0x5f,
+	0x1a, 0x74, 0xd0, 0xb8, 0x07, 0xfe, 0xe8, 0x3d, 0xc4, 0xef, 0x0f, 0xa1, 0xfa, 0xda, 0xf1, 0x6c,
+	0x75, 0x37, 0xb5, 0xe5, 0xd0, 0x03, 0x7f, 0xf4, 0xa9, 0xe3, 0xd9, 0x44, 0x10, 0xf1, 0xe5, 0xe0,
+	0x3a, 0x1e, 0xbf, 0x9d, 0xd1, 0x2d, 0x05, 0xa0, 0xaf, 0x42, 0xed, 0xcc, 0x77, 0x5d, 0xff, 0x33,
+	0x6e, 0x98, 0x06, 0x91, 0x10, 0x6a, 0x6d, 0x14, 0xb0, 0x09, 0x37, 0x41, 0x93, 0xf0, 0xff, 0x38,
+	0x43, 0xe8, 0x60, 0x00, 0x13, 0xe7, 0x57, 0x00, 0x99, 0xc8, 0xd6, 0xc8, 0x45, 0xb6, 0xdf, 0x81,
+	0x6e, 0xb2, 0x4d, 0xa9, 0x48, 0x13, 0x2a, 0x28, 0x90, 0xdc, 0x67, 0x5e, 0x58, 0x4e, 0xc3, 0xd5,
+	0xf9, 0x29, 0x91, 0xf1, 0x0b, 0xff, 0x27, 0xf2, 0x97, 0xf9, 0x11, 0x10, 0x80, 0xf9, 0x87, 0x1a,
+	0xac, 0xed, 0x32, 0x15, 0x0d, 0xb6, 0x7c, 0xef, 0xcc, 0x19, 0xbd, 0xbb, 0x46, 0x7b, 0x50, 0xbf,
+	0x60, 0x41, 0xe8, 0xf8, 0x9e, 0xbc, 0x9b, 0x14, 0x78, 0x6d, 0x1c, 0xff, 0x67, 0x0d, 0x7a, 0xb3,
+	0xb2, 0xc8, 0x6d, 0xff, 0x06, 0x54, 0xcf, 0x5c, 0x3a, 0x52, 0xb1, 0xe9, 0xb1, 0x94, 0x66, 0x1e,
+	0xff, 0x93, 0x67, 0xc8, 0xbc, 0xe3, 0x45, 0xc1, 0x15, 0x11, 0x03, 0x51, 0x28, 0xcb, 0xf7, 0x22,
+	0xe6, 0x45, 0x52, 0x54, 0x05, 0xa2, 0x50, 0x52, 0x3e, 0xa1, 0x9d, 0x32, 0x89, 0x61, 0xe3, 0xbb,
+	0x00, 0xc9, 0x54, 0xb8, 0xd5, 0xd7, 0xec, 0x8a, 0x6b, 0xa4, 0x49, 0xf0, 0x2f, 0xaa, 0xf5, 0x82,
+	0xba, 0x53, 0xa5, 0x6b, 0x01, 0x7c, 0x5c, 0xfa, 0xae, 0x66, 0xfe, 0x79, 0x09, 0x8c, 0x93, 0x89,
+	0x4d, 0x23, 0xf6, 0x9e, 0xb5, 0xfb, 0x3d, 0x28, 0x87, 0x2c, 0xea, 0x95, 0x33, 0x8a, 0x98, 0xbf,
+	0xd2, 0x93, 0x01, 0x8b, 0x84, 0x22, 0x70, 0x18, 0x7a, 0x6c, 0xc0, 0xc6, 0xfe, 0x05, 0x93, 0x81,
+	0x52, 0x42, 0xfa, 0x1a, 0xd4, 0xed, 0xe0, 0x6a, 0x18, 0x4c, 0x3d, 0xe5, 0xca, 0x76, 0x70, 0x45,
+	0xa6, 0x59, 0x93, 0xd5, 0xb2, 0x26, 0x33, 0xbe, 0x0d, 0x0d, 0x35, 0xfb, 0x5b, 0xe9, 0xe6, 0x47,
+	0x1a, 0xdc, 0x2a, 0x94, 0x38, 0x7e, 0x1c, 0xb5, 0x4f, 0xa9, 0xf5, 0x7a, 0x3a, 0x19, 0x2a, 0x3f,
+	0x12, 0xb7, 0xc4, 0xa2, 0xc0, 0xbe, 0x14, 0x48, 0xfd, 0xab, 0xd0, 0x55, 0x01, 0x2f, 0x60, 0x3f,
+	0x9c, 0x3a, 0x41, 0xfc, 0xe4, 0xef, 0x48, 0x3c, 0x91, 0xe8, 0x74, 0xc4, 0x15, 0x7e, 0x24, 0x8e,
+	0x81, 0x8a, 0xb8, 0xdc, 0xc6, 0xe6, 0x1f, 0x69, 0x70, 0x9b, 0xf8, 0xae, 0x8b, 0xab, 0xfc, 0x5c,
+	0x1c, 0x89, 0x3f, 0xd3, 0x60, 0x63, 0x8e, 0x40, 0x5f, 0xae, 0xa6, 0x7e, 0xaa, 0x41, 0x13, 0xff,
+	0xbd, 0x44, 0x93, 0x62, 0xbc, 0xf1, 0xa8, 0xbc, 0xca, 0x9b, 0x84, 0xff, 0xd7, 0xef, 0x02, 0x9c,
+	0xfa, 0xbe, 0x3b, 0x4c, 0x3c, 0xa0, 0xb1, 0x77, 0x83, 0x34, 0x11, 0x27, 0x06, 0x6d, 0x40, 0xd3,
+	0xf1, 0x22, 0x49, 0xe7, 0x3a, 0xd9, 0xbb, 0x81, 0x5b, 0x8f, 0x04, 0xf9, 0x03, 0x58, 0xb0, 0xfd,
+	0xe9, 0xa9, 0xcb, 0x24, 0x07, 0xaa, 0x46, 0xdb, 0xbb, 0x41, 0x5a, 0x02, 0x1b, 0x33, 0x85, 0x51,
+	0x80, 0x8f, 0x58, 0xc1, 0xc4, 0x5f, 0x57, 0xc8, 0x24, 0xb0, 0x9c, 0x69, 0xb3, 0x2e, 0xdd, 0xd0,
+	0xb4, 0xa0, 0xb3, 0xcb, 0xc4, 0x06, 0xde, 0xd6, 0xa0, 0x45, 0x2f, 0xc2, 0x65, 0xa8, 0xe2, 0x4e,
+	0xe3, 0x88, 0xca, 0x01, 0xf3, 0x63, 0xe8, 0x26, 0x8b, 0x48, 0x23, 0x3d, 0xc8, 0x06, 0xaf, 0xae,
+	0x5c, 0x26, 0x56, 0xa0, 0x0c, 0x51, 0xe6, 0xbf, 0x6a, 0xd0, 0x19, 0xbc, 0x47, 0x09, 0xbf, 0xa3,
+	0xd6, 0x15, 0xb1, 0xe2, 0x7e, 0x3c, 0x38, 0xb3, 0x44, 0x71, 0xac, 0x9c, 0xa0, 0xe7, 0x84, 0xe2,
+	0x65, 0xd2, 0x20, 0x0a, 0x7c, 0x87, 0x78, 0xf8, 0xcb, 0xd0, 0x1d, 0xe4, 0x15, 0xf3, 0x66, 0xde,
+	0x6b, 0xfe, 0x26, 0x2c, 0x6d, 0x52, 0x8f, 0x30, 0x6a, 0xbf, 0x0a, 0x9c, 0x88, 0xbd, 0x07, 0xd5,
+	0x88, 0xe7, 0x6b, 0x7a, 0x4a, 0xf9, 0x4a, 0x1d, 0xc0, 0x4a, 0x1f, 0x6f, 0xf0, 0xf7, 0xba, 0x58,
+	0x0f, 0x56, 0xf3, 0x93, 0xca, 0xe5, 0xfe, 0x4a, 0x83, 0x85, 0x18, 0xbb, 0x49, 0xbd, 0x77, 0x75,
+	0x48, 0xf1, 0xc0, 0x28, 0xa7, 0x1f, 0x18, 0x3d, 0xa8, 0xd3, 0xc9, 0xc4, 0x75, 0x98, 0xad, 0x6c,
+	0x29, 0xc1, 0xa4, 0x18, 0x52, 0x4d, 0x15, 0x43, 0x90, 0xff, 0xd4, 0xf5, 0xad, 0xd7, 0xcc, 0x96,
+	0xe1, 0x5e, 0x81, 0x22, 0xd1, 0x92, 0xb2, 0x66, 0xdf, 0x7d, 0x9b, 0xb0, 0x36, 0x43, 0x91, 0x26,
+	0x7e, 0x08, 0x95, 0x53, 0xea, 0x29, 0xd7, 0x5f, 0x52, 0x99, 0x79, 0x6a, 0xcf, 0x84, 0x33, 0x98,
+	0x3f, 0xd1, 0x60, 0x69, 0x9b, 0x46, 0xf4, 0xc8, 0xa5, 0x57, 0x9b, 0xd4, 0x7a, 0xad, 0x14, 0x2f,
+	0x63, 0xa9, 0x96, 0xc4, 0x52, 0x95, 0x6f, 0x4d, 0x68, 0x74, 0x2e, 0x15, 0xc0, 0xf3, 0xad, 0x23,
+	0x1a, 0x9d, 0x23, 0x71, 0xcc, 0x22, 0x3a, 0xe4, 0xda, 0x91, 0xe9, 0x38, 0x22, 0xfa, 0xa8, 0xa1,
+	0x87, 0xd0, 0xc9, 0x27, 0x9d, 0xe2, 0x39, 0xd7, 0x8e, 0xb2, 0xf9, 0xe6, 0x32, 0x54, 0x69, 0x78,
+	0xe5, 0x59, 0xf2, 0x2e, 0x14, 0x80, 0xb9, 0x07, 0xcb, 0x59, 0x09, 0xe5, 0x1e, 0x57, 0xa0, 0xf6,
+	0x03, 0xff, 0x74, 0xe8, 0xd8, 0x52, 0xca, 0xea, 0x0f, 0xfc, 0xd3, 0x7d, 0x3b, 0x93, 0xb0, 0x94,
+	0x32, 0x09, 0x8b, 0xa9, 0x43, 0x17, 0x2b, 0x19, 0x7d, 0x2c, 0x82, 0x29, 0x25, 0x2e, 0xc1, 0xcd,
+	0x14, 0x4e, 0x3a, 0xc8, 0x32, 0xe8, 0x7b, 0x8c, 0xba, 0xd1, 0xf9, 0xd6, 0x39, 0x8b, 0x75, 0x62,
+	0x7e, 0x1d, 0x96, 0x32, 0x58, 0x29, 0xc7, 0x6a, 0xa6, 0xa6, 0xd0, 0x54, 0x45, 0x04, 0xf3, 0xf7,
+	0x60, 0x05, 0x1f, 0x4a, 0x13, 0x6a, 0xb1, 0x93, 0x90, 0x8e, 0xe2, 0x64, 0x30, 0xab, 0x49, 0x2d,
+	0xa7, 0xc9, 0x0d, 0x80, 0x98, 0x28, 0x9e, 0xe6, 0x4d, 0xd2, 0x54, 0xd4, 0x50, 0x7f, 0x00, 0x9d,
+	0x31, 0xbd, 0x1c, 0xd2, 0x11, 0xcb, 0x25, 0xf0, 0x8b, 0x63, 0x7a, 0xd9, 0x1f, 0xa9, 0x42, 0x84,
+	0xf9, 0x0f, 0x65, 0x58, 0xcd, 0xaf, 0x2e, 0xe5, 0x7d, 0x01, 0xad, 0x14, 0x5a, 0xba, 0xc8, 0xd7,
+	0x52, 0x4f, 0xbb, 0xd9, 0x31, 0x4f, 0x12, 0xdc, 0x7e, 0xc4, 0xc6, 0x24, 0x3d, 0x01, 0x6e, 0x27,
+	0xb4, 0xa8, 0x27, 0xb2, 0x1f, 0x51, 0x32, 0x69, 0x20, 0x02, 0xd3, 0x1e, 0xe3, 0x7b, 0xb0, 0x88,
+	0xc9, 0x72, 0x3c, 0x54, 0x6f, 0x43, 0x49, 0x5a, 0xac, 0x4c, 0x4a, 0x8e, 0x8d, 0xa3, 0x3f, 0xa3,
+	0xee, 0xf0, 0xf4, 0x2a, 0xe2, 0x99, 0x08, 0x1f, 0xfd, 0x19, 0x75, 0x37, 0x11, 0x36, 0x7e, 0xbf,
+	0x04, 0xed, 0xec, 0xd2, 0x33, 0xe3, 0x97, 0xa1, 0x3a, 0x45, 0xa2, 0x1c, 0x2b, 0x80, 0x58, 0x8b,
+	0x62, 0x5a, 0x71, 0x32, 0xb9, 0x16, 0xf9, 0xbc, 0xd9, 0x45, 0x2b, 0xd9, 0x45, 0xf1, 0xd6, 0xb6,
+	0xd0, 0xc0, 0x13, 0x1f, 0x6f, 0x4a, 0xc1, 0x23, 0x13, 0xc0, 0x04, 0x2f, 0x58, 0xef, 0x42, 0xcb,
+	0x8f, 0xce, 0x59, 0x20, 0xb9, 0x44, 0xea, 0x07, 0x1c, 0x25, 0x18, 0xfa, 0x50, 0x55, 0xd5, 0x03,
+	0xd4, 0xf2, 0x47, 0xd7, 0x6b, 0x39, 0xa3, 0x29, 0x22, 0x46, 0x9a, 0x3f, 0x29, 0xc1, 0xca, 0xc9,
+	0x64, 0x14, 0x50, 0x9b, 0xbd, 0xb7, 0x62, 0xd0, 0x35, 0xc5, 0x33, 0xfd, 0x01, 0xd4, 0x42, 0x7f,
+	0x1a, 0xc8, 0x77, 0x51, 0x2b, 0x4e, 0x76, 0xf0, 0xec, 0x31, 0xcf, 0x26, 0x92, 0xca, 0xdd, 0xfe,
+	0x9c, 0x3e, 0xfd, 0xd6, 0xb7, 0x65, 0x20, 0x93, 0x50, 0xfa, 0xcd, 0x25, 0x23, 0x99, 0x04, 0xe7,
+	0x97, 0xa0, 0xea, 0xf3, 0x4b, 0x50, 0x1f, 0xc0, 0xe2, 0x6b, 0xc6, 0xe2, 0x9b, 0x2a, 0xe4, 0xd9,
+	0x5a, 0x95, 0x2c, 0x20, 0x52, 0x5e, 0x54, 0xa1, 0x39, 0x84, 0xd5, 0xbc, 0x86, 0xa4, 0xaf, 0x7f,
+	0x15, 0xba, 0x93, 0x80, 0x5d, 0x38, 0xfe, 0x34, 0xcc, 0x5c, 0x76, 0x4d, 0xd2, 0x51, 0xf8, 0x97,
+	0xc9, 0x8b, 0x30, 0x5e, 0x44, 0x1c, 0xbb, 0x18, 0x36, 0xff, 0x7e, 0xf6, 0x89, 0xba, 0xe9, 0x78,
+	0x34, 0xb8, 0xfa, 0x62, 0x4d, 0x91, 0x52, 0x65, 0xe5, 0x0d, 0x55, 0x59, 0x9d, 0xab, 0x4a, 0xf3,
+	0x13, 0xd8, 0x98, 0xb3, 0x87, 0xb7, 0x56, 0x96, 0xf9, 0x12, 0x8c, 0x54, 0x3d, 0x4b, 0x62, 0xdf,
+	0xbd, 0x2a, 0x60, 0x0e, 0xe0, 0x56, 0xe1, 0xbc, 0x49, 0x3d, 0xc3, 0x9a, 0x06, 0x01, 0x66, 0x93,
+	0x9a, 0xcc, 0x26, 0x05, 0x78, 0xad, 0xf5, 0x7e, 0x5d, 0x04, 0x62, 0x31, 0x27, 0x96, 0x07, 0xdf,
+	0x52, 0x4e, 0xf3, 0x6f, 0x92, 0x8a, 0x26, 0x0e, 0xff, 0x52, 0x2a, 0x9a, 0x1b, 0x00, 0x21, 0x86,
+	0x0c, 0x41, 0x95, 0x15, 0x35, 0x8e, 0xe1, 0x64, 0x55, 0x30, 0xaa, 0xa5, 0x0a, 0x46, 0x7b, 0xe2,
+	0x0a, 0x48, 0xef, 0xfb, 0x4d, 0xcb, 0x8e, 0x9c, 0x3b, 0xe6, 0x31, 0xff, 0x4d, 0x83, 0xf6, 0x56,
+	0x1c, 0xfb, 0x78, 0x55, 0xb7, 0x28, 0xfb, 0x58, 0x87, 0x86, 0x90, 0x31, 0xae, 0x9d, 0xd7, 0x39,
+	0xbc, 0x6f, 0x67, 0xef, 0xbc, 0x72, 0xee, 0xce, 0x5b, 0x86, 0xaa, 0xb8, 0xee, 0xc4, 0xa6, 0x05,
+	0x90, 0xd4, 0x5f, 0xd3, 0x21, 0x58, 0xd4, 0x5f, 0x45, 0x70, 0x7d, 0x08, 0x1d, 0x76, 0x69, 0xb9,
+	0xd3, 0xd0, 0xb9, 0x60, 0x99, 0x08, 0xdc, 0x8e, 0xd1, 0x71, 0x98, 0xb6, 0x02, 0x46, 0x23, 0x26,
+	0xee, 0x28, 0x59, 0xc9, 0x15, 0x28, 0x3c, 0x24, 0x26, 0x81, 0x55, 0x74, 0xbb, 0x64, 0x8b, 0xb1,
+	0x8b, 0x64, 0xaf, 0x63, 0x2d, 0x7f, 0x1d, 0xcf, 0xdf, 0xb1, 0x49, 0x60, 0x6d, 0x66, 0x4e, 0xa9,
+	0xfe, 0xef, 0x40, 0x2b, 0xb9, 0x49, 0x94, 0x05, 0x54, 0xfd, 0x2d, 0xab, 0x67, 0x92, 0xe6, 0x34,
+	0x9f, 0xc1, 0xca, 0x76, 0xe0, 0x4f, 0x12, 0x16, 0x25, 0xe6, 0xdb, 0x59, 0xc3, 0xdc, 0x87, 0xd5,
+	0xfc, 0x3c, 0x52, 0xb4, 0x6f, 0x60, 0x41, 0xc2, 0x9f, 0x4c, 0x98, 0x7d, 0xbd, 0x58, 0x8a, 0xcb,
+	0xfc, 0x6f, 0x0d, 0xd6, 0xb7, 0x7c, 0xd7, 0x65, 0x56, 0xb4, 0xed, 0xd0, 0x91, 0xe7, 0x87, 0x91,
+	0x63, 0x7d, 0xb1, 0xfd, 0x1d, 0x7e, 0x16, 0x92, 0x82, 0xab, 0xb8, 0xbf, 0x9b, 0x49, 0xa9, 0x75,
+	0x1d, 0x1a, 0xcc, 0xb3, 0xd3, 0x95, 0xdb, 0x3a, 0xf3, 0x6c, 0x4e, 0x7a, 0x00, 0xb5, 0x88, 0x06,
+	0x23, 0x16, 0xf5, 0x6a, 0xc5, 0x97, 0x9b, 0xa0, 0xf2, 0xf7, 0x2c, 0xbd, 0x94, 0x4e, 0x25, 0xfc,
+	0xa5, 0x31, 0xa6, 0x97, 0xdc, 0x9d, 0xcc, 0x3f, 0xd5, 0xc0, 0x28, 0xda, 0xb2, 0x54, 0xa1, 0x0e,
+	0x95, 0xd4, 0xcb, 0x8e, 0xff, 0xc7, 0xfd, 0x4d, 0x03, 0x47, 0xed, 0x6f, 0x1a, 0x38, 0xd8, 0x80,
+	0x38, 0x9d, 0x7a, 0xb6, 0xcb, 0x32, 0x6f, 0x94, 0x96, 0xc0, 0x09, 0xb7, 0x5d, 0x86, 0xaa, 0xa8,
+	0x9f, 0xcb, 0x63, 0xc1, 0x01, 0x8c, 0x81, 0xe1, 0x6b, 0x87, 0x5b, 0x48, 0x44, 0x01, 0x05, 0x9a,
+	0xff, 0xa1, 0x81, 0x7e, 0xe0, 0x8f, 0x08, 0x8b, 0x98, 0x17, 0x39, 0xbe, 0x77, 0xe4, 0xbb, 0x8e,
+	0x75, 0x55, 0xf4, 0x64, 0x94, 0xf9, 0x5e, 0xe6, 0xc9, 0xa8, 0xf8, 0xd2, 0x67, 0xae, 0x14, 0xf3,
+	0x1d, 0x27, 0xc7, 0x4e, 0xea, 0x46, 0x88, 0x26, 0x1e, 0x9f, 0xa8, 0x1b, 0x5e, 0xd9, 0xd7, 0x7f,
+	0x09, 0x56, 0x2d, 0x7f, 0x3c, 0x09, 0x58, 0x18, 0x0e, 0xe9, 0x59, 0xc4, 0x82, 0xcc, 0x93, 0xbf,
+	0x4c, 0x96, 0x15, 0xb5, 0x8f, 0xc4, 0xa4, 0x09, 0x5b, 0xa7, 0x81, 0x75, 0xee, 0x5c, 0x08, 0x83,
+	0xcd, 0xda, 0x45, 0x91, 0xcd, 0x3f, 0x28, 0x65, 0xf7, 0x28, 0x0a, 0x33, 0x78, 0x75, 0x39, 0x5e,
+	0xc4, 0x82, 0x0b, 0xea, 0x66, 0x36, 0x59, 0x25, 0x1d, 0x85, 0x57, 0x6b, 0x7d, 0x13, 0xea, 0x36,
+	0x3b, 0xa3, 0x53, 0x57, 0x54, 0x24, 0x5b, 0x4f, 0xd7, 0x93, 0x6a, 0x6e, 0x4e, 0x75, 0x44, 0x71,
+	0xea, 0x1f, 0xa7, 0x3b, 0xa9, 0xad, 0xa7, 0x1f, 0x16, 0x0c, 0x11, 0x92, 0xf0, 0xee, 0xa7, 0x4a,
+	0xeb, 0xf9, 0x10, 0x63, 0x00, 0x90, 0x20, 0x0b, 0x92, 0xf7, 0x6f, 0xa4, 0x93, 0xf7, 0x6b, 0xc5,
+	0x49, 0xe5, 0xf5, 0x7f, 0xac, 0xc1, 0xe2, 0x81, 0x48, 0x6b, 0xfa, 0x16, 0xb2, 0xc4, 0xe5, 0x67,
+	0x2d, 0x55, 0x7e, 0x5e, 0x85, 0x1a, 0xe5, 0x54, 0xe9, 0x79, 0x12, 0x42, 0xcf, 0x4a, 0x7b, 0x9d,
+	0x00, 0x44, 0x91, 0x92, 0x86, 0xf1, 0x6b, 0x43, 0x42, 0xca, 0x79, 0xab, 0x89, 0xf3, 0xc6, 0x39,
+	0x6c, 0x2d, 0xdd, 0xd0, 0xff, 0xdb, 0x12, 0xb4, 0x0f, 0xfc, 0xd1, 0x96, 0xcb, 0x30, 0xc7, 0x9f,
+	0xf8, 0xc1, 0x9b, 0x9f, 0xff, 0x35, 0xc0, 0x24, 0x6d, 0x98, 0xc4, 0x80, 0x9a, 0xeb, 0x8f, 0xf0,
+	0x4e, 0x54, 0xfd, 0xaa, 0x72, 0xaa, 0x5f, 0x95, 0x2a, 0x9a, 0x56, 0x32, 0x45, 0xd3, 0x27, 0x50,
+	0x17, 0x1b, 0x14, 0x17, 0x64, 0xeb, 0xe9, 0x72, 0xa2, 0xcb, 0x44, 0x53, 0x44, 0x31, 0xe1, 0xbd,
+	0x70, 0x16, 0x30, 0x66, 0x67, 0x9f, 0xef, 0x1c, 0x15, 0xdf, 0x30, 0x01, 0x1b, 0x53, 0x87, 0x77,
+	0x6c, 0xd3, 0xc1, 0xa0, 0x1d, 0xa3, 0x0b, 0x18, 0xc5, 0xc9, 0x10, 0x0f, 0xd5, 0x84, 0x51, 0x9c,
+	0x8f, 0x58, 0x73, 0xcd, 0xb4, 0xe6, 0x3e, 0x82, 0x2e, 0xd7, 0x5a, 0xba, 0xb5, 0x92, 0xda, 0xa5,
+	0x96, 0xde, 0xa5, 0xb9, 0x0d, 0x37, 0x53, 0xcc, 0x49, 0xdc, 0x0e, 0x98, 0xe8, 0x29, 0x67, 0xe3,
+	0x76, 0xd6, 0x20, 0x44, 0x71, 0x99, 0xeb, 0xb0, 0x76, 0xe0, 0x8f, 0x3e, 0xa1, 0x9e, 0x13, 0xf9,
+	0x41, 0xb6, 0xae, 0xf0, 0x63, 0x0d, 0x7a, 0xb3, 0xb4, 0x37, 0x68, 0x29, 0xd5, 0x2c, 0x7e, 0x06,
+	0xae, 0x71, 0x64, 0x59, 0x47, 0x95, 0x8c, 0x69, 0xa9, 0xcb, 0x6f, 0x24, 0xf5, 0x32, 0xe8, 0xbb,
+	0x2c, 0xda, 0xf3, 0x43, 0x71, 0x0b, 0x49, 0x81, 0xff, 0x47, 0x03, 0x78, 0x8e, 0xdd, 0x3b, 0x9e,
+	0x3c, 0xa1, 0x59, 0xc7, 0x08, 0x0d, 0xf9, 0x75, 0x25, 0x0f, 0x04, 0x70, 0xd4, 0x11, 0x62, 0xd0,
+	0xd1, 0x6d, 0x86, 0x1e, 0xa8, 0x9c, 0x4d, 0x40, 0xa8, 0xf2, 0xb3, 0x70, 0x18, 0x5d, 0x4d, 0xd4,
+	0x95, 0x53, 0x3b, 0x0b, 0x8f, 0xaf, 0x26, 0x3c, 0xa4, 0xa7, 0x1e, 0x65, 0xfc, 0x7f, 0xd1, 0xf3,
+	0xa4, 0x92, 0x79, 0x9e, 0x6c, 0x00, 0x77, 0xa5, 0x94, 0x73, 0x55, 0x48, 0x13, 0x31, 0x82, 0x7c,
+	0x1f, 0x16, 0xc4, 0x78, 0xc7, 0xf3, 0x6d, 0xe9, 0x58, 0x15, 0x22, 0xe6, 0xdc, 0xe7, 0x28, 0xe5,
+	0x9f, 0x8a, 0xa3, 0x21, 0x96, 0x40, 0x94, 0x60, 0x30, 0xff, 0xa2, 0x02, 0x4b, 0x19, 0x7d, 0x48,
+	0x23, 0x19, 0xd0, 0xc0, 0x5e, 0x77, 0xea, 0x49, 0x10, 0xc3, 0x18, 0xbe, 0xad, 0xc9, 0x54, 0x76,
+	0x40, 0x65, 0xb7, 0xd7, 0x9a, 0x4c, 0x45, 0xf7, 0x93, 0x17, 0x4f, 0xa8, 0x3d, 0xa4, 0x17, 0x23,
+	0x6e, 0x11, 0x0d, 0x8b, 0x27, 0xd4, 0xee, 0x5f, 0x8c, 0xf8, 0xf5, 0xc0, 0xc6, 0x99, 0xeb, 0xa1,
+	0xc2, 0x05, 0x5a, 0x1c, 0xb3, 0x71, 0xea, 0x7a, 0x78, 0x02, 0x4b, 0xc8, 0x47, 0x2f, 0xa8, 0xe3,
+	0xa2, 0x63, 0x64, 0xf4, 0x73, 0x73, 0xcc, 0xc6, 0x7d, 0x45, 0x51, 0xe9, 0x76, 0x8d, 0x9b, 0x06,
+	0x55, 0x94, 0xfe, 0x8c, 0x24, 0x31, 0x28, 0x91, 0x0c, 0xbc, 0xdf, 0xea, 0xe3, 0xe9, 0x1a, 0x86,
+	0xfe, 0x59, 0x34, 0x74, 0x9d, 0xb1, 0x13, 0x49, 0xbd, 0x75, 0x04, 0x61, 0xe0, 0x9f, 0x45, 0x07,
+	0x88, 0x4e, 0xf1, 0x9e, 0xd3, 0xc0, 0x96, 0xbc, 0x8d, 0x34, 0xef, 0x1e, 0x0d, 0x6c, 0xc1, 0xbb,
+	0x0e, 0x0d, 0xce, 0x39, 0xa6, 0x97, 0xfc, 0x5c, 0x56, 0x48, 0x1d, 0xe1, 0xe7, 0xf4, 0x12, 0x6b,
+	0xa5, 0x9c, 0x44, 0x5d, 0xd7, 0xb7, 0x68, 0xc4, 0xec, 0x1e, 0x88, 0x4d, 0x23, 0xb6, 0xaf, 0x90,
+	0xc8, 0xf6, 0x9a, 0x05, 0x1e, 0x73, 0xe3, 0xc4, 0xa9, 0xc5, 0xd5, 0xbe, 0x28, 0xb0, 0x2a, 0xc7,
+	0x7c, 0x00, 0x1d, 0x0b, 0xcb, 0x7a, 0x43, 0xff, 0xec, 0x2c, 0x64, 0xd1, 0x70, 0x1a, 0xf6, 0x16,
+	0xc4, 0x15, 0xcb, 0xd1, 0x87, 0x1c, 0x7b, 0x12, 0xea, 0x5f, 0x07, 0x5d, 0xf0, 0x61, 0x01, 0xec,
+	0x3c, 0xf0, 0x3d, 0xe7, 0x77, 0x99, 0xdd, 0x5b, 0xe4, 0xe7, 0xed, 0x26, 0xa7, 0x0c, 0x52, 0x04,
+	0xf4, 0x5b, 0xfe, 0x69, 0xca, 0x38, 0xec, 0xb5, 0xf9, 0x74, 0x35, 0x04, 0x9f, 0x87, 0x8f, 0x3f,
+	0x81, 0x56, 0x2a, 0xd6, 0xea, 0x5d, 0x58, 0x38, 0x79, 0xf1, 0xe9, 0x8b, 0xc3, 0x57, 0x2f, 0x86,
+	0xe4, 0xf0, 0x60, 0xa7, 0x7b, 0x43, 0x6f, 0x40, 0xe5, 0xf9, 0xce, 0x71, 0xbf, 0xab, 0xe9, 0x4d,
+	0xa8, 0xee, 0x92, 0xfe, 0xd1, 0x5e, 0xb7, 0xa4, 0xb7, 0xa0, 0x3e, 0x38, 0x3e, 0x24, 0xfd, 0xdd,
+	0x9d, 0x6e, 0x59, 0xaf, 0x43, 0xb9, 0x7f, 0x70, 0xd0, 0xad, 0x3c, 0xfe, 0x16, 0xd4, 0xe4, 0xb7,
+	0x13, 0x3a, 0xb4, 0xd5, 0x34, 0x83, 0xe3, 0xfe, 0xf1, 0xc9, 0xa0, 0x7b, 0x03, 0xc7, 0x90, 0x93,
+	0x17, 0x2f, 0xf6, 0x5f, 0xec, 0x76, 0x35, 0x1d, 0xa0, 0xb6, 0xf3, 0x5b, 0xfb, 0xc7, 0x3b, 0xdb,
+	0xdd, 0xd2, 0xe3, 0xef, 0x03, 0x24, 0x9d, 0x78, 0x7d, 0x0d, 0x96, 0xd4, 0xd0, 0x57, 0xfd, 0xe3,
+	0xad, 0x3d, 0x3e, 0x01, 0x0a, 0xb2, 0x00, 0x0d, 0x8e, 0x10, 0x13, 0xb4, 0xa0, 0xbe, 0xd9, 0xdf,
+	0xfa, 0xf4, 0xf0, 0xd9, 0xb3, 0x6e, 0x09, 0x67, 0x3b, 0xea, 0x9f, 0x0c, 0x76, 0xb6, 0xbb, 0x65,
+	0xbd, 0x0d, 0xb0, 0x45, 0xfa, 0x83, 0xbd, 0xe1, 0xc1, 0xe1, 0xe1, 0x51, 0xb7, 0xf2, 0xf8, 0x1c,
+	0xea, 0xb2, 0x09, 0xab, 0x77, 0xa0, 0xa5, 0xa6, 0x3e, 0x38, 0xdc, 0x15, 0x53, 0x1e, 0x1c, 0xee,
+	0x0e, 0xf7, 0x5f, 0x3c, 0x3b, 0xec, 0x6a, 0x48, 0x46, 0xe8, 0x55, 0x9f, 0x70, 0x21, 0x4b, 0xfa,
+	0x22, 0x34, 0x11, 0xb1, 0x43, 0xc8, 0x21, 0x11, 0x33, 0x23, 0x38, 0x38, 0xde, 0x3e, 0x3c, 0x39,
+	0xee, 0x56, 0x52, 0xf0, 0x0e, 0x21, 0xdd, 0xea, 0xd3, 0xbf, 0xd6, 0x61, 0x81, 0x17, 0x09, 0xa5,
+	0x42, 0xf5, 0x5d, 0x58, 0x48, 0x7f, 0xf2, 0xa5, 0x1b, 0xc9, 0xa7, 0x44, 0xf9, 0xcf, 0x76, 0x8c,
+	0x5b, 0x85, 0x34, 0x79, 0x58, 0xb7, 0xa1, 0x95, 0xfa, 0xbe, 0x4a, 0x5f, 0x8f, 0x79, 0xf3, 0x5f,
+	0x82, 0x19, 0x46, 0x11, 0x49, 0xce, 0xf2, 0x09, 0x2c, 0x66, 0x3e, 0x79, 0xd2, 0x6f, 0x65, 0x2f,
+	0xdb, 0x4c, 0x88, 0x37, 0x6e, 0x17, 0x13, 0xe5, 0x5c, 0xcf, 0xa1, 0x9d, 0xfd, 0xb6, 0x47, 0xbf,
+	0x1d, 0x57, 0x90, 0x0b, 0xbe, 0x4a, 0x32, 0x36, 0xe6, 0x50, 0xe5, 0x74, 0xbb, 0xb0, 0x90, 0xfe,
+	0xf8, 0x25, 0xd6, 0x54, 0xc1, 0x87, 0x32, 0xc6, 0xad, 0x42, 0x5a, 0xb2, 0xc7, 0xcc, 0x57, 0x2e,
+	0xf1, 0x1e, 0x8b, 0xbe, 0x89, 0x31, 0x6e, 0x17, 0x13, 0x93, 0x3d, 0x66, 0x3f, 0x9a, 0x88, 0xf7,
+	0x58, 0xf8, 0x91, 0x85, 0xb1, 0x31, 0x87, 0x2a, 0xa7, 0xfb, 0x55, 0x68, 0xa8, 0x8f, 0x06, 0xf4,
+	0x55, 0xc9, 0x9a, 0xfb, 0x58, 0xc2, 0x58, 0x9b, 0xc1, 0x8b, 0xc1, 0xbf, 0xa0, 0xe9, 0x03, 0xde,
+	0xbf, 0xca, 0x34, 0x1b, 0xf5, 0x3b, 0x73, 0xbb, 0xed, 0x62, 0xba, 0xbb, 0x3f, 0xa3, 0x1b, 0xaf,
+	0x7f, 0x1f, 0x96, 0x0a, 0xda, 0xbd, 0xfa, 0xfd, 0x9f, 0xd9, 0xbc, 0x36, 0xcc, 0xeb, 0x58, 0xe4,
+	0xec, 0xa7, 0xb0, 0x52, 0xd8, 0x24, 0xd5, 0x3f, 0x48, 0xbe, 0x03, 0x9c, 0xdb, 0xd3, 0x35, 0x3e,
+	0xbc, 0x9e, 0x49, 0xae, 0xf1, 0x2b, 0xd0, 0x50, 0x6d, 0xbd, 0x58, 0xab, 0xb9, 0x66, 0xa2, 0xb1,
+	0x36, 0x83, 0x4f, 0x06, 0x0f, 0xf2, 0x83, 0x07, 0x73, 0x06, 0xcf, 0xf4, 0xc8, 0x76, 0x61, 0x21,
+	0xdd, 0xa9, 0x8a, 0x7d, 0xb6, 0xa0, 0x23, 0x66, 0xdc, 0x2a, 0xa4, 0x25, 0x7e, 0x96, 0xed, 0x42,
+	0xc5, 0x7e, 0x56, 0xd8, 0xf1, 0x32, 0x36, 0xe6, 0x50, 0xe5, 0x74, 0x47, 0xd0, 0xc9, 0xf5, 0x7c,
+	0xf4, 0x8d, 0x7c, 0x77, 0x27, 0xeb, 0xb8, 0x77, 0xe6, 0x91, 0x13, 0x01, 0xb3, 0xc5, 0xd3, 0x58,
+	0xc0, 0xc2, 0xaa, 0xb3, 0xb1, 0x31, 0x87, 0x3a, 0xd7, 0x2d, 0x44, 0x95, 0x71, 0x9e, 0x5b, 0x64,
+	0xea, 0xa8, 0xc6, 0x87, 0xd7, 0x33, 0x25, 0x8e, 0x5d, 0x50, 0x25, 0x8c, 0x1d, 0x7b, 0x7e, 0x65,
+	0xd2, 0x30, 0xaf, 0x63, 0x49, 0xe2, 0x71, 0xea, 0x4d, 0x15, 0xc7, 0xe3, 0xd9, 0x77, 0xa7, 0x61,
+	0x14, 0x91, 0x12, 0xb5, 0x66, 0x8b, 0x6f, 0xb1, 0x5a, 0x0b, 0x6b, 0x91, 0xc6, 0xc6, 0x1c, 0xaa,
+	0x9c, 0xee, 0xb7, 0x41, 0x9f, 0x2d, 0x39, 0xe8, 0xf7, 0x54, 0x71, 0x66, 0x5e, 0x01, 0xc6, 0xb8,
+	0x7f, 0x0d, 0x87, 0x9c, 0xfa, 0xd7, 0xa0, 0x19, 0xe7, 0x13, 0xba, 0x3a, 0x10, 0xf9, 0x74, 0xc4,
+	0xe8, 0xcd, 0x12, 0xe4, 0xf8, 0x01, 0x74, 0xf3, 0xd9, 0x42, 0x1c, 0xbb, 0xe6, 0xa4, 0x18, 0xc6,
+	0xdd, 0xb9, 0xf4, 0xc4, 0xcf, 0x73, 0xd5, 0xb3, 0xd8, 0xcf, 0x8b, 0x2b, 0x75, 0xc6, 0x9d, 0x79,
+	0xe4, 0xc4, 0x20, 0xd9, 0x9a, 0x57, 0x6c, 0x90, 0xc2, 0x92, 0x5a, 0x6c, 0x90, 0x39, 0x85, 0xb2,
+	0x5d, 0x58, 0x48, 0x77, 0x25, 0xe3, 0x00, 0x51, 0xd0, 0x4c, 0x35, 0x6e, 0x15, 0xd2, 0x12, 0xf5,
+	0xc7, 0x0d, 0xc8, 0x58, 0xfd, 0xf9, 0x36, 0xa5, 0xd1, 0x9b, 0x25, 0x24, 0xee, 0x9a, 0xea, 0x4a,
+	0xc6, 0xee, 0x3a, 0xdb, 0xbf, 0x34, 0x8c, 0x22, 0x52, 0xd6, 0x5d, 0x53, 0x6d, 0xbd, 0xdb, 0x73,
+	0x7a, 0x55, 0xb3, 0xee, 0x3a, 0xdb, 0xc9, 0xda, 0xec, 0xfe, 0xe3, 0xe7, 0x77, 0xb4, 0x7f, 0xf9,
+	0xfc, 0x8e, 0xf6, 0xef, 0x9f, 0xdf, 0xd1, 0x7e, 0xfc, 0x9f, 0x77, 0x6e, 0x9c, 0xd6, 0x38, 0xff,
+	0x37, 0xff, 0x6f, 0x00, 0xa6, 0x1b, 0x6e, 0x7a, 0x83, 0x30, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AgentServiceClient is the client API for AgentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AgentServiceClient interface {
+	StartService(ctx context.Context, in *StartServiceRequest, opts ...grpc.CallOption) (*StartServiceResponse, error)
+	StopService(ctx context.Context, in *StopServiceRequest, opts ...grpc.CallOption) (*StopServiceResponse, error)
+	ServiceStatus(ctx context.Context, in *ServiceStatusRequest, opts ...grpc.CallOption) (*ServiceStatusResponse, error)
+	RestartService(ctx context.Context, in *RestartServiceRequest, opts ...grpc.CallOption) (*RestartServiceResponse, error)
+	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
+	BalanceLeader(ctx context.Context, in *BalanceLeaderRequest, opts ...grpc.CallOption) (*BalanceLeaderResponse, error)
+	WatchdogStatus(ctx context.Context, in *WatchdogStatusRequest, opts ...grpc.CallOption) (*WatchdogStatusResponse, error)
+	TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (AgentService_TailLogsClient, error)
+	GetServiceConfig(ctx context.Context, in *GetServiceConfigRequest, opts ...grpc.CallOption) (*GetServiceConfigResponse, error)
+	UpdateServiceConfig(ctx context.Context, in *UpdateServiceConfigRequest, opts ...grpc.CallOption) (*UpdateServiceConfigResponse, error)
+	RollbackServiceConfig(ctx context.Context, in *RollbackServiceConfigRequest, opts ...grpc.CallOption) (*RollbackServiceConfigResponse, error)
+	GetFlags(ctx context.Context, in *GetFlagsRequest, opts ...grpc.CallOption) (*GetFlagsResponse, error)
+	SetFlags(ctx context.Context, in *SetFlagsRequest, opts ...grpc.CallOption) (*SetFlagsResponse, error)
+	// block the writes of storaged or the new connections of graphd until allowed, despite the name,
+	// the reads of storaged and the existing sessions of graphd are not blocked
+	BanReadWrite(ctx context.Context, in *BanReadWriteRequest, opts ...grpc.CallOption) (*BanReadWriteResponse, error)
+	// lift the ban, nothing changed if not banned
+	AllowReadWrite(ctx context.Context, in *AllowReadWriteRequest, opts ...grpc.CallOption) (*AllowReadWriteResponse, error)
+	ReadWriteStatus(ctx context.Context, in *ReadWriteStatusRequest, opts ...grpc.CallOption) (*ReadWriteStatusResponse, error)
+	UpgradeService(ctx context.Context, in *UpgradeServiceRequest, opts ...grpc.CallOption) (*UpgradeServiceResponse, error)
+	RollbackServiceBinary(ctx context.Context, in *RollbackServiceBinaryRequest, opts ...grpc.CallOption) (*RollbackServiceBinaryResponse, error)
+	ListServiceVersions(ctx context.Context, in *ListServiceVersionsRequest, opts ...grpc.CallOption) (*ListServiceVersionsResponse, error)
+	GetHostInfo(ctx context.Context, in *GetHostInfoRequest, opts ...grpc.CallOption) (*GetHostInfoResponse, error)
+	GetServiceDirs(ctx context.Context, in *GetServiceDirsRequest, opts ...grpc.CallOption) (*GetServiceDirsResponse, error)
+	CollectDiagnostics(ctx context.Context, in *CollectDiagnosticsRequest, opts ...grpc.CallOption) (*CollectDiagnosticsResponse, error)
+	// clean the logs of local services by the retention policies now
+	CleanLogs(ctx context.Context, in *CleanLogsRequest, opts ...grpc.CallOption) (*CleanLogsResponse, error)
+	LogJanitorStatus(ctx context.Context, in *LogJanitorStatusRequest, opts ...grpc.CallOption) (*LogJanitorStatusResponse, error)
+	ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error)
+	DropCheckpoint(ctx context.Context, in *DropCheckpointRequest, opts ...grpc.CallOption) (*DropCheckpointResponse, error)
+	DataPlayBack(ctx context.Context, in *DataPlayBackRequest, opts ...grpc.CallOption) (*DataPlayBackResponse, error)
+	StopAgent(ctx context.Context, in *StopAgentRequest, opts ...grpc.CallOption) (*StopAgentResponse, error)
+	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	GetSpaceUsages(ctx context.Context, in *GetSpaceUsagesRequest, opts ...grpc.CallOption) (*GetSpaceUsagesResponse, error)
+}
+
+type agentServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAgentServiceClient(cc *grpc.ClientConn) AgentServiceClient {
+	return &agentServiceClient{cc}
+}
+
+func (c *agentServiceClient) StartService(ctx context.Context, in *StartServiceRequest, opts ...grpc.CallOption) (*StartServiceResponse, error) {
+	out := new(StartServiceResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/StartService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) StopService(ctx context.Context, in *StopServiceRequest, opts ...grpc.CallOption) (*StopServiceResponse, error) {
+	out := new(StopServiceResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/StopService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ServiceStatus(ctx context.Context, in *ServiceStatusRequest, opts ...grpc.CallOption) (*ServiceStatusResponse, error) {
+	out := new(ServiceStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/ServiceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) RestartService(ctx context.Context, in *RestartServiceRequest, opts ...grpc.CallOption) (*RestartServiceResponse, error) {
+	out := new(RestartServiceResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/RestartService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error) {
+	out := new(ListServicesResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/ListServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) BalanceLeader(ctx context.Context, in *BalanceLeaderRequest, opts ...grpc.CallOption) (*BalanceLeaderResponse, error) {
+	out := new(BalanceLeaderResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/BalanceLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) WatchdogStatus(ctx context.Context, in *WatchdogStatusRequest, opts ...grpc.CallOption) (*WatchdogStatusResponse, error) {
+	out := new(WatchdogStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/WatchdogStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (AgentService_TailLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AgentService_serviceDesc.Streams[0], "/proto.AgentService/TailLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentServiceTailLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentService_TailLogsClient interface {
+	Recv() (*TailLogsResponse, error)
+	grpc.ClientStream
+}
+
+type agentServiceTailLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentServiceTailLogsClient) Recv() (*TailLogsResponse, error) {
+	m := new(TailLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentServiceClient) GetServiceConfig(ctx context.Context, in *GetServiceConfigRequest, opts ...grpc.CallOption) (*GetServiceConfigResponse, error) {
+	out := new(GetServiceConfigResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/GetServiceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) UpdateServiceConfig(ctx context.Context, in *UpdateServiceConfigRequest, opts ...grpc.CallOption) (*UpdateServiceConfigResponse, error) {
+	out := new(UpdateServiceConfigResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/UpdateServiceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) RollbackServiceConfig(ctx context.Context, in *RollbackServiceConfigRequest, opts ...grpc.CallOption) (*RollbackServiceConfigResponse, error) {
+	out := new(RollbackServiceConfigResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/RollbackServiceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) GetFlags(ctx context.Context, in *GetFlagsRequest, opts ...grpc.CallOption) (*GetFlagsResponse, error) {
+	out := new(GetFlagsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/GetFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) SetFlags(ctx context.Context, in *SetFlagsRequest, opts ...grpc.CallOption) (*SetFlagsResponse, error) {
+	out := new(SetFlagsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/SetFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) BanReadWrite(ctx context.Context, in *BanReadWriteRequest, opts ...grpc.CallOption) (*BanReadWriteResponse, error) {
+	out := new(BanReadWriteResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/BanReadWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) AllowReadWrite(ctx context.Context, in *AllowReadWriteRequest, opts ...grpc.CallOption) (*AllowReadWriteResponse, error) {
+	out := new(AllowReadWriteResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/AllowReadWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ReadWriteStatus(ctx context.Context, in *ReadWriteStatusRequest, opts ...grpc.CallOption) (*ReadWriteStatusResponse, error) {
+	out := new(ReadWriteStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/ReadWriteStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) UpgradeService(ctx context.Context, in *UpgradeServiceRequest, opts ...grpc.CallOption) (*UpgradeServiceResponse, error) {
+	out := new(UpgradeServiceResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/UpgradeService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) RollbackServiceBinary(ctx context.Context, in *RollbackServiceBinaryRequest, opts ...grpc.CallOption) (*RollbackServiceBinaryResponse, error) {
+	out := new(RollbackServiceBinaryResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/RollbackServiceBinary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ListServiceVersions(ctx context.Context, in *ListServiceVersionsRequest, opts ...grpc.CallOption) (*ListServiceVersionsResponse, error) {
+	out := new(ListServiceVersionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/ListServiceVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) GetHostInfo(ctx context.Context, in *GetHostInfoRequest, opts ...grpc.CallOption) (*GetHostInfoResponse, error) {
+	out := new(GetHostInfoResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/GetHostInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) GetServiceDirs(ctx context.Context, in *GetServiceDirsRequest, opts ...grpc.CallOption) (*GetServiceDirsResponse, error) {
+	out := new(GetServiceDirsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/GetServiceDirs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) CollectDiagnostics(ctx context.Context, in *CollectDiagnosticsRequest, opts ...grpc.CallOption) (*CollectDiagnosticsResponse, error) {
+	out := new(CollectDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/CollectDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) CleanLogs(ctx context.Context, in *CleanLogsRequest, opts ...grpc.CallOption) (*CleanLogsResponse, error) {
+	out := new(CleanLogsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/CleanLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) LogJanitorStatus(ctx context.Context, in *LogJanitorStatusRequest, opts ...grpc.CallOption) (*LogJanitorStatusResponse, error) {
+	out := new(LogJanitorStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/LogJanitorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...grpc.CallOption) (*ListCheckpointsResponse, error) {
+	out := new(ListCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/ListCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) DropCheckpoint(ctx context.Context, in *DropCheckpointRequest, opts ...grpc.CallOption) (*DropCheckpointResponse, error) {
+	out := new(DropCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/DropCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) DataPlayBack(ctx context.Context, in *DataPlayBackRequest, opts ...grpc.CallOption) (*DataPlayBackResponse, error) {
+	out := new(DataPlayBackResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/DataPlayBack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) StopAgent(ctx context.Context, in *StopAgentRequest, opts ...grpc.CallOption) (*StopAgentResponse, error) {
+	out := new(StopAgentResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/StopAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/HealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) GetSpaceUsages(ctx context.Context, in *GetSpaceUsagesRequest, opts ...grpc.CallOption) (*GetSpaceUsagesResponse, error) {
+	out := new(GetSpaceUsagesResponse)
+	err := c.cc.Invoke(ctx, "/proto.AgentService/GetSpaceUsages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentServiceServer is the server API for AgentService service.
+type AgentServiceServer interface {
+	StartService(context.Context, *StartServiceRequest) (*StartServiceResponse, error)
+	StopService(context.Context, *StopServiceRequest) (*StopServiceResponse, error)
+	ServiceStatus(context.Context, *ServiceStatusRequest) (*ServiceStatusResponse, error)
+	RestartService(context.Context, *RestartServiceRequest) (*RestartServiceResponse, error)
+	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
+	BalanceLeader(context.Context, *BalanceLeaderRequest) (*BalanceLeaderResponse, error)
+	WatchdogStatus(context.Context, *WatchdogStatusRequest) (*WatchdogStatusResponse, error)
+	TailLogs(*TailLogsRequest, AgentService_TailLogsServer) error
+	GetServiceConfig(context.Context, *GetServiceConfigRequest) (*GetServiceConfigResponse, error)
+	UpdateServiceConfig(context.Context, *UpdateServiceConfigRequest) (*UpdateServiceConfigResponse, error)
+	RollbackServiceConfig(context.Context, *RollbackServiceConfigRequest) (*RollbackServiceConfigResponse, error)
+	GetFlags(context.Context, *GetFlagsRequest) (*GetFlagsResponse, error)
+	SetFlags(context.Context, *SetFlagsRequest) (*SetFlagsResponse, error)
+	// block the writes of storaged or the new connections of graphd until allowed, despite the name,
+	// the reads of storaged and the existing sessions of graphd are not blocked
+	BanReadWrite(context.Context, *BanReadWriteRequest) (*BanReadWriteResponse, error)
+	// lift the ban, nothing changed if not banned
+	AllowReadWrite(context.Context, *AllowReadWriteRequest) (*AllowReadWriteResponse, error)
+	ReadWriteStatus(context.Context, *ReadWriteStatusRequest) (*ReadWriteStatusResponse, error)
+	UpgradeService(context.Context, *UpgradeServiceRequest) (*UpgradeServiceResponse, error)
+	RollbackServiceBinary(context.Context, *RollbackServiceBinaryRequest) (*RollbackServiceBinaryResponse, error)
+	ListServiceVersions(context.Context, *ListServiceVersionsRequest) (*ListServiceVersionsResponse, error)
+	GetHostInfo(context.Context, *GetHostInfoRequest) (*GetHostInfoResponse, error)
+	GetServiceDirs(context.Context, *GetServiceDirsRequest) (*GetServiceDirsResponse, error)
+	CollectDiagnostics(context.Context, *CollectDiagnosticsRequest) (*CollectDiagnosticsResponse, error)
+	// clean the logs of local services by the retention policies now
+	CleanLogs(context.Context, *CleanLogsRequest) (*CleanLogsResponse, error)
+	LogJanitorStatus(context.Context, *LogJanitorStatusRequest) (*LogJanitorStatusResponse, error)
+	ListCheckpoints(context.Context, *ListCheckpointsRequest) (*ListCheckpointsResponse, error)
+	DropCheckpoint(context.Context, *DropCheckpointRequest) (*DropCheckpointResponse, error)
+	DataPlayBack(context.Context, *DataPlayBackRequest) (*DataPlayBackResponse, error)
+	StopAgent(context.Context, *StopAgentRequest) (*StopAgentResponse, error)
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	GetSpaceUsages(context.Context, *GetSpaceUsagesRequest) (*GetSpaceUsagesResponse, error)
+}
+
+// UnimplementedAgentServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAgentServiceServer struct {
+}
+
+func (*UnimplementedAgentServiceServer) StartService(ctx context.Context, req *StartServiceRequest) (*StartServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartService not implemented")
+}
+func (*UnimplementedAgentServiceServer) StopService(ctx context.Context, req *StopServiceRequest) (*StopServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopService not implemented")
+}
+func (*UnimplementedAgentServiceServer) ServiceStatus(ctx context.Context, req *ServiceStatusRequest) (*ServiceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceStatus not implemented")
+}
+func (*UnimplementedAgentServiceServer) RestartService(ctx context.Context, req *RestartServiceRequest) (*RestartServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartService not implemented")
+}
+func (*UnimplementedAgentServiceServer) ListServices(ctx context.Context, req *ListServicesRequest) (*ListServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
+}
+func (*UnimplementedAgentServiceServer) BalanceLeader(ctx context.Context, req *BalanceLeaderRequest) (*BalanceLeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceLeader not implemented")
+}
+func (*UnimplementedAgentServiceServer) WatchdogStatus(ctx context.Context, req *WatchdogStatusRequest) (*WatchdogStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchdogStatus not implemented")
+}
+func (*UnimplementedAgentServiceServer) TailLogs(req *TailLogsRequest, srv AgentService_TailLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailLogs not implemented")
+}
+func (*UnimplementedAgentServiceServer) GetServiceConfig(ctx context.Context, req *GetServiceConfigRequest) (*GetServiceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceConfig not implemented")
+}
+func (*UnimplementedAgentServiceServer) UpdateServiceConfig(ctx context.Context, req *UpdateServiceConfigRequest) (*UpdateServiceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceConfig not implemented")
+}
+func (*UnimplementedAgentServiceServer) RollbackServiceConfig(ctx context.Context, req *RollbackServiceConfigRequest) (*RollbackServiceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackServiceConfig not implemented")
+}
+func (*UnimplementedAgentServiceServer) GetFlags(ctx context.Context, req *GetFlagsRequest) (*GetFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlags not implemented")
+}
+func (*UnimplementedAgentServiceServer) SetFlags(ctx context.Context, req *SetFlagsRequest) (*SetFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFlags not implemented")
+}
+func (*UnimplementedAgentServiceServer) BanReadWrite(ctx context.Context, req *BanReadWriteRequest) (*BanReadWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanReadWrite not implemented")
+}
+func (*UnimplementedAgentServiceServer) AllowReadWrite(ctx context.Context, req *AllowReadWriteRequest) (*AllowReadWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowReadWrite not implemented")
+}
+func (*UnimplementedAgentServiceServer) ReadWriteStatus(ctx context.Context, req *ReadWriteStatusRequest) (*ReadWriteStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadWriteStatus not implemented")
+}
+func (*UnimplementedAgentServiceServer) UpgradeService(ctx context.Context, req *UpgradeServiceRequest) (*UpgradeServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeService not implemented")
+}
+func (*UnimplementedAgentServiceServer) RollbackServiceBinary(ctx context.Context, req *RollbackServiceBinaryRequest) (*RollbackServiceBinaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackServiceBinary not implemented")
+}
+func (*UnimplementedAgentServiceServer) ListServiceVersions(ctx context.Context, req *ListServiceVersionsRequest) (*ListServiceVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceVersions not implemented")
+}
+func (*UnimplementedAgentServiceServer) GetHostInfo(ctx context.Context, req *GetHostInfoRequest) (*GetHostInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostInfo not implemented")
+}
+func (*UnimplementedAgentServiceServer) GetServiceDirs(ctx context.Context, req *GetServiceDirsRequest) (*GetServiceDirsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceDirs not implemented")
+}
+func (*UnimplementedAgentServiceServer) CollectDiagnostics(ctx context.Context, req *CollectDiagnosticsRequest) (*CollectDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectDiagnostics not implemented")
+}
+func (*UnimplementedAgentServiceServer) CleanLogs(ctx context.Context, req *CleanLogsRequest) (*CleanLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanLogs not implemented")
+}
+func (*UnimplementedAgentServiceServer) LogJanitorStatus(ctx context.Context, req *LogJanitorStatusRequest) (*LogJanitorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogJanitorStatus not implemented")
+}
+func (*UnimplementedAgentServiceServer) ListCheckpoints(ctx context.Context, req *ListCheckpointsRequest) (*ListCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCheckpoints not implemented")
+}
+func (*UnimplementedAgentServiceServer) DropCheckpoint(ctx context.Context, req *DropCheckpointRequest) (*DropCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropCheckpoint not implemented")
+}
+func (*UnimplementedAgentServiceServer) DataPlayBack(ctx context.Context, req *DataPlayBackRequest) (*DataPlayBackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataPlayBack not implemented")
+}
+func (*UnimplementedAgentServiceServer) StopAgent(ctx context.Context, req *StopAgentRequest) (*StopAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopAgent not implemented")
+}
+func (*UnimplementedAgentServiceServer) HealthCheck(ctx context.Context, req *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (*UnimplementedAgentServiceServer) GetSpaceUsages(ctx context.Context, req *GetSpaceUsagesRequest) (*GetSpaceUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpaceUsages not implemented")
+}
+
+func RegisterAgentServiceServer(s *grpc.Server, srv AgentServiceServer) {
+	s.RegisterService(&_AgentService_serviceDesc, srv)
+}
+
+func _AgentService_StartService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).StartService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/StartService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).StartService(ctx, req.(*StartServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_StopService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).StopService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/StopService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).StopService(ctx, req.(*StopServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ServiceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ServiceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/ServiceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ServiceStatus(ctx, req.(*ServiceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_RestartService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).RestartService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/RestartService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).RestartService(ctx, req.(*RestartServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/ListServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListServices(ctx, req.(*ListServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_BalanceLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceLeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).BalanceLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/BalanceLeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).BalanceLeader(ctx, req.(*BalanceLeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_WatchdogStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchdogStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).WatchdogStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/WatchdogStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).WatchdogStatus(ctx, req.(*WatchdogStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_TailLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServiceServer).TailLogs(m, &agentServiceTailLogsServer{stream})
+}
+
+type AgentService_TailLogsServer interface {
+	Send(*TailLogsResponse) error
+	grpc.ServerStream
+}
+
+type agentServiceTailLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentServiceTailLogsServer) Send(m *TailLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AgentService_GetServiceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetServiceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/GetServiceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetServiceConfig(ctx, req.(*GetServiceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_UpdateServiceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).UpdateServiceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/UpdateServiceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).UpdateServiceConfig(ctx, req.(*UpdateServiceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_RollbackServiceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackServiceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).RollbackServiceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/RollbackServiceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).RollbackServiceConfig(ctx, req.(*RollbackServiceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_GetFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/GetFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetFlags(ctx, req.(*GetFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_SetFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).SetFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/SetFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).SetFlags(ctx, req.(*SetFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_BanReadWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanReadWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).BanReadWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/BanReadWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).BanReadWrite(ctx, req.(*BanReadWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_AllowReadWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllowReadWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).AllowReadWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/AllowReadWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).AllowReadWrite(ctx, req.(*AllowReadWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ReadWriteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadWriteStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ReadWriteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/ReadWriteStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ReadWriteStatus(ctx, req.(*ReadWriteStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_UpgradeService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).UpgradeService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/UpgradeService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).UpgradeService(ctx, req.(*UpgradeServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_RollbackServiceBinary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackServiceBinaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).RollbackServiceBinary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/RollbackServiceBinary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).RollbackServiceBinary(ctx, req.(*RollbackServiceBinaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ListServiceVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListServiceVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/ListServiceVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListServiceVersions(ctx, req.(*ListServiceVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_GetHostInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetHostInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/GetHostInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetHostInfo(ctx, req.(*GetHostInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_GetServiceDirs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceDirsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetServiceDirs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/GetServiceDirs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetServiceDirs(ctx, req.(*GetServiceDirsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_CollectDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CollectDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/CollectDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CollectDiagnostics(ctx, req.(*CollectDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_CleanLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CleanLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/CleanLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CleanLogs(ctx, req.(*CleanLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_LogJanitorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogJanitorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).LogJanitorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/LogJanitorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).LogJanitorStatus(ctx, req.(*LogJanitorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ListCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/ListCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListCheckpoints(ctx, req.(*ListCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_DropCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).DropCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/DropCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).DropCheckpoint(ctx, req.(*DropCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_DataPlayBack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataPlayBackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).DataPlayBack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/DataPlayBack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).DataPlayBack(ctx, req.(*DataPlayBackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_StopAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).StopAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/StopAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).StopAgent(ctx, req.(*StopAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/HealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_GetSpaceUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpaceUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetSpaceUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AgentService/GetSpaceUsages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetSpaceUsages(ctx, req.(*GetSpaceUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AgentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.AgentService",
+	HandlerType: (*AgentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartService",
+			Handler:    _AgentService_StartService_Handler,
+		},
+		{
+			MethodName: "StopService",
+			Handler:    _AgentService_StopService_Handler,
+		},
+		{
+			MethodName: "ServiceStatus",
+			Handler:    _AgentService_ServiceStatus_Handler,
+		},
+		{
+			MethodName: "RestartService",
+			Handler:    _AgentService_RestartService_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _AgentService_ListServices_Handler,
+		},
+		{
+			MethodName: "BalanceLeader",
+			Handler:    _AgentService_BalanceLeader_Handler,
+		},
+		{
+			MethodName: "WatchdogStatus",
+			Handler:    _AgentService_WatchdogStatus_Handler,
+		},
+		{
+			MethodName: "GetServiceConfig",
+			Handler:    _AgentService_GetServiceConfig_Handler,
+		},
+		{
+			MethodName: "UpdateServiceConfig",
+			Handler:    _AgentService_UpdateServiceConfig_Handler,
+		},
+		{
+			MethodName: "RollbackServiceConfig",
+			Handler:    _AgentService_RollbackServiceConfig_Handler,
+		},
+		{
+			MethodName: "GetFlags",
+			Handler:    _AgentService_GetFlags_Handler,
+		},
+		{
+			MethodName: "SetFlags",
+			Handler:    _AgentService_SetFlags_Handler,
+		},
+		{
+			MethodName: "BanReadWrite",
+			Handler:    _AgentService_BanReadWrite_Handler,
+		},
+		{
+			MethodName: "AllowReadWrite",
+			Handler:    _AgentService_AllowReadWrite_Handler,
+		},
+		{
+			MethodName: "ReadWriteStatus",
+			Handler:    _AgentService_ReadWriteStatus_Handler,
+		},
+		{
+			MethodName: "UpgradeService",
+			Handler:    _AgentService_UpgradeService_Handler,
+		},
+		{
+			MethodName: "RollbackServiceBinary",
+			Handler:    _AgentService_RollbackServiceBinary_Handler,
+		},
+		{
+			MethodName: "ListServiceVersions",
+			Handler:    _AgentService_ListServiceVersions_Handler,
+		},
+		{
+			MethodName: "GetHostInfo",
+			Handler:    _AgentService_GetHostInfo_Handler,
+		},
+		{
+			MethodName: "GetServiceDirs",
+			Handler:    _AgentService_GetServiceDirs_Handler,
+		},
+		{
+			MethodName: "CollectDiagnostics",
+			Handler:    _AgentService_CollectDiagnostics_Handler,
+		},
+		{
+			MethodName: "CleanLogs",
+			Handler:    _AgentService_CleanLogs_Handler,
+		},
+		{
+			MethodName: "LogJanitorStatus",
+			Handler:    _AgentService_LogJanitorStatus_Handler,
+		},
+		{
+			MethodName: "ListCheckpoints",
+			Handler:    _AgentService_ListCheckpoints_Handler,
+		},
+		{
+			MethodName: "DropCheckpoint",
+			Handler:    _AgentService_DropCheckpoint_Handler,
+		},
+		{
+			MethodName: "DataPlayBack",
+			Handler:    _AgentService_DataPlayBack_Handler,
+		},
+		{
+			MethodName: "StopAgent",
+			Handler:    _AgentService_StopAgent_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _AgentService_HealthCheck_Handler,
+		},
+		{
+			MethodName: "GetSpaceUsages",
+			Handler:    _AgentService_GetSpaceUsages_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailLogs",
+			Handler:       _AgentService_TailLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "agent.proto",
+}
+
+func (m *RoleResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Roles) > 0 {
+		dAtA2 := make([]byte, len(m.Roles)*10)
+		var j1 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAgent(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ReadyTimeoutSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ReadyTimeoutSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Roles) > 0 {
+		dAtA4 := make([]byte, len(m.Roles)*10)
+		var j3 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAgent(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ListeningPorts) > 0 {
+		dAtA6 := make([]byte, len(m.ListeningPorts)*10)
+		var j5 int
+		for _, num1 := range m.ListeningPorts {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintAgent(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UptimeSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.UptimeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pid != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Pid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestartServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ReadyTimeoutSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ReadyTimeoutSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestartServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Conf) > 0 {
+		i -= len(m.Conf)
+		copy(dAtA[i:], m.Conf)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Conf)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TotalParts != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TotalParts))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LeaderParts != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.LeaderParts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.HostStatus) > 0 {
+		i -= len(m.HostStatus)
+		copy(dAtA[i:], m.HostStatus)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.HostStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DataDirs) > 0 {
+		for iNdEx := len(m.DataDirs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataDirs[iNdEx])
+			copy(dAtA[i:], m.DataDirs[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.DataDirs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RootDir) > 0 {
+		i -= len(m.RootDir)
+		copy(dAtA[i:], m.RootDir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.RootDir)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServicesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServicesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServicesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceLeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceLeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceLeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceLeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CoreFiles) > 0 {
+		for iNdEx := len(m.CoreFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoreFiles[iNdEx])
+			copy(dAtA[i:], m.CoreFiles[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.CoreFiles[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LogTail) > 0 {
+		for iNdEx := len(m.LogTail) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LogTail[iNdEx])
+			copy(dAtA[i:], m.LogTail[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.LogTail[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LogFile) > 0 {
+		i -= len(m.LogFile)
+		copy(dAtA[i:], m.LogFile)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.LogFile)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Time != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchedService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchedService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchedService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Conf) > 0 {
+		i -= len(m.Conf)
+		copy(dAtA[i:], m.Conf)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Conf)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Crashes) > 0 {
+		for iNdEx := len(m.Crashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Crashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextRestartTime != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.NextRestartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastRestartTime != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.LastRestartTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RestartCount != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.RestartCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.State != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchdogStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchdogStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchdogStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchdogStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchdogStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchdogStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TailLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TailLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TailLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Since != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Grep) > 0 {
+		i -= len(m.Grep)
+		copy(dAtA[i:], m.Grep)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Grep)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Follow {
+		i--
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Lines != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Lines))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Kinds) > 0 {
+		dAtA8 := make([]byte, len(m.Kinds)*10)
+		var j7 int
+		for _, num := range m.Kinds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintAgent(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TailLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TailLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TailLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Lines) > 0 {
+		for iNdEx := len(m.Lines) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Lines[iNdEx])
+			copy(dAtA[i:], m.Lines[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Lines[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetServiceConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetServiceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetServiceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Version != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetServiceConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetServiceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetServiceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Versions) > 0 {
+		dAtA10 := make([]byte, len(m.Versions)*10)
+		var j9 int
+		for _, num1 := range m.Versions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintAgent(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Flags) > 0 {
+		for k := range m.Flags {
+			v := m.Flags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAgent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAgent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateServiceConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateServiceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateServiceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Set) > 0 {
+		for k := range m.Set {
+			v := m.Set[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAgent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAgent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateServiceConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateServiceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateServiceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RestartFlags) > 0 {
+		for iNdEx := len(m.RestartFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RestartFlags[iNdEx])
+			copy(dAtA[i:], m.RestartFlags[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.RestartFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RestartRequired {
+		i--
+		if m.RestartRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BackupVersion != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.BackupVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackServiceConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackServiceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackServiceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Version != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackServiceConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackServiceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackServiceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RestartFlags) > 0 {
+		for iNdEx := len(m.RestartFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RestartFlags[iNdEx])
+			copy(dAtA[i:], m.RestartFlags[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.RestartFlags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RestartRequired {
+		i--
+		if m.RestartRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BackupVersion != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.BackupVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlagValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlagValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlagValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlagValue_BoolValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlagValue_BoolValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.BoolValue {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *FlagValue_IntValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlagValue_IntValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintAgent(dAtA, i, uint64(m.IntValue))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *FlagValue_DoubleValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlagValue_DoubleValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DoubleValue))))
+	i--
+	dAtA[i] = 0x21
+	return len(dAtA) - i, nil
+}
+func (m *FlagValue_StringValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlagValue_StringValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.StringValue)
+	copy(dAtA[i:], m.StringValue)
+	i = encodeVarintAgent(dAtA, i, uint64(len(m.StringValue)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *GetFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Flags) > 0 {
+		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Persist {
+		i--
+		if m.Persist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Flags) > 0 {
+		for k := range m.Flags {
+			v := m.Flags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAgent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAgent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BackupVersion != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.BackupVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BanReadWriteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BanReadWriteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BanReadWriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BanReadWriteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BanReadWriteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BanReadWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowReadWriteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowReadWriteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowReadWriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowReadWriteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowReadWriteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowReadWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadWriteBan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadWriteBan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadWriteBan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Blocked) > 0 {
+		i -= len(m.Blocked)
+		copy(dAtA[i:], m.Blocked)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Blocked)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Since != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadWriteStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadWriteStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadWriteStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadWriteStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadWriteStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadWriteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Bans) > 0 {
+		for iNdEx := len(m.Bans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataPlayBackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataPlayBackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataPlayBackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Async {
+		i--
+		if m.Async {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MetaAddr) > 0 {
+		i -= len(m.MetaAddr)
+		copy(dAtA[i:], m.MetaAddr)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.MetaAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DataPath) > 0 {
+		i -= len(m.DataPath)
+		copy(dAtA[i:], m.DataPath)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.DataPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataPlayBackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataPlayBackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataPlayBackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LogFile) > 0 {
+		i -= len(m.LogFile)
+		copy(dAtA[i:], m.LogFile)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.LogFile)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopAgentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopAgentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopAgentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HealthCheckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthCheckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthCheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HealthCheckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthCheckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthCheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSpaceUsagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSpaceUsagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSpaceUsagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxAgeSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxAgeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DataPaths) > 0 {
+		for iNdEx := len(m.DataPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataPaths[iNdEx])
+			copy(dAtA[i:], m.DataPaths[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.DataPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DataPath) > 0 {
+		i -= len(m.DataPath)
+		copy(dAtA[i:], m.DataPath)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.DataPath)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSpaceUsagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSpaceUsagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSpaceUsagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ScanTime != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ScanTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SpaceUsages) > 0 {
+		for iNdEx := len(m.SpaceUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpaceUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSpaceUsagesResponse_PartUsageItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSpaceUsagesResponse_PartUsageItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSpaceUsagesResponse_PartUsageItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WalBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.WalBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Parts) > 0 {
+		for iNdEx := len(m.Parts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.OtherBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.OtherBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CheckpointBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.CheckpointBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WalBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.WalBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DataBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.DataBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Usage != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.KeepVersions != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.KeepVersions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReadyTimeoutSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ReadyTimeoutSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Source != nil {
+		{
+			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Versions[iNdEx])
+			copy(dAtA[i:], m.Versions[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Versions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PreviousVersion) > 0 {
+		i -= len(m.PreviousVersion)
+		copy(dAtA[i:], m.PreviousVersion)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.PreviousVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackServiceBinaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackServiceBinaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackServiceBinaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReadyTimeoutSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ReadyTimeoutSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackServiceBinaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackServiceBinaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackServiceBinaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PreviousVersion) > 0 {
+		i -= len(m.PreviousVersion)
+		copy(dAtA[i:], m.PreviousVersion)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.PreviousVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServiceVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListServiceVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListServiceVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListServiceVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Versions[iNdEx])
+			copy(dAtA[i:], m.Versions[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Versions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Current) > 0 {
+		i -= len(m.Current)
+		copy(dAtA[i:], m.Current)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Current)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetServiceDirsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetServiceDirsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetServiceDirsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceDirs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceDirs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceDirs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Conf) > 0 {
+		i -= len(m.Conf)
+		copy(dAtA[i:], m.Conf)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Conf)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SpaceDirs) > 0 {
+		for iNdEx := len(m.SpaceDirs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpaceDirs[iNdEx])
+			copy(dAtA[i:], m.SpaceDirs[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.SpaceDirs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DataDirs) > 0 {
+		for iNdEx := len(m.DataDirs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataDirs[iNdEx])
+			copy(dAtA[i:], m.DataDirs[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.DataDirs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RootDir) > 0 {
+		i -= len(m.RootDir)
+		copy(dAtA[i:], m.RootDir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.RootDir)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetServiceDirsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetServiceDirsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetServiceDirsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreateTime != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.CreateTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExclusiveBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ExclusiveBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DataPath) > 0 {
+		i -= len(m.DataPath)
+		copy(dAtA[i:], m.DataPath)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.DataPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DataPaths) > 0 {
+		for iNdEx := len(m.DataPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataPaths[iNdEx])
+			copy(dAtA[i:], m.DataPaths[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.DataPaths[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DropCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DropCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DropCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DropCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Dropped) > 0 {
+		for iNdEx := len(m.Dropped) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dropped[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectDiagnosticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectDiagnosticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectDiagnosticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectDiagnosticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectDiagnosticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectDiagnosticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Skipped) > 0 {
+		for iNdEx := len(m.Skipped) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skipped[iNdEx])
+			copy(dAtA[i:], m.Skipped[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Skipped[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Files[iNdEx])
+			copy(dAtA[i:], m.Files[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Files[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BundleBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.BundleBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogRetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Archive != nil {
+		{
+			size, err := m.Archive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CompressAfterSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.CompressAfterSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxFiles != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxFiles))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTotalBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxTotalBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAgeSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxAgeSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogRetentionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogRetentionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogRetentionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Roles) > 0 {
+		for k := range m.Roles {
+			v := m.Roles[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintAgent(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAgent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Default != nil {
+		{
+			size, err := m.Default.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogFileAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogFileAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogFileAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogCleanReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogCleanReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogCleanReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RemainingFiles != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.RemainingFiles))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RemainingBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.RemainingBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FreedBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.FreedBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Time != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LogDir) > 0 {
+		i -= len(m.LogDir)
+		copy(dAtA[i:], m.LogDir)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.LogDir)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogJanitorStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogJanitorStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogJanitorStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogJanitorStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogJanitorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogJanitorStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHostInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHostInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHostInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MountUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MountUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MountUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FreeInodes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.FreeInodes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalInodes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TotalInodes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FreeBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.FreeBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Dirs) > 0 {
+		for iNdEx := len(m.Dirs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dirs[iNdEx])
+			copy(dAtA[i:], m.Dirs[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Dirs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FsType) > 0 {
+		i -= len(m.FsType)
+		copy(dAtA[i:], m.FsType)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.FsType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MountPoint) > 0 {
+		i -= len(m.MountPoint)
+		copy(dAtA[i:], m.MountPoint)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.MountPoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHostInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHostInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHostInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TimeMs != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TimeMs))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ClockSynchronized {
+		i--
+		if m.ClockSynchronized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ClockOffsetUs != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ClockOffsetUs))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.KernelVersion) > 0 {
+		i -= len(m.KernelVersion)
+		copy(dAtA[i:], m.KernelVersion)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.KernelVersion)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.FileAllocated != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.FileAllocated))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FileMax != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.FileMax))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NofileHardLimit != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.NofileHardLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NofileSoftLimit != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.NofileSoftLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Mounts) > 0 {
+		for iNdEx := len(m.Mounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MemAvailableBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MemAvailableBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MemTotalBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MemTotalBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LoadAvg) > 0 {
+		for iNdEx := len(m.LoadAvg) - 1; iNdEx >= 0; iNdEx-- {
+			f17 := math.Float64bits(float64(m.LoadAvg[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f17))
+		}
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.LoadAvg)*8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CpuCount != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.CpuCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hostname) > 0 {
+		i -= len(m.Hostname)
+		copy(dAtA[i:], m.Hostname)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Hostname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAgent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAgent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	if m.Succeeded {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.ReadyTimeoutSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.ReadyTimeoutSeconds))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServiceStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServiceStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovAgent(uint64(m.Status))
+	}
+	if m.Pid != 0 {
+		n += 1 + sovAgent(uint64(m.Pid))
+	}
+	if m.UptimeSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.UptimeSeconds))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovAgent(uint64(m.ExitCode))
+	}
+	if len(m.ListeningPorts) > 0 {
+		l = 0
+		for _, e := range m.ListeningPorts {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestartServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.ReadyTimeoutSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.ReadyTimeoutSeconds))
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestartServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServiceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.RootDir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.DataDirs) > 0 {
+		for _, s := range m.DataDirs {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.HostStatus)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.LeaderParts != 0 {
+		n += 1 + sovAgent(uint64(m.LeaderParts))
+	}
+	if m.TotalParts != 0 {
+		n += 1 + sovAgent(uint64(m.TotalParts))
+	}
+	l = len(m.Conf)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServicesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BalanceLeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BalanceLeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CrashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovAgent(uint64(m.Time))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovAgent(uint64(m.ExitCode))
+	}
+	l = len(m.LogFile)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.LogTail) > 0 {
+		for _, s := range m.LogTail {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.CoreFiles) > 0 {
+		for _, s := range m.CoreFiles {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchedService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovAgent(uint64(m.State))
+	}
+	if m.RestartCount != 0 {
+		n += 1 + sovAgent(uint64(m.RestartCount))
+	}
+	if m.LastRestartTime != 0 {
+		n += 1 + sovAgent(uint64(m.LastRestartTime))
+	}
+	if m.NextRestartTime != 0 {
+		n += 1 + sovAgent(uint64(m.NextRestartTime))
+	}
+	if len(m.Crashes) > 0 {
+		for _, e := range m.Crashes {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.Conf)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchdogStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchdogStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TailLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Kinds) > 0 {
+		l = 0
+		for _, e := range m.Kinds {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
+	}
+	if m.Lines != 0 {
+		n += 1 + sovAgent(uint64(m.Lines))
+	}
+	if m.Follow {
+		n += 2
+	}
+	l = len(m.Grep)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovAgent(uint64(m.Since))
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TailLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovAgent(uint64(m.Kind))
+	}
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Lines) > 0 {
+		for _, s := range m.Lines {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetServiceConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAgent(uint64(m.Version))
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetServiceConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Flags) > 0 {
+		for k, v := range m.Flags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + len(v) + sovAgent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		l = 0
+		for _, e := range m.Versions {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateServiceConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Set) > 0 {
+		for k, v := range m.Set {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + len(v) + sovAgent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateServiceConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BackupVersion != 0 {
+		n += 1 + sovAgent(uint64(m.BackupVersion))
+	}
+	if m.RestartRequired {
+		n += 2
+	}
+	if len(m.RestartFlags) > 0 {
+		for _, s := range m.RestartFlags {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackServiceConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAgent(uint64(m.Version))
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackServiceConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BackupVersion != 0 {
+		n += 1 + sovAgent(uint64(m.BackupVersion))
+	}
+	if m.RestartRequired {
+		n += 2
+	}
+	if len(m.RestartFlags) > 0 {
+		for _, s := range m.RestartFlags {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FlagValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FlagValue_BoolValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *FlagValue_IntValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovAgent(uint64(m.IntValue))
+	return n
+}
+func (m *FlagValue_DoubleValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
+func (m *FlagValue_StringValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StringValue)
+	n += 1 + l + sovAgent(uint64(l))
+	return n
+}
+func (m *GetFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Flags) > 0 {
+		for _, e := range m.Flags {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Flags) > 0 {
+		for k, v := range m.Flags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + len(v) + sovAgent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	if m.Persist {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BackupVersion != 0 {
+		n += 1 + sovAgent(uint64(m.BackupVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BanReadWriteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BanReadWriteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllowReadWriteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllowReadWriteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadWriteBan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovAgent(uint64(m.Since))
+	}
+	if m.Applied {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Blocked)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadWriteStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadWriteStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bans) > 0 {
+		for _, e := range m.Bans {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DataPlayBackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.DataPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.MetaAddr)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.TimeoutSeconds))
+	}
+	if m.Async {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DataPlayBackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.LogFile)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopAgentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HealthCheckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HealthCheckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSpaceUsagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.DataPaths) > 0 {
+		for _, s := range m.DataPaths {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.MaxAgeSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.MaxAgeSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSpaceUsagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpaceUsages) > 0 {
+		for _, e := range m.SpaceUsages {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.ScanTime != 0 {
+		n += 1 + sovAgent(uint64(m.ScanTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSpaceUsagesResponse_PartUsageItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAgent(uint64(m.Id))
+	}
+	if m.WalBytes != 0 {
+		n += 1 + sovAgent(uint64(m.WalBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAgent(uint64(m.Id))
+	}
+	if m.Usage != 0 {
+		n += 1 + sovAgent(uint64(m.Usage))
+	}
+	if m.DataBytes != 0 {
+		n += 1 + sovAgent(uint64(m.DataBytes))
+	}
+	if m.WalBytes != 0 {
+		n += 1 + sovAgent(uint64(m.WalBytes))
+	}
+	if m.CheckpointBytes != 0 {
+		n += 1 + sovAgent(uint64(m.CheckpointBytes))
+	}
+	if m.OtherBytes != 0 {
+		n += 1 + sovAgent(uint64(m.OtherBytes))
+	}
+	if len(m.Parts) > 0 {
+		for _, e := range m.Parts {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpgradeServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.ReadyTimeoutSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.ReadyTimeoutSeconds))
+	}
+	if m.KeepVersions != 0 {
+		n += 1 + sovAgent(uint64(m.KeepVersions))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpgradeServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousVersion)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackServiceBinaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.ReadyTimeoutSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.ReadyTimeoutSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackServiceBinaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousVersion)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServiceVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListServiceVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Current)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetServiceDirsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServiceDirs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.RootDir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.DataDirs) > 0 {
+		for _, s := range m.DataDirs {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.SpaceDirs) > 0 {
+		for _, s := range m.SpaceDirs {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.Conf)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetServiceDirsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckpointInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovAgent(uint64(m.SpaceId))
+	}
+	l = len(m.DataPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovAgent(uint64(m.TotalBytes))
+	}
+	if m.ExclusiveBytes != 0 {
+		n += 1 + sovAgent(uint64(m.ExclusiveBytes))
+	}
+	if m.CreateTime != 0 {
+		n += 1 + sovAgent(uint64(m.CreateTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DataPaths) > 0 {
+		for _, s := range m.DataPaths {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovAgent(uint64(m.SpaceId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DropCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovAgent(uint64(m.SpaceId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DropCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dropped) > 0 {
+		for _, e := range m.Dropped {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CollectDiagnosticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovAgent(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovAgent(uint64(m.EndTime))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovAgent(uint64(m.MaxBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CollectDiagnosticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.BundleBytes != 0 {
+		n += 1 + sovAgent(uint64(m.BundleBytes))
+	}
+	if len(m.Files) > 0 {
+		for _, s := range m.Files {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Skipped) > 0 {
+		for _, s := range m.Skipped {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogRetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAgeSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.MaxAgeSeconds))
+	}
+	if m.MaxTotalBytes != 0 {
+		n += 1 + sovAgent(uint64(m.MaxTotalBytes))
+	}
+	if m.MaxFiles != 0 {
+		n += 1 + sovAgent(uint64(m.MaxFiles))
+	}
+	if m.CompressAfterSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.CompressAfterSeconds))
+	}
+	if m.Archive != nil {
+		l = m.Archive.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogRetentionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovAgent(uint64(m.IntervalSeconds))
+	}
+	if m.Default != nil {
+		l = m.Default.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for k, v := range m.Roles {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovAgent(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogFileAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovAgent(uint64(m.Bytes))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogCleanReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovAgent(uint64(m.Role))
+	}
+	l = len(m.LogDir)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovAgent(uint64(m.Time))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.FreedBytes != 0 {
+		n += 1 + sovAgent(uint64(m.FreedBytes))
+	}
+	if m.RemainingBytes != 0 {
+		n += 1 + sovAgent(uint64(m.RemainingBytes))
+	}
+	if m.RemainingFiles != 0 {
+		n += 1 + sovAgent(uint64(m.RemainingFiles))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CleanLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CleanLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogJanitorStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogJanitorStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHostInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MountUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MountPoint)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.FsType)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Dirs) > 0 {
+		for _, s := range m.Dirs {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovAgent(uint64(m.TotalBytes))
+	}
+	if m.FreeBytes != 0 {
+		n += 1 + sovAgent(uint64(m.FreeBytes))
+	}
+	if m.TotalInodes != 0 {
+		n += 1 + sovAgent(uint64(m.TotalInodes))
+	}
+	if m.FreeInodes != 0 {
+		n += 1 + sovAgent(uint64(m.FreeInodes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHostInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.CpuCount != 0 {
+		n += 1 + sovAgent(uint64(m.CpuCount))
+	}
+	if len(m.LoadAvg) > 0 {
+		n += 1 + sovAgent(uint64(len(m.LoadAvg)*8)) + len(m.LoadAvg)*8
+	}
+	if m.MemTotalBytes != 0 {
+		n += 1 + sovAgent(uint64(m.MemTotalBytes))
+	}
+	if m.MemAvailableBytes != 0 {
+		n += 1 + sovAgent(uint64(m.MemAvailableBytes))
+	}
+	if len(m.Mounts) > 0 {
+		for _, e := range m.Mounts {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.NofileSoftLimit != 0 {
+		n += 1 + sovAgent(uint64(m.NofileSoftLimit))
+	}
+	if m.NofileHardLimit != 0 {
+		n += 1 + sovAgent(uint64(m.NofileHardLimit))
+	}
+	if m.FileMax != 0 {
+		n += 1 + sovAgent(uint64(m.FileMax))
+	}
+	if m.FileAllocated != 0 {
+		n += 1 + sovAgent(uint64(m.FileAllocated))
+	}
+	l = len(m.KernelVersion)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.ClockOffsetUs != 0 {
+		n += 1 + sovAgent(uint64(m.ClockOffsetUs))
+	}
+	if m.ClockSynchronized {
+		n += 2
+	}
+	if m.TimeMs != 0 {
+		n += 1 + sovAgent(uint64(m.TimeMs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAgent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAgent(x uint64) (n int) {
+	return sovAgent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartServiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartServiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyTimeoutSeconds", wireType)
+			}
+			m.ReadyTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyTimeoutSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v ServiceRole
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ServiceRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAgent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]ServiceRole, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ServiceRole
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ServiceRole(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &RoleResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopServiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopServiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v ServiceRole
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ServiceRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAgent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]ServiceRole, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ServiceRole
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ServiceRole(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &RoleResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pid |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeSeconds", wireType)
+			}
+			m.UptimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UptimeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ListeningPorts = append(m.ListeningPorts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAgent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ListeningPorts) == 0 {
+					m.ListeningPorts = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ListeningPorts = append(m.ListeningPorts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListeningPorts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartServiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartServiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyTimeoutSeconds", wireType)
+			}
+			m.ReadyTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyTimeoutSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataDirs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataDirs = append(m.DataDirs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderParts", wireType)
+			}
+			m.LeaderParts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderParts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalParts", wireType)
+			}
+			m.TotalParts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalParts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServicesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServicesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServicesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceInfo{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceLeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceLeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceLeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceLeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogTail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogTail = append(m.LogTail, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoreFiles = append(m.CoreFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchedService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchedService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchedService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= WatchState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartCount", wireType)
+			}
+			m.RestartCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestartCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRestartTime", wireType)
+			}
+			m.LastRestartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRestartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRestartTime", wireType)
+			}
+			m.NextRestartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRestartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Crashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Crashes = append(m.Crashes, &CrashRecord{})
+			if err := m.Crashes[len(m.Crashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchdogStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchdogStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchdogStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchdogStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchdogStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchdogStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &WatchedService{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TailLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TailLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TailLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v LogKind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= LogKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Kinds = append(m.Kinds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAgent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Kinds) == 0 {
+					m.Kinds = make([]LogKind, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v LogKind
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= LogKind(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Kinds = append(m.Kinds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+			m.Lines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lines |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Follow = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grep = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TailLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TailLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TailLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= LogKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lines = append(m.Lines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServiceConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServiceConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServiceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServiceConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServiceConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServiceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flags == nil {
+				m.Flags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAgent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Flags[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Versions = append(m.Versions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAgent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Versions) == 0 {
+					m.Versions = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Versions = append(m.Versions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateServiceConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateServiceConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateServiceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Set == nil {
+				m.Set = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAgent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Set[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateServiceConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateServiceConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateServiceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupVersion", wireType)
+			}
+			m.BackupVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackupVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartRequired = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartFlags = append(m.RestartFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackServiceConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackServiceConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackServiceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackServiceConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackServiceConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackServiceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupVersion", wireType)
+			}
+			m.BackupVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackupVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartRequired = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartFlags = append(m.RestartFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlagValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlagValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlagValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Value = &FlagValue_BoolValue{b}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntValue", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &FlagValue_IntValue{v}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = &FlagValue_DoubleValue{float64(math.Float64frombits(v))}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &FlagValue_StringValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFlagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFlagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, &FlagValue{})
+			if err := m.Flags[len(m.Flags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetFlagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetFlagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flags == nil {
+				m.Flags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAgent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Flags[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Persist = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupVersion", wireType)
+			}
+			m.BackupVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackupVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BanReadWriteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BanReadWriteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BanReadWriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BanReadWriteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BanReadWriteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BanReadWriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowReadWriteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowReadWriteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowReadWriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowReadWriteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowReadWriteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowReadWriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadWriteBan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadWriteBan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadWriteBan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadWriteStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadWriteStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadWriteStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadWriteStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadWriteStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadWriteStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bans = append(m.Bans, &ReadWriteBan{})
+			if err := m.Bans[len(m.Bans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataPlayBackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataPlayBackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataPlayBackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Async", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Async = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataPlayBackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataPlayBackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataPlayBackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopAgentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopAgentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopAgentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthCheckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthCheckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthCheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthCheckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthCheckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthCheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSpaceUsagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSpaceUsagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSpaceUsagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataPaths = append(m.DataPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeSeconds", wireType)
+			}
+			m.MaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSpaceUsagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSpaceUsagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSpaceUsagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceUsages = append(m.SpaceUsages, &GetSpaceUsagesResponse_SpaceUsageItem{})
+			if err := m.SpaceUsages[len(m.SpaceUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanTime", wireType)
+			}
+			m.ScanTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScanTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSpaceUsagesResponse_PartUsageItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartUsageItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartUsageItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalBytes", wireType)
+			}
+			m.WalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSpaceUsagesResponse_SpaceUsageItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceUsageItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceUsageItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			m.Usage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Usage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataBytes", wireType)
+			}
+			m.DataBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalBytes", wireType)
+			}
+			m.WalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointBytes", wireType)
+			}
+			m.CheckpointBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherBytes", wireType)
+			}
+			m.OtherBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OtherBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parts = append(m.Parts, &GetSpaceUsagesResponse_PartUsageItem{})
+			if err := m.Parts[len(m.Parts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeServiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeServiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &Backend{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyTimeoutSeconds", wireType)
+			}
+			m.ReadyTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyTimeoutSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepVersions", wireType)
+			}
+			m.KeepVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepVersions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackServiceBinaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackServiceBinaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackServiceBinaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyTimeoutSeconds", wireType)
+			}
+			m.ReadyTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyTimeoutSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackServiceBinaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackServiceBinaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackServiceBinaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServiceVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServiceVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServiceVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListServiceVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListServiceVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListServiceVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Current = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServiceDirsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServiceDirsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServiceDirsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceDirs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceDirs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceDirs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataDirs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataDirs = append(m.DataDirs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceDirs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceDirs = append(m.SpaceDirs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServiceDirsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServiceDirsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServiceDirsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceDirs{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusiveBytes", wireType)
+			}
+			m.ExclusiveBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExclusiveBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			m.CreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCheckpointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCheckpointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataPaths = append(m.DataPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCheckpointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCheckpointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, &CheckpointInfo{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DropCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DropCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DropCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DropCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DropCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DropCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dropped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dropped = append(m.Dropped, &CheckpointInfo{})
+			if err := m.Dropped[len(m.Dropped)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectDiagnosticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectDiagnosticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectDiagnosticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ServiceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &Backend{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *StartServiceRequest) Unmarshal(dAtA []byte) error {
+func (m *CollectDiagnosticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2394,17 +20769,17 @@ func (m *StartServiceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartServiceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectDiagnosticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectDiagnosticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2414,14 +20789,27 @@ func (m *StartServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= ServiceRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2449,7 +20837,90 @@ func (m *StartServiceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleBytes", wireType)
+			}
+			m.BundleBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skipped = append(m.Skipped, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2473,7 +20944,7 @@ func (m *StartServiceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *LogRetentionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2496,12 +20967,124 @@ func (m *StartServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogRetentionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeSeconds", wireType)
+			}
+			m.MaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBytes", wireType)
+			}
+			m.MaxTotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFiles", wireType)
+			}
+			m.MaxFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFiles |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressAfterSeconds", wireType)
+			}
+			m.CompressAfterSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressAfterSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Archive == nil {
+				m.Archive = &Backend{}
+			}
+			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -2524,7 +21107,7 @@ func (m *StartServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopServiceRequest) Unmarshal(dAtA []byte) error {
+func (m *LogRetentionConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2547,17 +21130,17 @@ func (m *StopServiceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopServiceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogRetentionConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogRetentionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 			}
-			m.Role = 0
+			m.IntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2567,16 +21150,16 @@ func (m *StopServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= ServiceRole(b&0x7F) << shift
+				m.IntervalSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2586,75 +21169,157 @@ func (m *StopServiceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if m.Default == nil {
+				m.Default = &LogRetentionPolicy{}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
+			if err := m.Default.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StopServiceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Roles == nil {
+				m.Roles = make(map[string]*LogRetentionPolicy)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StopServiceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var mapkey string
+			var mapvalue *LogRetentionPolicy
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LogRetentionPolicy{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAgent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Roles[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -2677,7 +21342,7 @@ func (m *StopServiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *LogFileAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2700,17 +21365,17 @@ func (m *ServiceStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogFileAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogFileAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2720,14 +21385,27 @@ func (m *ServiceStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= ServiceRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2755,64 +21433,96 @@ func (m *ServiceStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ServiceStatusResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceStatusResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.Status = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2822,11 +21532,24 @@ func (m *ServiceStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -2849,7 +21572,7 @@ func (m *ServiceStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BanReadWriteRequest) Unmarshal(dAtA []byte) error {
+func (m *LogCleanReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2872,10 +21595,10 @@ func (m *BanReadWriteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BanReadWriteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogCleanReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BanReadWriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogCleanReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2899,7 +21622,7 @@ func (m *BanReadWriteRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogDir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2927,115 +21650,105 @@ func (m *BanReadWriteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.LogDir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BanReadWriteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.DryRun = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BanReadWriteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BanReadWriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllowReadWriteRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			m.Actions = append(m.Actions, &LogFileAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreedBytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FreedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreedBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllowReadWriteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowReadWriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBytes", wireType)
 			}
-			m.Role = 0
+			m.RemainingBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3045,14 +21758,33 @@ func (m *AllowReadWriteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= ServiceRole(b&0x7F) << shift
+				m.RemainingBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingFiles", wireType)
+			}
+			m.RemainingFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingFiles |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3080,7 +21812,7 @@ func (m *AllowReadWriteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3104,7 +21836,7 @@ func (m *AllowReadWriteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowReadWriteResponse) Unmarshal(dAtA []byte) error {
+func (m *CleanLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3127,12 +21859,32 @@ func (m *AllowReadWriteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowReadWriteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowReadWriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -3155,7 +21907,7 @@ func (m *AllowReadWriteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataPlayBackRequest) Unmarshal(dAtA []byte) error {
+func (m *CleanLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3178,81 +21930,17 @@ func (m *DataPlayBackRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataPlayBackRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataPlayBackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataPath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3262,75 +21950,26 @@ func (m *DataPlayBackRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DataPlayBackResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Reports = append(m.Reports, &LogCleanReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataPlayBackResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataPlayBackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -3353,7 +21992,7 @@ func (m *DataPlayBackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopAgentRequest) Unmarshal(dAtA []byte) error {
+func (m *LogJanitorStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3376,10 +22015,10 @@ func (m *StopAgentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopAgentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogJanitorStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopAgentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogJanitorStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3404,7 +22043,7 @@ func (m *StopAgentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopAgentResponse) Unmarshal(dAtA []byte) error {
+func (m *LogJanitorStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3427,12 +22066,102 @@ func (m *StopAgentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopAgentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogJanitorStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogJanitorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &LogRetentionConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, &LogCleanReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -3455,7 +22184,7 @@ func (m *StopAgentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthCheckRequest) Unmarshal(dAtA []byte) error {
+func (m *GetHostInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3478,10 +22207,10 @@ func (m *HealthCheckRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthCheckRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHostInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthCheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHostInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3506,7 +22235,7 @@ func (m *HealthCheckRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthCheckResponse) Unmarshal(dAtA []byte) error {
+func (m *MountUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3529,15 +22258,15 @@ func (m *HealthCheckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthCheckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MountUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthCheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MountUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3565,8 +22294,180 @@ func (m *HealthCheckResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.MountPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FsType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FsType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dirs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dirs = append(m.Dirs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeBytes", wireType)
+			}
+			m.FreeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInodes", wireType)
+			}
+			m.TotalInodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalInodes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeInodes", wireType)
+			}
+			m.FreeInodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeInodes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -3589,7 +22490,7 @@ func (m *HealthCheckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSpaceUsagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetHostInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3612,15 +22513,15 @@ func (m *GetSpaceUsagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSpaceUsagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHostInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSpaceUsagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHostInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3648,62 +22549,122 @@ func (m *GetSpaceUsagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataPath = string(dAtA[iNdEx:postIndex])
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuCount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
+			m.CpuCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CpuCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.LoadAvg = append(m.LoadAvg, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAgent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.LoadAvg) == 0 {
+					m.LoadAvg = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.LoadAvg = append(m.LoadAvg, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadAvg", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetSpaceUsagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemTotalBytes", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.MemTotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemTotalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemAvailableBytes", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetSpaceUsagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSpaceUsagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MemAvailableBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemAvailableBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceUsages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3730,67 +22691,143 @@ func (m *GetSpaceUsagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceUsages = append(m.SpaceUsages, &GetSpaceUsagesResponse_SpaceUsageItem{})
-			if err := m.SpaceUsages[len(m.SpaceUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Mounts = append(m.Mounts, &MountUsage{})
+			if err := m.Mounts[len(m.Mounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NofileSoftLimit", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
+			m.NofileSoftLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NofileSoftLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NofileHardLimit", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetSpaceUsagesResponse_SpaceUsageItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			m.NofileHardLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NofileHardLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileMax", wireType)
+			}
+			m.FileMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileAllocated", wireType)
+			}
+			m.FileAllocated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileAllocated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.KernelVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockOffsetUs", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpaceUsageItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpaceUsageItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ClockOffsetUs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClockOffsetUs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSynchronized", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3800,16 +22837,17 @@ func (m *GetSpaceUsagesResponse_SpaceUsageItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.ClockSynchronized = bool(v != 0)
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeMs", wireType)
 			}
-			m.Usage = 0
+			m.TimeMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3819,7 +22857,7 @@ func (m *GetSpaceUsagesResponse_SpaceUsageItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Usage |= int64(b&0x7F) << shift
+				m.TimeMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
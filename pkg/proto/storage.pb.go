@@ -234,7 +234,6 @@ func (m *GS) GetCredentials() string {
 
 type Backend struct {
 	// Types that are valid to be assigned to Storage:
-	//
 	//	*Backend_Local
 	//	*Backend_S3
 	//	*Backend_Gs
@@ -337,10 +336,15 @@ func (*Backend) XXX_OneofWrappers() []interface{} {
 // every request should include the storage info,
 // because there is no explicit session management in the interface
 type UploadFileRequest struct {
-	SessionId            string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Recursively          bool     `protobuf:"varint,2,opt,name=recursively,proto3" json:"recursively,omitempty"`
-	SourcePath           string   `protobuf:"bytes,3,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
-	TargetBackend        *Backend `protobuf:"bytes,4,opt,name=target_backend,json=targetBackend,proto3" json:"target_backend,omitempty"`
+	SessionId     string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Recursively   bool     `protobuf:"varint,2,opt,name=recursively,proto3" json:"recursively,omitempty"`
+	SourcePath    string   `protobuf:"bytes,3,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	TargetBackend *Backend `protobuf:"bytes,4,opt,name=target_backend,json=targetBackend,proto3" json:"target_backend,omitempty"`
+	// upload sst files into the content-addressed pool once if set,
+	// the pool should be in the same storage as target_backend
+	DedupPool *Backend `protobuf:"bytes,5,opt,name=dedup_pool,json=dedupPool,proto3" json:"dedup_pool,omitempty"`
+	// record the upload into the session's catalog in this dir if set
+	Catalog              *Backend `protobuf:"bytes,6,opt,name=catalog,proto3" json:"catalog,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -407,6 +411,20 @@ func (m *UploadFileRequest) GetTargetBackend() *Backend {
 	return nil
 }
 
+func (m *UploadFileRequest) GetDedupPool() *Backend {
+	if m != nil {
+		return m.DedupPool
+	}
+	return nil
+}
+
+func (m *UploadFileRequest) GetCatalog() *Backend {
+	if m != nil {
+		return m.Catalog
+	}
+	return nil
+}
+
 type UploadFileResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -447,11 +465,13 @@ func (m *UploadFileResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_UploadFileResponse proto.InternalMessageInfo
 
 type IncrUploadFileRequest struct {
-	SessionId            string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	SourcePath           string   `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
-	TargetBackend        *Backend `protobuf:"bytes,3,opt,name=target_backend,json=targetBackend,proto3" json:"target_backend,omitempty"`
-	CommitLogId          int64    `protobuf:"varint,4,opt,name=commit_log_id,json=commitLogId,proto3" json:"commit_log_id,omitempty"`
-	LastLogId            int64    `protobuf:"varint,5,opt,name=last_log_id,json=lastLogId,proto3" json:"last_log_id,omitempty"`
+	SessionId     string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SourcePath    string   `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	TargetBackend *Backend `protobuf:"bytes,3,opt,name=target_backend,json=targetBackend,proto3" json:"target_backend,omitempty"`
+	CommitLogId   int64    `protobuf:"varint,4,opt,name=commit_log_id,json=commitLogId,proto3" json:"commit_log_id,omitempty"`
+	LastLogId     int64    `protobuf:"varint,5,opt,name=last_log_id,json=lastLogId,proto3" json:"last_log_id,omitempty"`
+	// record the upload into the session's catalog in this dir if set
+	Catalog              *Backend `protobuf:"bytes,6,opt,name=catalog,proto3" json:"catalog,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -525,6 +545,13 @@ func (m *IncrUploadFileRequest) GetLastLogId() int64 {
 	return 0
 }
 
+func (m *IncrUploadFileRequest) GetCatalog() *Backend {
+	if m != nil {
+		return m.Catalog
+	}
+	return nil
+}
+
 type IncrUploadFileResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -565,10 +592,12 @@ func (m *IncrUploadFileResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_IncrUploadFileResponse proto.InternalMessageInfo
 
 type DownloadFileRequest struct {
-	SessionId            string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Recursively          bool     `protobuf:"varint,2,opt,name=recursively,proto3" json:"recursively,omitempty"`
-	SourceBackend        *Backend `protobuf:"bytes,3,opt,name=source_backend,json=sourceBackend,proto3" json:"source_backend,omitempty"`
-	TargetPath           string   `protobuf:"bytes,4,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	SessionId     string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Recursively   bool     `protobuf:"varint,2,opt,name=recursively,proto3" json:"recursively,omitempty"`
+	SourceBackend *Backend `protobuf:"bytes,3,opt,name=source_backend,json=sourceBackend,proto3" json:"source_backend,omitempty"`
+	TargetPath    string   `protobuf:"bytes,4,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	// resolve sst files from the pool if the source is uploaded in dedup mode
+	DedupPool            *Backend `protobuf:"bytes,5,opt,name=dedup_pool,json=dedupPool,proto3" json:"dedup_pool,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -635,6 +664,13 @@ func (m *DownloadFileRequest) GetTargetPath() string {
 	return ""
 }
 
+func (m *DownloadFileRequest) GetDedupPool() *Backend {
+	if m != nil {
+		return m.DedupPool
+	}
+	return nil
+}
+
 type DownloadFileResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -948,876 +984,1092 @@ func (m *ExistDirResponse) GetExist() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*Local)(nil), "proto.Local")
-	proto.RegisterType((*S3)(nil), "proto.S3")
-	proto.RegisterType((*GS)(nil), "proto.GS")
-	proto.RegisterType((*Backend)(nil), "proto.Backend")
-	proto.RegisterType((*UploadFileRequest)(nil), "proto.UploadFileRequest")
-	proto.RegisterType((*UploadFileResponse)(nil), "proto.UploadFileResponse")
-	proto.RegisterType((*IncrUploadFileRequest)(nil), "proto.IncrUploadFileRequest")
-	proto.RegisterType((*IncrUploadFileResponse)(nil), "proto.IncrUploadFileResponse")
-	proto.RegisterType((*DownloadFileRequest)(nil), "proto.DownloadFileRequest")
-	proto.RegisterType((*DownloadFileResponse)(nil), "proto.DownloadFileResponse")
-	proto.RegisterType((*MoveDirRequest)(nil), "proto.MoveDirRequest")
-	proto.RegisterType((*MoveDirResponse)(nil), "proto.MoveDirResponse")
-	proto.RegisterType((*RemoveDirRequest)(nil), "proto.RemoveDirRequest")
-	proto.RegisterType((*RemoveDirResponse)(nil), "proto.RemoveDirResponse")
-	proto.RegisterType((*ExistDirRequest)(nil), "proto.ExistDirRequest")
-	proto.RegisterType((*ExistDirResponse)(nil), "proto.ExistDirResponse")
+type CatalogFile struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	FileSize             int64    `protobuf:"varint,2,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Sha256               string   `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
+func (m *CatalogFile) Reset()         { *m = CatalogFile{} }
+func (m *CatalogFile) String() string { return proto.CompactTextString(m) }
+func (*CatalogFile) ProtoMessage()    {}
+func (*CatalogFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{16}
+}
+func (m *CatalogFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CatalogFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CatalogFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CatalogFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogFile.Merge(m, src)
+}
+func (m *CatalogFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *CatalogFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogFile.DiscardUnknown(m)
+}
 
-var fileDescriptor_0d2c4ccf1453ffdb = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
-	0x14, 0xc5, 0x09, 0x21, 0xc9, 0x35, 0x04, 0x18, 0x20, 0x18, 0xf3, 0x11, 0x90, 0xbf, 0xb6, 0x62,
-	0xc5, 0x22, 0x11, 0x52, 0xa5, 0x4a, 0x95, 0x4a, 0x29, 0x3f, 0x02, 0xa4, 0xca, 0x51, 0xd7, 0x91,
-	0x19, 0x8f, 0x5c, 0x0b, 0xe3, 0x49, 0x67, 0x26, 0xb4, 0x79, 0x93, 0xae, 0xbb, 0xae, 0xfa, 0x1a,
-	0xed, 0xb2, 0xea, 0x13, 0x54, 0xf4, 0x45, 0x2a, 0xcf, 0xdc, 0x24, 0x4e, 0x48, 0x05, 0x95, 0xba,
-	0x4a, 0xe6, 0x9c, 0x33, 0xf7, 0xde, 0x73, 0x8f, 0x6d, 0x58, 0x90, 0x8a, 0x8b, 0x20, 0x62, 0x7b,
-	0x5d, 0xc1, 0x15, 0x27, 0x25, 0xfd, 0xe3, 0x6d, 0x42, 0xe9, 0x9c, 0xd3, 0x20, 0x21, 0x04, 0x66,
-	0xbb, 0x81, 0x7a, 0xeb, 0x58, 0x3b, 0xd6, 0x6e, 0xd5, 0xd7, 0xff, 0xbd, 0xaf, 0x16, 0x14, 0xda,
-	0x2d, 0xe2, 0x42, 0x85, 0xa5, 0x61, 0x97, 0xc7, 0xa9, 0x42, 0x7a, 0x78, 0x26, 0x75, 0x98, 0x13,
-	0x2c, 0x8a, 0x79, 0xea, 0x14, 0x34, 0x83, 0xa7, 0x0c, 0xbf, 0xec, 0xd1, 0x2b, 0xa6, 0x9c, 0xa2,
-	0xc1, 0xcd, 0x69, 0xd8, 0x66, 0x76, 0xd4, 0x86, 0xfc, 0x3f, 0x9c, 0xad, 0x43, 0x93, 0x40, 0x4a,
-	0xa7, 0xa4, 0xc9, 0x79, 0x04, 0x5f, 0x66, 0x18, 0xd9, 0x02, 0x08, 0x28, 0x65, 0x52, 0x76, 0xae,
-	0x58, 0xdf, 0x99, 0xd3, 0x8a, 0xaa, 0x41, 0xce, 0x58, 0x3f, 0xa3, 0x25, 0xa3, 0x82, 0x29, 0x4d,
-	0x97, 0x0d, 0x6d, 0x90, 0x33, 0xd6, 0xf7, 0x7c, 0x28, 0x1c, 0xb7, 0x73, 0x43, 0x59, 0x53, 0x87,
-	0x2a, 0xe4, 0x86, 0xda, 0x01, 0x9b, 0x0a, 0x16, 0xb2, 0x54, 0xc5, 0x41, 0x22, 0xd1, 0x45, 0x1e,
-	0xf2, 0x14, 0x94, 0x0f, 0x02, 0x7a, 0xc5, 0xd2, 0x90, 0x3c, 0x82, 0x52, 0x92, 0x6d, 0x51, 0xd7,
-	0xb5, 0x9b, 0xf3, 0x66, 0xc7, 0x7b, 0x7a, 0xb3, 0x27, 0x33, 0xbe, 0x21, 0xc9, 0x26, 0x14, 0x64,
-	0x4b, 0x37, 0xb1, 0x9b, 0x55, 0x94, 0xb4, 0x5b, 0x27, 0x33, 0x7e, 0x41, 0xb6, 0x32, 0x32, 0x32,
-	0x6d, 0x46, 0xe4, 0x71, 0x3b, 0x23, 0x23, 0x79, 0x50, 0x85, 0x32, 0x2e, 0xc3, 0xfb, 0x6c, 0xc1,
-	0xf2, 0x9b, 0x6e, 0xc2, 0x83, 0xf0, 0x28, 0x4e, 0x98, 0xcf, 0xde, 0xf5, 0x98, 0x54, 0xc6, 0xbe,
-	0x94, 0x31, 0x4f, 0x3b, 0x71, 0x88, 0xee, 0xaa, 0x88, 0x9c, 0x86, 0x99, 0x19, 0xc1, 0x68, 0x4f,
-	0xc8, 0xf8, 0x86, 0x25, 0x7d, 0x3d, 0x42, 0xc5, 0xcf, 0x43, 0x64, 0x1b, 0x6c, 0xc9, 0x7b, 0x82,
-	0xb2, 0x8e, 0xde, 0x84, 0xb1, 0x0b, 0x06, 0x7a, 0x9d, 0xed, 0x63, 0x1f, 0x6a, 0x2a, 0x10, 0x11,
-	0x53, 0x9d, 0x4b, 0x63, 0x5a, 0x47, 0x68, 0x37, 0x6b, 0x38, 0x2b, 0xae, 0xc2, 0x5f, 0x30, 0x2a,
-	0x3c, 0x7a, 0xab, 0x40, 0xf2, 0xd3, 0xca, 0x2e, 0x4f, 0x25, 0xf3, 0x7e, 0x58, 0xb0, 0x76, 0x9a,
-	0x52, 0xf1, 0xd7, 0x46, 0x26, 0xc6, 0x2c, 0x3c, 0x60, 0xcc, 0xe2, 0x03, 0xc6, 0x24, 0x1e, 0x2c,
-	0x50, 0x7e, 0x7d, 0x1d, 0xab, 0x4e, 0xc2, 0xa3, 0xac, 0x73, 0x66, 0xae, 0xe8, 0xdb, 0x06, 0x3c,
-	0xe7, 0xd1, 0x69, 0x48, 0x1a, 0x60, 0x27, 0x81, 0x1c, 0x2a, 0x4a, 0x5a, 0x51, 0xcd, 0x20, 0xcd,
-	0x7b, 0x0e, 0xd4, 0x27, 0x3d, 0xa1, 0xdd, 0x2f, 0x16, 0xac, 0x1c, 0xf2, 0xf7, 0xe9, 0x3f, 0x4f,
-	0x6d, 0x1f, 0x6a, 0xb8, 0x8e, 0x7b, 0xdc, 0x1a, 0xd5, 0xc0, 0xed, 0x36, 0xd8, 0xb8, 0xa4, 0xdc,
-	0xbb, 0x08, 0x06, 0xca, 0xb6, 0xe8, 0xd5, 0x61, 0x75, 0x7c, 0x5e, 0x34, 0x72, 0x04, 0xb5, 0x0b,
-	0x7e, 0xc3, 0x0e, 0x63, 0x31, 0xb0, 0xb0, 0x01, 0x15, 0x29, 0x68, 0x27, 0xf7, 0xe9, 0x28, 0x4b,
-	0x41, 0x75, 0x14, 0x1b, 0x50, 0x09, 0xa5, 0xca, 0x07, 0x55, 0x0e, 0xa5, 0xa9, 0xbf, 0x0c, 0x8b,
-	0xc3, 0x3a, 0x58, 0xfa, 0x09, 0x2c, 0xf9, 0xec, 0x7a, 0xbc, 0xf8, 0xb4, 0x6f, 0xd2, 0x0a, 0x2c,
-	0xe7, 0x74, 0x78, 0xf9, 0x31, 0x2c, 0xbe, 0xfa, 0x10, 0x4b, 0x75, 0xcf, 0xdd, 0x5d, 0x58, 0x1a,
-	0xc9, 0xcc, 0x55, 0xb2, 0x0a, 0x25, 0x96, 0x61, 0x5a, 0x58, 0xf1, 0xcd, 0xa1, 0xf9, 0xa9, 0x08,
-	0xb5, 0xb6, 0x79, 0xe3, 0xda, 0x4c, 0xdc, 0xc4, 0x94, 0x91, 0x17, 0x00, 0xa3, 0x68, 0x89, 0x83,
-	0x1b, 0xbe, 0xf3, 0x04, 0xbb, 0x1b, 0x53, 0x18, 0xec, 0x75, 0x01, 0xb5, 0xf1, 0x27, 0x84, 0xfc,
-	0x87, 0xe2, 0xa9, 0x2f, 0x83, 0xbb, 0xf5, 0x07, 0x16, 0xcb, 0x1d, 0xc3, 0x7c, 0x3e, 0x25, 0xe2,
-	0xa2, 0x7c, 0xca, 0xa3, 0xe6, 0x6e, 0x4e, 0xe5, 0xb0, 0xd0, 0x53, 0x28, 0x63, 0x1c, 0x64, 0x0d,
-	0x75, 0xe3, 0x31, 0xbb, 0xf5, 0x49, 0x18, 0x6f, 0x3e, 0x87, 0xea, 0x30, 0x0d, 0xb2, 0x8e, 0xa2,
-	0xc9, 0x1c, 0x5d, 0xe7, 0x2e, 0x81, 0xf7, 0x9f, 0x41, 0x65, 0x90, 0x08, 0x19, 0xf4, 0x98, 0x48,
-	0xd2, 0x5d, 0xbf, 0x83, 0x9b, 0xcb, 0x07, 0x4b, 0xdf, 0x6e, 0x1b, 0xd6, 0xf7, 0xdb, 0x86, 0xf5,
-	0xf3, 0xb6, 0x61, 0x7d, 0xfc, 0xd5, 0x98, 0xb9, 0x9c, 0xd3, 0xca, 0xd6, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xcf, 0x4a, 0x23, 0xbd, 0xec, 0x06, 0x00, 0x00,
+var xxx_messageInfo_CatalogFile proto.InternalMessageInfo
+
+func (m *CatalogFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *CatalogFile) GetFileSize() int64 {
+	if m != nil {
+		return m.FileSize
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CatalogFile) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
 
-// StorageServiceClient is the client API for StorageService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type StorageServiceClient interface {
-	// UploadFile upload file from agent machine to external storage
-	UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error)
-	// IncrUploadFile upload incremental file from agent machine to external storage
-	IncrUploadFile(ctx context.Context, in *IncrUploadFileRequest, opts ...grpc.CallOption) (*IncrUploadFileResponse, error)
-	// DownloadFile download file from external storage to agent machine
-	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (*DownloadFileResponse, error)
-	// MoveDir rename dir in agent machine
-	MoveDir(ctx context.Context, in *MoveDirRequest, opts ...grpc.CallOption) (*MoveDirResponse, error)
-	// RemoveDir delete dir in agent machine
-	RemoveDir(ctx context.Context, in *RemoveDirRequest, opts ...grpc.CallOption) (*RemoveDirResponse, error)
-	// ExistDir check if dir in agent machine exist
-	ExistDir(ctx context.Context, in *ExistDirRequest, opts ...grpc.CallOption) (*ExistDirResponse, error)
+// CatalogEntry records one upload request
+type CatalogEntry struct {
+	SourcePath           string         `protobuf:"bytes,1,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	TargetUri            string         `protobuf:"bytes,2,opt,name=target_uri,json=targetUri,proto3" json:"target_uri,omitempty"`
+	SpaceId              int64          `protobuf:"varint,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	PartId               int64          `protobuf:"varint,4,opt,name=part_id,json=partId,proto3" json:"part_id,omitempty"`
+	Incremental          bool           `protobuf:"varint,5,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	StartTime            int64          `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64          `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	TotalSize            int64          `protobuf:"varint,8,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Files                []*CatalogFile `protobuf:"bytes,9,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CatalogEntry) Reset()         { *m = CatalogEntry{} }
+func (m *CatalogEntry) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntry) ProtoMessage()    {}
+func (*CatalogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{17}
+}
+func (m *CatalogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CatalogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CatalogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CatalogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntry.Merge(m, src)
+}
+func (m *CatalogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CatalogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntry.DiscardUnknown(m)
 }
 
-type storageServiceClient struct {
-	cc *grpc.ClientConn
+var xxx_messageInfo_CatalogEntry proto.InternalMessageInfo
+
+func (m *CatalogEntry) GetSourcePath() string {
+	if m != nil {
+		return m.SourcePath
+	}
+	return ""
 }
 
-func NewStorageServiceClient(cc *grpc.ClientConn) StorageServiceClient {
-	return &storageServiceClient{cc}
+func (m *CatalogEntry) GetTargetUri() string {
+	if m != nil {
+		return m.TargetUri
+	}
+	return ""
 }
 
-func (c *storageServiceClient) UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error) {
-	out := new(UploadFileResponse)
-	err := c.cc.Invoke(ctx, "/proto.StorageService/UploadFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CatalogEntry) GetSpaceId() int64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *storageServiceClient) IncrUploadFile(ctx context.Context, in *IncrUploadFileRequest, opts ...grpc.CallOption) (*IncrUploadFileResponse, error) {
-	out := new(IncrUploadFileResponse)
-	err := c.cc.Invoke(ctx, "/proto.StorageService/IncrUploadFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CatalogEntry) GetPartId() int64 {
+	if m != nil {
+		return m.PartId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *storageServiceClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (*DownloadFileResponse, error) {
-	out := new(DownloadFileResponse)
-	err := c.cc.Invoke(ctx, "/proto.StorageService/DownloadFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CatalogEntry) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
 	}
-	return out, nil
+	return false
 }
 
-func (c *storageServiceClient) MoveDir(ctx context.Context, in *MoveDirRequest, opts ...grpc.CallOption) (*MoveDirResponse, error) {
-	out := new(MoveDirResponse)
-	err := c.cc.Invoke(ctx, "/proto.StorageService/MoveDir", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CatalogEntry) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *storageServiceClient) RemoveDir(ctx context.Context, in *RemoveDirRequest, opts ...grpc.CallOption) (*RemoveDirResponse, error) {
-	out := new(RemoveDirResponse)
-	err := c.cc.Invoke(ctx, "/proto.StorageService/RemoveDir", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CatalogEntry) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *storageServiceClient) ExistDir(ctx context.Context, in *ExistDirRequest, opts ...grpc.CallOption) (*ExistDirResponse, error) {
-	out := new(ExistDirResponse)
-	err := c.cc.Invoke(ctx, "/proto.StorageService/ExistDir", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CatalogEntry) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
 	}
-	return out, nil
+	return 0
 }
 
-// StorageServiceServer is the server API for StorageService service.
-type StorageServiceServer interface {
-	// UploadFile upload file from agent machine to external storage
-	UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error)
-	// IncrUploadFile upload incremental file from agent machine to external storage
-	IncrUploadFile(context.Context, *IncrUploadFileRequest) (*IncrUploadFileResponse, error)
-	// DownloadFile download file from external storage to agent machine
-	DownloadFile(context.Context, *DownloadFileRequest) (*DownloadFileResponse, error)
-	// MoveDir rename dir in agent machine
-	MoveDir(context.Context, *MoveDirRequest) (*MoveDirResponse, error)
-	// RemoveDir delete dir in agent machine
-	RemoveDir(context.Context, *RemoveDirRequest) (*RemoveDirResponse, error)
-	// ExistDir check if dir in agent machine exist
-	ExistDir(context.Context, *ExistDirRequest) (*ExistDirResponse, error)
+func (m *CatalogEntry) GetFiles() []*CatalogFile {
+	if m != nil {
+		return m.Files
+	}
+	return nil
 }
 
-// UnimplementedStorageServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedStorageServiceServer struct {
+// BackupCatalog records all uploads in one session from one agent
+type BackupCatalog struct {
+	SessionId            string          `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Host                 string          `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	AgentVersion         string          `protobuf:"bytes,3,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
+	CreateTime           int64           `protobuf:"varint,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime           int64           `protobuf:"varint,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Entries              []*CatalogEntry `protobuf:"bytes,6,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (*UnimplementedStorageServiceServer) UploadFile(ctx context.Context, req *UploadFileRequest) (*UploadFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+func (m *BackupCatalog) Reset()         { *m = BackupCatalog{} }
+func (m *BackupCatalog) String() string { return proto.CompactTextString(m) }
+func (*BackupCatalog) ProtoMessage()    {}
+func (*BackupCatalog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{18}
 }
-func (*UnimplementedStorageServiceServer) IncrUploadFile(ctx context.Context, req *IncrUploadFileRequest) (*IncrUploadFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncrUploadFile not implemented")
+func (m *BackupCatalog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedStorageServiceServer) DownloadFile(ctx context.Context, req *DownloadFileRequest) (*DownloadFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+func (m *BackupCatalog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupCatalog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedStorageServiceServer) MoveDir(ctx context.Context, req *MoveDirRequest) (*MoveDirResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MoveDir not implemented")
+func (m *BackupCatalog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupCatalog.Merge(m, src)
 }
-func (*UnimplementedStorageServiceServer) RemoveDir(ctx context.Context, req *RemoveDirRequest) (*RemoveDirResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveDir not implemented")
+func (m *BackupCatalog) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedStorageServiceServer) ExistDir(ctx context.Context, req *ExistDirRequest) (*ExistDirResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExistDir not implemented")
+func (m *BackupCatalog) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupCatalog.DiscardUnknown(m)
 }
 
-func RegisterStorageServiceServer(s *grpc.Server, srv StorageServiceServer) {
-	s.RegisterService(&_StorageService_serviceDesc, srv)
-}
+var xxx_messageInfo_BackupCatalog proto.InternalMessageInfo
 
-func _StorageService_UploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BackupCatalog) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
 	}
-	if interceptor == nil {
-		return srv.(StorageServiceServer).UploadFile(ctx, in)
+	return ""
+}
+
+func (m *BackupCatalog) GetHost() string {
+	if m != nil {
+		return m.Host
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.StorageService/UploadFile",
+	return ""
+}
+
+func (m *BackupCatalog) GetAgentVersion() string {
+	if m != nil {
+		return m.AgentVersion
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UploadFile(ctx, req.(*UploadFileRequest))
+	return ""
+}
+
+func (m *BackupCatalog) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _StorageService_IncrUploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncrUploadFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BackupCatalog) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
 	}
-	if interceptor == nil {
-		return srv.(StorageServiceServer).IncrUploadFile(ctx, in)
+	return 0
+}
+
+func (m *BackupCatalog) GetEntries() []*CatalogEntry {
+	if m != nil {
+		return m.Entries
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.StorageService/IncrUploadFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).IncrUploadFile(ctx, req.(*IncrUploadFileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _StorageService_DownloadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownloadFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StorageServiceServer).DownloadFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.StorageService/DownloadFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).DownloadFile(ctx, req.(*DownloadFileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DescribeBackupRequest struct {
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// the catalog dir, or a single catalog file
+	Catalog              *Backend `protobuf:"bytes,2,opt,name=catalog,proto3" json:"catalog,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _StorageService_MoveDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MoveDirRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StorageServiceServer).MoveDir(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.StorageService/MoveDir",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).MoveDir(ctx, req.(*MoveDirRequest))
+func (m *DescribeBackupRequest) Reset()         { *m = DescribeBackupRequest{} }
+func (m *DescribeBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeBackupRequest) ProtoMessage()    {}
+func (*DescribeBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{19}
+}
+func (m *DescribeBackupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeBackupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DescribeBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeBackupRequest.Merge(m, src)
+}
+func (m *DescribeBackupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeBackupRequest.DiscardUnknown(m)
 }
 
-func _StorageService_RemoveDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveDirRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StorageServiceServer).RemoveDir(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.StorageService/RemoveDir",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).RemoveDir(ctx, req.(*RemoveDirRequest))
+var xxx_messageInfo_DescribeBackupRequest proto.InternalMessageInfo
+
+func (m *DescribeBackupRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _StorageService_ExistDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExistDirRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StorageServiceServer).ExistDir(ctx, in)
+func (m *DescribeBackupRequest) GetCatalog() *Backend {
+	if m != nil {
+		return m.Catalog
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.StorageService/ExistDir",
+	return nil
+}
+
+type DescribeBackupResponse struct {
+	Catalogs             []*BackupCatalog `protobuf:"bytes,1,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DescribeBackupResponse) Reset()         { *m = DescribeBackupResponse{} }
+func (m *DescribeBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeBackupResponse) ProtoMessage()    {}
+func (*DescribeBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{20}
+}
+func (m *DescribeBackupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeBackupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).ExistDir(ctx, req.(*ExistDirRequest))
+}
+func (m *DescribeBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeBackupResponse.Merge(m, src)
+}
+func (m *DescribeBackupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeBackupResponse proto.InternalMessageInfo
+
+func (m *DescribeBackupResponse) GetCatalogs() []*BackupCatalog {
+	if m != nil {
+		return m.Catalogs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _StorageService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.StorageService",
-	HandlerType: (*StorageServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UploadFile",
-			Handler:    _StorageService_UploadFile_Handler,
-		},
-		{
-			MethodName: "IncrUploadFile",
-			Handler:    _StorageService_IncrUploadFile_Handler,
-		},
-		{
-			MethodName: "DownloadFile",
-			Handler:    _StorageService_DownloadFile_Handler,
-		},
-		{
-			MethodName: "MoveDir",
-			Handler:    _StorageService_MoveDir_Handler,
-		},
-		{
-			MethodName: "RemoveDir",
-			Handler:    _StorageService_RemoveDir_Handler,
-		},
-		{
-			MethodName: "ExistDir",
-			Handler:    _StorageService_ExistDir_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "storage.proto",
+type VerifyBackupRequest struct {
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// the remote prefix to audit
+	Backend *Backend `protobuf:"bytes,2,opt,name=backend,proto3" json:"backend,omitempty"`
+	// the catalog dir, or a single catalog file
+	Catalog *Backend `protobuf:"bytes,3,opt,name=catalog,proto3" json:"catalog,omitempty"`
+	// download and re-hash the object content
+	Rehash               bool     `protobuf:"varint,4,opt,name=rehash,proto3" json:"rehash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Local) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VerifyBackupRequest) Reset()         { *m = VerifyBackupRequest{} }
+func (m *VerifyBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyBackupRequest) ProtoMessage()    {}
+func (*VerifyBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{21}
+}
+func (m *VerifyBackupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyBackupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *VerifyBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyBackupRequest.Merge(m, src)
+}
+func (m *VerifyBackupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyBackupRequest.DiscardUnknown(m)
 }
 
-func (m *Local) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_VerifyBackupRequest proto.InternalMessageInfo
+
+func (m *VerifyBackupRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
 }
 
-func (m *Local) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *VerifyBackupRequest) GetBackend() *Backend {
+	if m != nil {
+		return m.Backend
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *VerifyBackupRequest) GetCatalog() *Backend {
+	if m != nil {
+		return m.Catalog
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *S3) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VerifyBackupRequest) GetRehash() bool {
+	if m != nil {
+		return m.Rehash
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *S3) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the report could be got from JobService by job id
+type VerifyBackupResponse struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *S3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.SecretKey)))
-		i--
-		dAtA[i] = 0x3a
+func (m *VerifyBackupResponse) Reset()         { *m = VerifyBackupResponse{} }
+func (m *VerifyBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyBackupResponse) ProtoMessage()    {}
+func (*VerifyBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{22}
+}
+func (m *VerifyBackupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyBackupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AccessKey) > 0 {
-		i -= len(m.AccessKey)
-		copy(dAtA[i:], m.AccessKey)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.AccessKey)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *VerifyBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyBackupResponse.Merge(m, src)
+}
+func (m *VerifyBackupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyBackupResponse proto.InternalMessageInfo
+
+func (m *VerifyBackupResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	if len(m.StorageClass) > 0 {
-		i -= len(m.StorageClass)
-		copy(dAtA[i:], m.StorageClass)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.StorageClass)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Bucket) > 0 {
-		i -= len(m.Bucket)
-		copy(dAtA[i:], m.Bucket)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Bucket)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Region) > 0 {
-		i -= len(m.Region)
-		copy(dAtA[i:], m.Region)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Region)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GS) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type PruneDedupPoolRequest struct {
+	SessionId            string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Pool                 *Backend `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GS) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PruneDedupPoolRequest) Reset()         { *m = PruneDedupPoolRequest{} }
+func (m *PruneDedupPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*PruneDedupPoolRequest) ProtoMessage()    {}
+func (*PruneDedupPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{23}
 }
-
-func (m *GS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Credentials) > 0 {
-		i -= len(m.Credentials)
-		copy(dAtA[i:], m.Credentials)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Credentials)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Bucket) > 0 {
-		i -= len(m.Bucket)
-		copy(dAtA[i:], m.Bucket)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Bucket)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PruneDedupPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Backend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PruneDedupPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruneDedupPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Backend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PruneDedupPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneDedupPoolRequest.Merge(m, src)
+}
+func (m *PruneDedupPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruneDedupPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneDedupPoolRequest.DiscardUnknown(m)
 }
 
-func (m *Backend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_PruneDedupPoolRequest proto.InternalMessageInfo
+
+func (m *PruneDedupPoolRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
 	}
-	if m.Storage != nil {
-		{
-			size := m.Storage.Size()
-			i -= size
-			if _, err := m.Storage.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return ""
+}
+
+func (m *PruneDedupPoolRequest) GetPool() *Backend {
+	if m != nil {
+		return m.Pool
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Backend_Local) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PruneDedupPoolResponse struct {
+	Removed              int64    `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Backend_Local) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Local != nil {
-		{
-			size, err := m.Local.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStorage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PruneDedupPoolResponse) Reset()         { *m = PruneDedupPoolResponse{} }
+func (m *PruneDedupPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*PruneDedupPoolResponse) ProtoMessage()    {}
+func (*PruneDedupPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{24}
 }
-func (m *Backend_S3) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PruneDedupPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Backend_S3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.S3 != nil {
-		{
-			size, err := m.S3.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStorage(dAtA, i, uint64(size))
+func (m *PruneDedupPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruneDedupPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Backend_Gs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PruneDedupPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneDedupPoolResponse.Merge(m, src)
+}
+func (m *PruneDedupPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruneDedupPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneDedupPoolResponse.DiscardUnknown(m)
 }
 
-func (m *Backend_Gs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Gs != nil {
-		{
-			size, err := m.Gs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStorage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_PruneDedupPoolResponse proto.InternalMessageInfo
+
+func (m *PruneDedupPoolResponse) GetRemoved() int64 {
+	if m != nil {
+		return m.Removed
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *UploadFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+
+func init() {
+	proto.RegisterType((*Local)(nil), "proto.Local")
+	proto.RegisterType((*S3)(nil), "proto.S3")
+	proto.RegisterType((*GS)(nil), "proto.GS")
+	proto.RegisterType((*Backend)(nil), "proto.Backend")
+	proto.RegisterType((*UploadFileRequest)(nil), "proto.UploadFileRequest")
+	proto.RegisterType((*UploadFileResponse)(nil), "proto.UploadFileResponse")
+	proto.RegisterType((*IncrUploadFileRequest)(nil), "proto.IncrUploadFileRequest")
+	proto.RegisterType((*IncrUploadFileResponse)(nil), "proto.IncrUploadFileResponse")
+	proto.RegisterType((*DownloadFileRequest)(nil), "proto.DownloadFileRequest")
+	proto.RegisterType((*DownloadFileResponse)(nil), "proto.DownloadFileResponse")
+	proto.RegisterType((*MoveDirRequest)(nil), "proto.MoveDirRequest")
+	proto.RegisterType((*MoveDirResponse)(nil), "proto.MoveDirResponse")
+	proto.RegisterType((*RemoveDirRequest)(nil), "proto.RemoveDirRequest")
+	proto.RegisterType((*RemoveDirResponse)(nil), "proto.RemoveDirResponse")
+	proto.RegisterType((*ExistDirRequest)(nil), "proto.ExistDirRequest")
+	proto.RegisterType((*ExistDirResponse)(nil), "proto.ExistDirResponse")
+	proto.RegisterType((*CatalogFile)(nil), "proto.CatalogFile")
+	proto.RegisterType((*CatalogEntry)(nil), "proto.CatalogEntry")
+	proto.RegisterType((*BackupCatalog)(nil), "proto.BackupCatalog")
+	proto.RegisterType((*DescribeBackupRequest)(nil), "proto.DescribeBackupRequest")
+	proto.RegisterType((*DescribeBackupResponse)(nil), "proto.DescribeBackupResponse")
+	proto.RegisterType((*VerifyBackupRequest)(nil), "proto.VerifyBackupRequest")
+	proto.RegisterType((*VerifyBackupResponse)(nil), "proto.VerifyBackupResponse")
+	proto.RegisterType((*PruneDedupPoolRequest)(nil), "proto.PruneDedupPoolRequest")
+	proto.RegisterType((*PruneDedupPoolResponse)(nil), "proto.PruneDedupPoolResponse")
 }
 
-func (m *UploadFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
+
+var fileDescriptor_0d2c4ccf1453ffdb = []byte{
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x13, 0x0d, 0x25, 0x4b, 0x14, 0x47, 0xb6, 0x92, 0xac, 0x6d, 0x85, 0x91, 0x7f, 0x76, 0x0c, 0xfe,
+	0xda, 0xc2, 0x17, 0x07, 0x85, 0x0c, 0x17, 0x05, 0x0a, 0x14, 0xa8, 0xe3, 0xc4, 0x71, 0x93, 0x00,
+	0x06, 0xd5, 0xf8, 0xd0, 0x0b, 0x4b, 0x91, 0x13, 0x99, 0x31, 0xc5, 0x55, 0x77, 0x57, 0x6e, 0x9d,
+	0x7b, 0x0f, 0xfd, 0x06, 0x3d, 0xf6, 0xd6, 0x8f, 0xd2, 0x1e, 0x7b, 0xec, 0xa5, 0x40, 0xe1, 0x7e,
+	0x8d, 0x1e, 0x8a, 0xfd, 0x23, 0x8a, 0x92, 0xe9, 0xd8, 0x06, 0x7a, 0xb2, 0xf7, 0xbd, 0xd9, 0xd9,
+	0x79, 0x8f, 0xb3, 0xb3, 0x82, 0x25, 0x2e, 0x28, 0x0b, 0x07, 0xf8, 0x78, 0xc4, 0xa8, 0xa0, 0xa4,
+	0xa6, 0xfe, 0x78, 0x6b, 0x50, 0x7b, 0x49, 0xa3, 0x30, 0x25, 0x04, 0x16, 0x46, 0xa1, 0x38, 0x71,
+	0xad, 0x4d, 0x6b, 0xcb, 0xf1, 0xd5, 0xff, 0xde, 0xaf, 0x16, 0x54, 0x7a, 0x3b, 0xa4, 0x03, 0x0d,
+	0xcc, 0xe2, 0x11, 0x4d, 0x32, 0x61, 0xe8, 0x7c, 0x4d, 0xda, 0x50, 0x67, 0x38, 0x48, 0x68, 0xe6,
+	0x56, 0x14, 0x63, 0x56, 0x12, 0xef, 0x8f, 0xa3, 0x53, 0x14, 0x6e, 0x55, 0xe3, 0x7a, 0x95, 0x1f,
+	0xb3, 0x30, 0x3d, 0x86, 0xfc, 0x3f, 0xaf, 0x2d, 0x88, 0xd2, 0x90, 0x73, 0xb7, 0xa6, 0xc8, 0x45,
+	0x03, 0x3e, 0x91, 0x18, 0x59, 0x07, 0x08, 0xa3, 0x08, 0x39, 0x0f, 0x4e, 0xf1, 0xdc, 0xad, 0xab,
+	0x08, 0x47, 0x23, 0x2f, 0xf0, 0x5c, 0xd2, 0x1c, 0x23, 0x86, 0x42, 0xd1, 0xb6, 0xa6, 0x35, 0xf2,
+	0x02, 0xcf, 0x3d, 0x1f, 0x2a, 0x07, 0xbd, 0x42, 0x51, 0x56, 0x69, 0x51, 0x95, 0x42, 0x51, 0x9b,
+	0xd0, 0x8c, 0x18, 0xc6, 0x98, 0x89, 0x24, 0x4c, 0xb9, 0x51, 0x51, 0x84, 0x3c, 0x01, 0xf6, 0x5e,
+	0x18, 0x9d, 0x62, 0x16, 0x93, 0x0f, 0xa0, 0x96, 0x4a, 0x17, 0x55, 0xde, 0x66, 0x77, 0x51, 0x7b,
+	0xfc, 0x58, 0x39, 0xfb, 0xfc, 0x8e, 0xaf, 0x49, 0xb2, 0x06, 0x15, 0xbe, 0xa3, 0x0e, 0x69, 0x76,
+	0x1d, 0x13, 0xd2, 0xdb, 0x79, 0x7e, 0xc7, 0xaf, 0xf0, 0x1d, 0x49, 0x0e, 0xf4, 0x31, 0x53, 0xf2,
+	0xa0, 0x27, 0xc9, 0x01, 0xdf, 0x73, 0xc0, 0x36, 0x66, 0x78, 0x3f, 0x54, 0xe0, 0xfe, 0xeb, 0x51,
+	0x4a, 0xc3, 0xf8, 0x59, 0x92, 0xa2, 0x8f, 0xdf, 0x8e, 0x91, 0x0b, 0x2d, 0x9f, 0xf3, 0x84, 0x66,
+	0x41, 0x12, 0x1b, 0x75, 0x8e, 0x41, 0x0e, 0x63, 0x29, 0x86, 0x61, 0x34, 0x66, 0x3c, 0x39, 0xc3,
+	0xf4, 0x5c, 0x95, 0xd0, 0xf0, 0x8b, 0x10, 0x79, 0x04, 0x4d, 0x4e, 0xc7, 0x2c, 0xc2, 0x40, 0x39,
+	0xa1, 0xe5, 0x82, 0x86, 0x8e, 0xa4, 0x1f, 0xbb, 0xd0, 0x12, 0x21, 0x1b, 0xa0, 0x08, 0xfa, 0x5a,
+	0xb4, 0xfa, 0x84, 0xcd, 0x6e, 0xcb, 0xd4, 0x6a, 0xac, 0xf0, 0x97, 0x74, 0x94, 0x59, 0x92, 0x6d,
+	0x80, 0x18, 0xe3, 0xf1, 0x28, 0x18, 0x51, 0x9a, 0xba, 0xb5, 0xd2, 0x2d, 0x8e, 0x8a, 0x38, 0xa2,
+	0x34, 0x25, 0x5b, 0x60, 0x47, 0xa1, 0x08, 0x53, 0x3a, 0x70, 0xeb, 0xa5, 0xb1, 0x13, 0xda, 0x5b,
+	0x01, 0x52, 0xb4, 0x81, 0x8f, 0x68, 0xc6, 0xd1, 0xfb, 0xc7, 0x82, 0xd5, 0xc3, 0x2c, 0x62, 0xb7,
+	0x76, 0x68, 0x4e, 0x7f, 0xe5, 0x06, 0xfa, 0xab, 0x37, 0xd1, 0xef, 0xc1, 0x52, 0x44, 0x87, 0xc3,
+	0x44, 0x04, 0x29, 0x1d, 0x04, 0x89, 0x76, 0xad, 0xea, 0x37, 0x35, 0xf8, 0x92, 0x0e, 0x0e, 0x63,
+	0xb2, 0x01, 0xcd, 0x34, 0xe4, 0x79, 0x44, 0x4d, 0x45, 0x38, 0x12, 0xd2, 0xfc, 0xcd, 0x4d, 0x71,
+	0xa1, 0x3d, 0xaf, 0xde, 0x18, 0xf3, 0xa7, 0x05, 0xcb, 0xfb, 0xf4, 0xbb, 0xec, 0x3f, 0x6f, 0x9c,
+	0x5d, 0x68, 0x19, 0xe3, 0xae, 0xf1, 0x45, 0x47, 0x99, 0xa5, 0xf4, 0xdb, 0xd8, 0x59, 0x18, 0x07,
+	0xa0, 0x21, 0xe5, 0xf7, 0xed, 0x1a, 0xc7, 0x6b, 0xc3, 0xca, 0xac, 0x3c, 0xa3, 0xfb, 0x19, 0xb4,
+	0x5e, 0xd1, 0x33, 0xdc, 0x4f, 0xd8, 0x44, 0xf1, 0x43, 0x68, 0x70, 0x16, 0x05, 0x85, 0x61, 0x67,
+	0x73, 0x16, 0xa9, 0x33, 0x1f, 0x42, 0x23, 0xe6, 0xa2, 0xd8, 0x01, 0x76, 0xcc, 0x55, 0x39, 0xde,
+	0x7d, 0xb8, 0x9b, 0xe7, 0x31, 0xa9, 0x3f, 0x82, 0x7b, 0x3e, 0x0e, 0x67, 0x93, 0x97, 0x4d, 0xd1,
+	0x65, 0xb8, 0x5f, 0x88, 0x33, 0x9b, 0x3f, 0x84, 0xbb, 0x4f, 0xbf, 0x4f, 0xb8, 0xb8, 0x66, 0xef,
+	0x16, 0xdc, 0x9b, 0x86, 0xe9, 0xad, 0x64, 0x05, 0x6a, 0x28, 0x31, 0x15, 0xd8, 0xf0, 0xf5, 0xc2,
+	0x3b, 0x86, 0xe6, 0x13, 0xdd, 0x05, 0x52, 0x7f, 0x59, 0x32, 0xb2, 0x06, 0xce, 0x9b, 0x24, 0xc5,
+	0x80, 0x27, 0xef, 0x50, 0xe9, 0xab, 0xfa, 0x0d, 0x09, 0xf4, 0x92, 0x77, 0x28, 0x67, 0x23, 0x3f,
+	0x09, 0xbb, 0xbb, 0x9f, 0x4c, 0x06, 0xb6, 0x5e, 0x79, 0xbf, 0x54, 0x60, 0xd1, 0x24, 0x7e, 0x9a,
+	0x09, 0x76, 0x69, 0x52, 0x58, 0x97, 0x6e, 0xca, 0x3a, 0x98, 0xef, 0x18, 0x8c, 0x59, 0x62, 0x7c,
+	0x74, 0x34, 0xf2, 0x9a, 0x25, 0xca, 0xff, 0x51, 0x18, 0xa1, 0xec, 0xb7, 0xaa, 0x2a, 0xc2, 0x56,
+	0xeb, 0xc3, 0x98, 0x3c, 0x00, 0x7b, 0x14, 0x32, 0x31, 0xbd, 0x26, 0x75, 0xb9, 0xd4, 0x6d, 0x98,
+	0x64, 0x11, 0xc3, 0x21, 0x66, 0x22, 0xd4, 0xdd, 0xd0, 0xf0, 0x8b, 0x90, 0xea, 0x63, 0x21, 0xf7,
+	0x8a, 0x64, 0x88, 0xea, 0x9a, 0x54, 0x7d, 0x47, 0x21, 0x5f, 0x25, 0x43, 0x94, 0x87, 0x62, 0x16,
+	0x6b, 0xd2, 0xd6, 0x87, 0x62, 0x16, 0x2b, 0x4a, 0x96, 0x4b, 0x45, 0x98, 0x6a, 0x5b, 0x1a, 0x7a,
+	0xa7, 0x42, 0x94, 0x2f, 0x5b, 0x50, 0x93, 0x1e, 0x71, 0xd7, 0xd9, 0xac, 0x6e, 0x35, 0xbb, 0xc4,
+	0xb4, 0x60, 0xc1, 0x6b, 0x5f, 0x07, 0x78, 0x7f, 0x58, 0xb0, 0x24, 0x3b, 0x73, 0x3c, 0x32, 0xe4,
+	0x75, 0x97, 0x8b, 0xc0, 0xc2, 0x09, 0xe5, 0x62, 0xf2, 0xec, 0xc8, 0xff, 0xe5, 0x5b, 0x18, 0x0e,
+	0x30, 0x13, 0xc1, 0x19, 0x32, 0x19, 0x66, 0xbe, 0xc6, 0xa2, 0x02, 0x8f, 0x35, 0x26, 0x3f, 0x41,
+	0xc4, 0x30, 0x14, 0xa8, 0x05, 0x69, 0xaf, 0x40, 0x43, 0x4a, 0xd3, 0x23, 0x68, 0x8e, 0x47, 0x71,
+	0x1e, 0xa0, 0x27, 0x0a, 0x68, 0x48, 0x05, 0x6c, 0x83, 0x8d, 0x99, 0x60, 0x09, 0x72, 0xb7, 0xae,
+	0x74, 0x2d, 0xcf, 0xea, 0x52, 0x9f, 0xda, 0x9f, 0xc4, 0x78, 0xdf, 0xc0, 0xea, 0x3e, 0xf2, 0x88,
+	0x25, 0x7d, 0xd4, 0x0a, 0x6f, 0x38, 0x3e, 0x0a, 0x93, 0xab, 0xf2, 0xfe, 0xc9, 0xf5, 0x25, 0xb4,
+	0xe7, 0x4f, 0x30, 0xed, 0xfe, 0x31, 0x34, 0x4c, 0x10, 0x77, 0x2d, 0x55, 0xeb, 0x4a, 0x21, 0x49,
+	0x6e, 0xb6, 0x9f, 0x47, 0x79, 0x3f, 0x5b, 0xb0, 0x7c, 0x8c, 0x2c, 0x79, 0x73, 0x7e, 0xdb, 0x62,
+	0x27, 0x23, 0xec, 0x8a, 0x62, 0x0d, 0x5d, 0x94, 0x55, 0x7d, 0xaf, 0x2c, 0xfd, 0xf3, 0xe8, 0x24,
+	0xe4, 0x7a, 0xc2, 0x35, 0x7c, 0xb3, 0xf2, 0xb6, 0x61, 0x65, 0xb6, 0x42, 0x23, 0x76, 0x15, 0xea,
+	0x6f, 0x69, 0x7f, 0x5a, 0x5e, 0xed, 0x2d, 0xed, 0x1f, 0xc6, 0xde, 0xd7, 0xb0, 0x7a, 0xc4, 0xc6,
+	0x19, 0xee, 0x4f, 0xe6, 0xdd, 0x0d, 0x25, 0x79, 0xb0, 0xa0, 0xc6, 0x67, 0xb9, 0x1e, 0xc5, 0x79,
+	0x5d, 0x68, 0xcf, 0xe7, 0x36, 0xc5, 0xb8, 0x60, 0x33, 0x35, 0xb8, 0x74, 0xe6, 0xaa, 0x3f, 0x59,
+	0x76, 0x7f, 0xac, 0x41, 0xab, 0xa7, 0x7f, 0x90, 0xf4, 0x90, 0x9d, 0x25, 0x11, 0x92, 0x2f, 0x00,
+	0xa6, 0xcf, 0x0e, 0x71, 0xcd, 0x51, 0x97, 0xde, 0xe1, 0xce, 0xc3, 0x12, 0xc6, 0x9c, 0xf7, 0x0a,
+	0x5a, 0xb3, 0xaf, 0x17, 0xf9, 0x9f, 0x09, 0x2e, 0x7d, 0xd2, 0x3b, 0xeb, 0x57, 0xb0, 0x26, 0xdd,
+	0x01, 0x2c, 0x16, 0x9f, 0x04, 0xd2, 0x31, 0xe1, 0x25, 0xcf, 0x60, 0x67, 0xad, 0x94, 0x33, 0x89,
+	0x3e, 0x05, 0xdb, 0xcc, 0x7e, 0xb2, 0x6a, 0xe2, 0x66, 0xdf, 0x94, 0x4e, 0x7b, 0x1e, 0x36, 0x3b,
+	0x3f, 0x07, 0x27, 0x1f, 0xfd, 0xe4, 0x81, 0x09, 0x9a, 0x7f, 0x34, 0x3a, 0xee, 0x65, 0xc2, 0xec,
+	0xff, 0x0c, 0x1a, 0x93, 0xf1, 0x4f, 0x26, 0x67, 0xcc, 0x3d, 0x1b, 0x9d, 0x07, 0x97, 0xf0, 0xa9,
+	0x9d, 0xb3, 0x57, 0x2a, 0xb7, 0xb3, 0xf4, 0x2e, 0x77, 0xd6, 0xaf, 0x60, 0xa7, 0x76, 0x16, 0x5b,
+	0x36, 0xb7, 0xb3, 0xe4, 0xa6, 0x75, 0xd6, 0x4a, 0xb9, 0x69, 0x5d, 0xb3, 0x0d, 0x97, 0xd7, 0x55,
+	0xda, 0xe3, 0x9d, 0xf5, 0x2b, 0x58, 0x9d, 0x6e, 0xef, 0xde, 0x6f, 0x17, 0x1b, 0xd6, 0xef, 0x17,
+	0x1b, 0xd6, 0x5f, 0x17, 0x1b, 0xd6, 0x4f, 0x7f, 0x6f, 0xdc, 0xe9, 0xd7, 0x55, 0xfc, 0xce, 0xbf,
+	0x03, 0x00, 0x7a, 0x35, 0xd5, 0x8c, 0xf2, 0x0c, 0x00, 0x00,
 }
 
-func (m *UploadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.TargetBackend != nil {
-		{
-			size, err := m.TargetBackend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStorage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourcePath) > 0 {
-		i -= len(m.SourcePath)
-		copy(dAtA[i:], m.SourcePath)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.SourcePath)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Recursively {
-		i--
-		if m.Recursively {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *UploadFileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StorageServiceClient is the client API for StorageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StorageServiceClient interface {
+	// UploadFile upload file from agent machine to external storage
+	UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error)
+	// IncrUploadFile upload incremental file from agent machine to external storage
+	IncrUploadFile(ctx context.Context, in *IncrUploadFileRequest, opts ...grpc.CallOption) (*IncrUploadFileResponse, error)
+	// DownloadFile download file from external storage to agent machine
+	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (*DownloadFileResponse, error)
+	// MoveDir rename dir in agent machine
+	MoveDir(ctx context.Context, in *MoveDirRequest, opts ...grpc.CallOption) (*MoveDirResponse, error)
+	// RemoveDir delete dir in agent machine
+	RemoveDir(ctx context.Context, in *RemoveDirRequest, opts ...grpc.CallOption) (*RemoveDirResponse, error)
+	// ExistDir check if dir in agent machine exist
+	ExistDir(ctx context.Context, in *ExistDirRequest, opts ...grpc.CallOption) (*ExistDirResponse, error)
+	// DescribeBackup read back the catalogs written by agents when uploading
+	DescribeBackup(ctx context.Context, in *DescribeBackupRequest, opts ...grpc.CallOption) (*DescribeBackupResponse, error)
+	// VerifyBackup audit the remote backup against its catalogs in background job
+	VerifyBackup(ctx context.Context, in *VerifyBackupRequest, opts ...grpc.CallOption) (*VerifyBackupResponse, error)
+	// PruneDedupPool remove the objects in dedup pool not referenced by any backup
+	PruneDedupPool(ctx context.Context, in *PruneDedupPoolRequest, opts ...grpc.CallOption) (*PruneDedupPoolResponse, error)
 }
 
-func (m *UploadFileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type storageServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *UploadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+func NewStorageServiceClient(cc *grpc.ClientConn) StorageServiceClient {
+	return &storageServiceClient{cc}
 }
 
-func (m *IncrUploadFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *storageServiceClient) UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error) {
+	out := new(UploadFileResponse)
+	err := c.cc.Invoke(ctx, "/proto.StorageService/UploadFile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IncrUploadFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *IncrUploadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LastLogId != 0 {
-		i = encodeVarintStorage(dAtA, i, uint64(m.LastLogId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.CommitLogId != 0 {
-		i = encodeVarintStorage(dAtA, i, uint64(m.CommitLogId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TargetBackend != nil {
-		{
-			size, err := m.TargetBackend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStorage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourcePath) > 0 {
-		i -= len(m.SourcePath)
-		copy(dAtA[i:], m.SourcePath)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.SourcePath)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0xa
+func (c *storageServiceClient) IncrUploadFile(ctx context.Context, in *IncrUploadFileRequest, opts ...grpc.CallOption) (*IncrUploadFileResponse, error) {
+	out := new(IncrUploadFileResponse)
+	err := c.cc.Invoke(ctx, "/proto.StorageService/IncrUploadFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *IncrUploadFileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *storageServiceClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (*DownloadFileResponse, error) {
+	out := new(DownloadFileResponse)
+	err := c.cc.Invoke(ctx, "/proto.StorageService/DownloadFile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IncrUploadFileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *IncrUploadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *storageServiceClient) MoveDir(ctx context.Context, in *MoveDirRequest, opts ...grpc.CallOption) (*MoveDirResponse, error) {
+	out := new(MoveDirResponse)
+	err := c.cc.Invoke(ctx, "/proto.StorageService/MoveDir", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DownloadFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *storageServiceClient) RemoveDir(ctx context.Context, in *RemoveDirRequest, opts ...grpc.CallOption) (*RemoveDirResponse, error) {
+	out := new(RemoveDirResponse)
+	err := c.cc.Invoke(ctx, "/proto.StorageService/RemoveDir", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DownloadFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *storageServiceClient) ExistDir(ctx context.Context, in *ExistDirRequest, opts ...grpc.CallOption) (*ExistDirResponse, error) {
+	out := new(ExistDirResponse)
+	err := c.cc.Invoke(ctx, "/proto.StorageService/ExistDir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DownloadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.TargetPath) > 0 {
-		i -= len(m.TargetPath)
-		copy(dAtA[i:], m.TargetPath)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.TargetPath)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SourceBackend != nil {
-		{
-			size, err := m.SourceBackend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStorage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Recursively {
-		i--
-		if m.Recursively {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SessionId) > 0 {
-		i -= len(m.SessionId)
-		copy(dAtA[i:], m.SessionId)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
-		i--
-		dAtA[i] = 0xa
+func (c *storageServiceClient) DescribeBackup(ctx context.Context, in *DescribeBackupRequest, opts ...grpc.CallOption) (*DescribeBackupResponse, error) {
+	out := new(DescribeBackupResponse)
+	err := c.cc.Invoke(ctx, "/proto.StorageService/DescribeBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DownloadFileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *storageServiceClient) VerifyBackup(ctx context.Context, in *VerifyBackupRequest, opts ...grpc.CallOption) (*VerifyBackupResponse, error) {
+	out := new(VerifyBackupResponse)
+	err := c.cc.Invoke(ctx, "/proto.StorageService/VerifyBackup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DownloadFileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *storageServiceClient) PruneDedupPool(ctx context.Context, in *PruneDedupPoolRequest, opts ...grpc.CallOption) (*PruneDedupPoolResponse, error) {
+	out := new(PruneDedupPoolResponse)
+	err := c.cc.Invoke(ctx, "/proto.StorageService/PruneDedupPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DownloadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+// StorageServiceServer is the server API for StorageService service.
+type StorageServiceServer interface {
+	// UploadFile upload file from agent machine to external storage
+	UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error)
+	// IncrUploadFile upload incremental file from agent machine to external storage
+	IncrUploadFile(context.Context, *IncrUploadFileRequest) (*IncrUploadFileResponse, error)
+	// DownloadFile download file from external storage to agent machine
+	DownloadFile(context.Context, *DownloadFileRequest) (*DownloadFileResponse, error)
+	// MoveDir rename dir in agent machine
+	MoveDir(context.Context, *MoveDirRequest) (*MoveDirResponse, error)
+	// RemoveDir delete dir in agent machine
+	RemoveDir(context.Context, *RemoveDirRequest) (*RemoveDirResponse, error)
+	// ExistDir check if dir in agent machine exist
+	ExistDir(context.Context, *ExistDirRequest) (*ExistDirResponse, error)
+	// DescribeBackup read back the catalogs written by agents when uploading
+	DescribeBackup(context.Context, *DescribeBackupRequest) (*DescribeBackupResponse, error)
+	// VerifyBackup audit the remote backup against its catalogs in background job
+	VerifyBackup(context.Context, *VerifyBackupRequest) (*VerifyBackupResponse, error)
+	// PruneDedupPool remove the objects in dedup pool not referenced by any backup
+	PruneDedupPool(context.Context, *PruneDedupPoolRequest) (*PruneDedupPoolResponse, error)
 }
 
-func (m *MoveDirRequest) Marshal() (dAtA []byte, err error) {
+// UnimplementedStorageServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedStorageServiceServer struct {
+}
+
+func (*UnimplementedStorageServiceServer) UploadFile(ctx context.Context, req *UploadFileRequest) (*UploadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (*UnimplementedStorageServiceServer) IncrUploadFile(ctx context.Context, req *IncrUploadFileRequest) (*IncrUploadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrUploadFile not implemented")
+}
+func (*UnimplementedStorageServiceServer) DownloadFile(ctx context.Context, req *DownloadFileRequest) (*DownloadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (*UnimplementedStorageServiceServer) MoveDir(ctx context.Context, req *MoveDirRequest) (*MoveDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveDir not implemented")
+}
+func (*UnimplementedStorageServiceServer) RemoveDir(ctx context.Context, req *RemoveDirRequest) (*RemoveDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDir not implemented")
+}
+func (*UnimplementedStorageServiceServer) ExistDir(ctx context.Context, req *ExistDirRequest) (*ExistDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExistDir not implemented")
+}
+func (*UnimplementedStorageServiceServer) DescribeBackup(ctx context.Context, req *DescribeBackupRequest) (*DescribeBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeBackup not implemented")
+}
+func (*UnimplementedStorageServiceServer) VerifyBackup(ctx context.Context, req *VerifyBackupRequest) (*VerifyBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBackup not implemented")
+}
+func (*UnimplementedStorageServiceServer) PruneDedupPool(ctx context.Context, req *PruneDedupPoolRequest) (*PruneDedupPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneDedupPool not implemented")
+}
+
+func RegisterStorageServiceServer(s *grpc.Server, srv StorageServiceServer) {
+	s.RegisterService(&_StorageService_serviceDesc, srv)
+}
+
+func _StorageService_UploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UploadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StorageService/UploadFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UploadFile(ctx, req.(*UploadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_IncrUploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrUploadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).IncrUploadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StorageService/IncrUploadFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).IncrUploadFile(ctx, req.(*IncrUploadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_DownloadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).DownloadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StorageService/DownloadFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).DownloadFile(ctx, req.(*DownloadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_MoveDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).MoveDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StorageService/MoveDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).MoveDir(ctx, req.(*MoveDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_RemoveDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).RemoveDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StorageService/RemoveDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).RemoveDir(ctx, req.(*RemoveDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_ExistDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExistDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).ExistDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StorageService/ExistDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).ExistDir(ctx, req.(*ExistDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_DescribeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).DescribeBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StorageService/DescribeBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).DescribeBackup(ctx, req.(*DescribeBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_VerifyBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).VerifyBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StorageService/VerifyBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).VerifyBackup(ctx, req.(*VerifyBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_PruneDedupPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneDedupPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).PruneDedupPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StorageService/PruneDedupPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).PruneDedupPool(ctx, req.(*PruneDedupPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StorageService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.StorageService",
+	HandlerType: (*StorageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadFile",
+			Handler:    _StorageService_UploadFile_Handler,
+		},
+		{
+			MethodName: "IncrUploadFile",
+			Handler:    _StorageService_IncrUploadFile_Handler,
+		},
+		{
+			MethodName: "DownloadFile",
+			Handler:    _StorageService_DownloadFile_Handler,
+		},
+		{
+			MethodName: "MoveDir",
+			Handler:    _StorageService_MoveDir_Handler,
+		},
+		{
+			MethodName: "RemoveDir",
+			Handler:    _StorageService_RemoveDir_Handler,
+		},
+		{
+			MethodName: "ExistDir",
+			Handler:    _StorageService_ExistDir_Handler,
+		},
+		{
+			MethodName: "DescribeBackup",
+			Handler:    _StorageService_DescribeBackup_Handler,
+		},
+		{
+			MethodName: "VerifyBackup",
+			Handler:    _StorageService_VerifyBackup_Handler,
+		},
+		{
+			MethodName: "PruneDedupPool",
+			Handler:    _StorageService_PruneDedupPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "storage.proto",
+}
+
+func (m *Local) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1827,12 +2079,12 @@ func (m *MoveDirRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MoveDirRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Local) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MoveDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Local) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1841,24 +2093,17 @@ func (m *MoveDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DstPath) > 0 {
-		i -= len(m.DstPath)
-		copy(dAtA[i:], m.DstPath)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.DstPath)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SrcPath) > 0 {
-		i -= len(m.SrcPath)
-		copy(dAtA[i:], m.SrcPath)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.SrcPath)))
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MoveDirResponse) Marshal() (dAtA []byte, err error) {
+func (m *S3) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1868,12 +2113,12 @@ func (m *MoveDirResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MoveDirResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *S3) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MoveDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *S3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1882,10 +2127,59 @@ func (m *MoveDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SecretKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AccessKey) > 0 {
+		i -= len(m.AccessKey)
+		copy(dAtA[i:], m.AccessKey)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.AccessKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StorageClass) > 0 {
+		i -= len(m.StorageClass)
+		copy(dAtA[i:], m.StorageClass)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.StorageClass)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bucket) > 0 {
+		i -= len(m.Bucket)
+		copy(dAtA[i:], m.Bucket)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Bucket)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveDirRequest) Marshal() (dAtA []byte, err error) {
+func (m *GS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1895,12 +2189,12 @@ func (m *RemoveDirRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveDirRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GS) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1909,17 +2203,31 @@ func (m *RemoveDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Credentials) > 0 {
+		i -= len(m.Credentials)
+		copy(dAtA[i:], m.Credentials)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Credentials)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Path) > 0 {
 		i -= len(m.Path)
 		copy(dAtA[i:], m.Path)
 		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bucket) > 0 {
+		i -= len(m.Bucket)
+		copy(dAtA[i:], m.Bucket)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Bucket)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveDirResponse) Marshal() (dAtA []byte, err error) {
+func (m *Backend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1929,12 +2237,12 @@ func (m *RemoveDirResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveDirResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Backend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Backend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1943,10 +2251,82 @@ func (m *RemoveDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Storage != nil {
+		{
+			size := m.Storage.Size()
+			i -= size
+			if _, err := m.Storage.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExistDirRequest) Marshal() (dAtA []byte, err error) {
+func (m *Backend_Local) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Backend_Local) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Local != nil {
+		{
+			size, err := m.Local.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Backend_S3) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Backend_S3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.S3 != nil {
+		{
+			size, err := m.S3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Backend_Gs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Backend_Gs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Gs != nil {
+		{
+			size, err := m.Gs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *UploadFileRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1956,12 +2336,12 @@ func (m *ExistDirRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExistDirRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UploadFileRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExistDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UploadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1970,17 +2350,70 @@ func (m *ExistDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
+	if m.Catalog != nil {
+		{
+			size, err := m.Catalog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DedupPool != nil {
+		{
+			size, err := m.DedupPool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TargetBackend != nil {
+		{
+			size, err := m.TargetBackend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourcePath) > 0 {
+		i -= len(m.SourcePath)
+		copy(dAtA[i:], m.SourcePath)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SourcePath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Recursively {
+		i--
+		if m.Recursively {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExistDirResponse) Marshal() (dAtA []byte, err error) {
+func (m *UploadFileResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1990,12 +2423,12 @@ func (m *ExistDirResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExistDirResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UploadFileResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExistDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UploadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2004,379 +2437,2663 @@ func (m *ExistDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Exist {
-		i--
-		if m.Exist {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStorage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStorage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *IncrUploadFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Local) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *IncrUploadFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *S3) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IncrUploadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	l = len(m.Region)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Bucket)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if m.Catalog != nil {
+		{
+			size, err := m.Catalog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if m.LastLogId != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.LastLogId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.StorageClass)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if m.CommitLogId != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.CommitLogId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.AccessKey)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if m.TargetBackend != nil {
+		{
+			size, err := m.TargetBackend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.SecretKey)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if len(m.SourcePath) > 0 {
+		i -= len(m.SourcePath)
+		copy(dAtA[i:], m.SourcePath)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SourcePath)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GS) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bucket)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	l = len(m.Credentials)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *IncrUploadFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Backend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IncrUploadFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncrUploadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Storage != nil {
-		n += m.Storage.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Backend_Local) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Local != nil {
-		l = m.Local.Size()
-		n += 1 + l + sovStorage(uint64(l))
+func (m *DownloadFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Backend_S3) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.S3 != nil {
-		l = m.S3.Size()
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	return n
+
+func (m *DownloadFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Backend_Gs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DownloadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Gs != nil {
-		l = m.Gs.Size()
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	return n
-}
-func (m *UploadFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if m.DedupPool != nil {
+		{
+			size, err := m.DedupPool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Recursively {
-		n += 2
+	if len(m.TargetPath) > 0 {
+		i -= len(m.TargetPath)
+		copy(dAtA[i:], m.TargetPath)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.TargetPath)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.SourcePath)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if m.SourceBackend != nil {
+		{
+			size, err := m.SourceBackend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.TargetBackend != nil {
-		l = m.TargetBackend.Size()
-		n += 1 + l + sovStorage(uint64(l))
+	if m.Recursively {
+		i--
+		if m.Recursively {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UploadFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DownloadFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IncrUploadFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	l = len(m.SourcePath)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	if m.TargetBackend != nil {
-		l = m.TargetBackend.Size()
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	if m.CommitLogId != 0 {
-		n += 1 + sovStorage(uint64(m.CommitLogId))
-	}
-	if m.LastLogId != 0 {
-		n += 1 + sovStorage(uint64(m.LastLogId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *DownloadFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncrUploadFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DownloadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DownloadFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MoveDirRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveDirRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SessionId)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	if m.Recursively {
-		n += 2
-	}
-	if m.SourceBackend != nil {
-		l = m.SourceBackend.Size()
-		n += 1 + l + sovStorage(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.TargetPath)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if len(m.DstPath) > 0 {
+		i -= len(m.DstPath)
+		copy(dAtA[i:], m.DstPath)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.DstPath)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.SrcPath) > 0 {
+		i -= len(m.SrcPath)
+		copy(dAtA[i:], m.SrcPath)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SrcPath)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DownloadFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MoveDirResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveDirResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MoveDirRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveDirRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDirRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SrcPath)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	l = len(m.DstPath)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MoveDirResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveDirResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDirResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveDirRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExistDirRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExistDirRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExistDirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveDirResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExistDirResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExistDirResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExistDirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Exist {
+		i--
+		if m.Exist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExistDirRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CatalogFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CatalogFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CatalogFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FileSize != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CatalogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CatalogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CatalogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Files[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Incremental {
+		i--
+		if m.Incremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PartId != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.PartId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TargetUri) > 0 {
+		i -= len(m.TargetUri)
+		copy(dAtA[i:], m.TargetUri)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.TargetUri)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourcePath) > 0 {
+		i -= len(m.SourcePath)
+		copy(dAtA[i:], m.SourcePath)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SourcePath)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupCatalog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupCatalog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupCatalog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.UpdateTime != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.UpdateTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreateTime != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.CreateTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AgentVersion) > 0 {
+		i -= len(m.AgentVersion)
+		copy(dAtA[i:], m.AgentVersion)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.AgentVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeBackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeBackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeBackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Catalog != nil {
+		{
+			size, err := m.Catalog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeBackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeBackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeBackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Catalogs) > 0 {
+		for iNdEx := len(m.Catalogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Catalogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyBackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyBackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyBackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rehash {
+		i--
+		if m.Rehash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Catalog != nil {
+		{
+			size, err := m.Catalog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Backend != nil {
+		{
+			size, err := m.Backend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyBackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyBackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyBackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruneDedupPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneDedupPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruneDedupPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruneDedupPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneDedupPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruneDedupPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Removed != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Removed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStorage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStorage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Local) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *S3) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.StorageClass)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.AccessKey)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Credentials)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Backend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Storage != nil {
+		n += m.Storage.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Backend_Local) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Local != nil {
+		l = m.Local.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+func (m *Backend_S3) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+func (m *Backend_Gs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gs != nil {
+		l = m.Gs.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+func (m *UploadFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Recursively {
+		n += 2
+	}
+	l = len(m.SourcePath)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.TargetBackend != nil {
+		l = m.TargetBackend.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.DedupPool != nil {
+		l = m.DedupPool.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Catalog != nil {
+		l = m.Catalog.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UploadFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IncrUploadFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.SourcePath)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.TargetBackend != nil {
+		l = m.TargetBackend.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.CommitLogId != 0 {
+		n += 1 + sovStorage(uint64(m.CommitLogId))
+	}
+	if m.LastLogId != 0 {
+		n += 1 + sovStorage(uint64(m.LastLogId))
+	}
+	if m.Catalog != nil {
+		l = m.Catalog.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IncrUploadFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DownloadFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Recursively {
+		n += 2
+	}
+	if m.SourceBackend != nil {
+		l = m.SourceBackend.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.TargetPath)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.DedupPool != nil {
+		l = m.DedupPool.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DownloadFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MoveDirRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcPath)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.DstPath)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MoveDirResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDirRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveDirResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExistDirRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExistDirResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exist {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CatalogFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovStorage(uint64(m.FileSize))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CatalogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePath)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.TargetUri)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovStorage(uint64(m.SpaceId))
+	}
+	if m.PartId != 0 {
+		n += 1 + sovStorage(uint64(m.PartId))
+	}
+	if m.Incremental {
+		n += 2
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovStorage(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovStorage(uint64(m.EndTime))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovStorage(uint64(m.TotalSize))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupCatalog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.AgentVersion)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.CreateTime != 0 {
+		n += 1 + sovStorage(uint64(m.CreateTime))
+	}
+	if m.UpdateTime != 0 {
+		n += 1 + sovStorage(uint64(m.UpdateTime))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeBackupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Catalog != nil {
+		l = m.Catalog.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeBackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Catalogs) > 0 {
+		for _, e := range m.Catalogs {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyBackupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Backend != nil {
+		l = m.Backend.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Catalog != nil {
+		l = m.Catalog.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Rehash {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyBackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PruneDedupPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PruneDedupPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Removed != 0 {
+		n += 1 + sovStorage(uint64(m.Removed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovStorage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStorage(x uint64) (n int) {
+	return sovStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Local) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Local: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Local: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *S3) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credentials = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Local{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Storage = &Backend_Local{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &S3{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Storage = &Backend_S3{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GS{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Storage = &Backend_Gs{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursively", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recursively = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBackend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetBackend == nil {
+				m.TargetBackend = &Backend{}
+			}
+			if err := m.TargetBackend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DedupPool == nil {
+				m.DedupPool = &Backend{}
+			}
+			if err := m.DedupPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Catalog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Catalog == nil {
+				m.Catalog = &Backend{}
+			}
+			if err := m.Catalog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrUploadFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncrUploadFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncrUploadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBackend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetBackend == nil {
+				m.TargetBackend = &Backend{}
+			}
+			if err := m.TargetBackend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitLogId", wireType)
+			}
+			m.CommitLogId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitLogId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLogId", wireType)
+			}
+			m.LastLogId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastLogId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Catalog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Catalog == nil {
+				m.Catalog = &Backend{}
+			}
+			if err := m.Catalog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ExistDirResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Exist {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovStorage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStorage(x uint64) (n int) {
-	return sovStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Local) Unmarshal(dAtA []byte) error {
+func (m *IncrUploadFileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2399,44 +5116,12 @@ func (m *Local) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Local: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncrUploadFileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Local: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncrUploadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -2459,7 +5144,7 @@ func (m *Local) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3) Unmarshal(dAtA []byte) error {
+func (m *DownloadFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2482,15 +5167,15 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3: wiretype end group for non-group")
+			return fmt.Errorf("proto: DownloadFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DownloadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2518,13 +5203,33 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.SessionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursively", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recursively = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBackend", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -2534,27 +5239,31 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStorage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if m.SourceBackend == nil {
+				m.SourceBackend = &Backend{}
+			}
+			if err := m.SourceBackend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2579,46 +5288,152 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthStorage
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DedupPool == nil {
+				m.DedupPool = &Backend{}
+			}
+			if err := m.DedupPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownloadFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownloadFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownloadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoveDirRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveDirRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveDirRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2646,11 +5461,11 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageClass = string(dAtA[iNdEx:postIndex])
+			m.SrcPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2678,40 +5493,59 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			m.DstPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoveDirResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveDirResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveDirResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -2734,7 +5568,7 @@ func (m *S3) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GS) Unmarshal(dAtA []byte) error {
+func (m *RemoveDirRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2757,15 +5591,15 @@ func (m *GS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GS: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveDirRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveDirRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2793,43 +5627,113 @@ func (m *GS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDirResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDirResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDirResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExistDirRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExistDirRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExistDirRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2857,7 +5761,7 @@ func (m *GS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credentials = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2881,7 +5785,7 @@ func (m *GS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backend) Unmarshal(dAtA []byte) error {
+func (m *ExistDirResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2904,87 +5808,17 @@ func (m *Backend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backend: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExistDirResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExistDirResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &Local{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Storage = &Backend_Local{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &S3{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Storage = &Backend_S3{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exist", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -2994,27 +5828,12 @@ func (m *Backend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &GS{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Storage = &Backend_Gs{v}
-			iNdEx = postIndex
+			m.Exist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -3037,7 +5856,7 @@ func (m *Backend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UploadFileRequest) Unmarshal(dAtA []byte) error {
+func (m *CatalogFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3060,15 +5879,15 @@ func (m *UploadFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UploadFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CatalogFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UploadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CatalogFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3096,13 +5915,13 @@ func (m *UploadFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recursively", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
 			}
-			var v int
+			m.FileSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3112,15 +5931,14 @@ func (m *UploadFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FileSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Recursively = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3148,95 +5966,8 @@ func (m *UploadFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBackend", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TargetBackend == nil {
-				m.TargetBackend = &Backend{}
-			}
-			if err := m.TargetBackend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStorage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStorage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UploadFileResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStorage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UploadFileResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UploadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -3259,7 +5990,7 @@ func (m *UploadFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrUploadFileRequest) Unmarshal(dAtA []byte) error {
+func (m *CatalogEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3282,15 +6013,15 @@ func (m *IncrUploadFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncrUploadFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CatalogEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrUploadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CatalogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3318,11 +6049,11 @@ func (m *IncrUploadFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionId = string(dAtA[iNdEx:postIndex])
+			m.SourcePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3350,13 +6081,13 @@ func (m *IncrUploadFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePath = string(dAtA[iNdEx:postIndex])
+			m.TargetUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBackend", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			var msglen int
+			m.SpaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3366,33 +6097,74 @@ func (m *IncrUploadFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SpaceId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartId", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
+			m.PartId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
 			}
-			if m.TargetBackend == nil {
-				m.TargetBackend = &Backend{}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.TargetBackend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Incremental = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			iNdEx = postIndex
-		case 4:
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitLogId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.CommitLogId = 0
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3402,16 +6174,16 @@ func (m *IncrUploadFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitLogId |= int64(b&0x7F) << shift
+				m.EndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastLogId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
 			}
-			m.LastLogId = 0
+			m.TotalSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3421,62 +6193,45 @@ func (m *IncrUploadFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastLogId |= int64(b&0x7F) << shift
+				m.TotalSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStorage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStorage
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IncrUploadFileResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStorage
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Files = append(m.Files, &CatalogFile{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IncrUploadFileResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrUploadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -3499,7 +6254,7 @@ func (m *IncrUploadFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DownloadFileRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupCatalog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3522,10 +6277,10 @@ func (m *DownloadFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DownloadFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupCatalog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DownloadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupCatalog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3561,10 +6316,10 @@ func (m *DownloadFileRequest) Unmarshal(dAtA []byte) error {
 			m.SessionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recursively", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3574,17 +6329,29 @@ func (m *DownloadFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Recursively = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceBackend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3594,33 +6361,29 @@ func (m *DownloadFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStorage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourceBackend == nil {
-				m.SourceBackend = &Backend{}
-			}
-			if err := m.SourceBackend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AgentVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
 			}
-			var stringLen uint64
+			m.CreateTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3630,75 +6393,64 @@ func (m *DownloadFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreateTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStorage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStorage
+			m.UpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DownloadFileResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStorage
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Entries = append(m.Entries, &CatalogEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DownloadFileResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DownloadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -3721,7 +6473,7 @@ func (m *DownloadFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoveDirRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeBackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3744,15 +6496,15 @@ func (m *MoveDirRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoveDirRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeBackupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoveDirRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeBackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3780,13 +6532,13 @@ func (m *MoveDirRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcPath = string(dAtA[iNdEx:postIndex])
+			m.SessionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Catalog", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3796,23 +6548,27 @@ func (m *MoveDirRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStorage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstPath = string(dAtA[iNdEx:postIndex])
+			if m.Catalog == nil {
+				m.Catalog = &Backend{}
+			}
+			if err := m.Catalog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3836,7 +6592,7 @@ func (m *MoveDirRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoveDirResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeBackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3859,12 +6615,46 @@ func (m *MoveDirResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoveDirResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeBackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoveDirResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeBackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Catalogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Catalogs = append(m.Catalogs, &BackupCatalog{})
+			if err := m.Catalogs[len(m.Catalogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -3887,7 +6677,7 @@ func (m *MoveDirResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDirRequest) Unmarshal(dAtA []byte) error {
+func (m *VerifyBackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3910,15 +6700,15 @@ func (m *RemoveDirRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDirRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyBackupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDirRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyBackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3946,8 +6736,100 @@ func (m *RemoveDirRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backend == nil {
+				m.Backend = &Backend{}
+			}
+			if err := m.Backend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Catalog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Catalog == nil {
+				m.Catalog = &Backend{}
+			}
+			if err := m.Catalog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rehash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rehash = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -3970,7 +6852,7 @@ func (m *RemoveDirRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveDirResponse) Unmarshal(dAtA []byte) error {
+func (m *VerifyBackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3993,12 +6875,44 @@ func (m *RemoveDirResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveDirResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyBackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveDirResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyBackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -4021,7 +6935,7 @@ func (m *RemoveDirResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExistDirRequest) Unmarshal(dAtA []byte) error {
+func (m *PruneDedupPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4044,15 +6958,15 @@ func (m *ExistDirRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExistDirRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PruneDedupPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExistDirRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PruneDedupPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4080,7 +6994,43 @@ func (m *ExistDirRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &Backend{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4104,7 +7054,7 @@ func (m *ExistDirRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExistDirResponse) Unmarshal(dAtA []byte) error {
+func (m *PruneDedupPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4127,17 +7077,17 @@ func (m *ExistDirResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExistDirResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PruneDedupPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExistDirResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PruneDedupPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var v int
+			m.Removed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -4147,12 +7097,11 @@ func (m *ExistDirResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Removed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -0,0 +1,238 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+const (
+	CatalogExt = ".catalog.json"
+)
+
+// CatalogName return the catalog file name of the session in the agent host
+func CatalogName(host, sessionId string) string {
+	r := strings.NewReplacer(":", "_", "/", "_")
+	return r.Replace(host) + "_" + r.Replace(sessionId) + CatalogExt
+}
+
+// CatalogEntryName return a new file name for one upload of the session in the agent host,
+// each upload is written to its own file, which are merged by ReadCatalogs
+func CatalogEntryName(host, sessionId string) string {
+	return strings.TrimSuffix(CatalogName(host, sessionId), CatalogExt) + "." + uuid.New().String() + CatalogExt
+}
+
+// fileHash return the checksum recorded when uploading, or hash the file if not recorded
+func fileHash(sums *Checksums, path string) (string, error) {
+	if h, ok := sums.Get(path); ok {
+		return h, nil
+	}
+	return hashFile(path)
+}
+
+// ParseSpacePart find the space and part id from the nebula data path such as:
+//
+//	{data}/nebula/{spaceId}/checkpoints/{name}/wal/{partId}
+//	{data}/nebula/{spaceId}/{partId}/checkpoints/{name}/wal
+//
+// return 0 if not found
+func ParseSpacePart(path string) (spaceId, partId int64) {
+	elems := strings.Split(filepath.ToSlash(filepath.Clean(path)), "/")
+	for i := len(elems) - 2; i >= 0; i-- {
+		if elems[i] != "nebula" {
+			continue
+		}
+		id, err := strconv.ParseInt(elems[i+1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		spaceId = id
+		rest := elems[i+2:]
+		for j, e := range rest {
+			if e == "wal" && j+1 < len(rest) {
+				if p, err := strconv.ParseInt(rest[j+1], 10, 64); err == nil {
+					partId = p
+				}
+				break
+			}
+		}
+		if partId == 0 && len(rest) > 0 {
+			if p, err := strconv.ParseInt(rest[0], 10, 64); err == nil {
+				partId = p
+			}
+		}
+		return
+	}
+	return 0, 0
+}
+
+// NewCatalogEntry create the catalog entry of uploading localPath to targetUri, the files are
+// collected from the local path, and the checksums are taken from sums if recorded in uploading
+func NewCatalogEntry(localPath, targetUri string, sums *Checksums) (*pb.CatalogEntry, error) {
+	e := &pb.CatalogEntry{
+		SourcePath: localPath,
+		TargetUri:  targetUri,
+		Files:      make([]*pb.CatalogFile, 0),
+	}
+	e.SpaceId, e.PartId = ParseSpacePart(localPath)
+
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		hash, err := fileHash(sums, localPath)
+		if err != nil {
+			return nil, err
+		}
+		// empty path means the target uri itself
+		e.Files = append(e.Files, &pb.CatalogFile{Path: "", FileSize: info.Size(), Sha256: hash})
+		e.TotalSize = info.Size()
+		return e, nil
+	}
+
+	err = filepath.Walk(localPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(localPath, path)
+		if err != nil {
+			return err
+		}
+		hash, err := fileHash(sums, path)
+		if err != nil {
+			return err
+		}
+		e.Files = append(e.Files, &pb.CatalogFile{Path: filepath.ToSlash(rel), FileSize: info.Size(), Sha256: hash})
+		e.TotalSize += info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("collect catalog files in %s failed: %w", localPath, err)
+	}
+	return e, nil
+}
+
+// NewIncrCatalogEntry create the catalog entry of incremental uploading,
+// the files are collected from the target uri because only part of the local files are uploaded,
+// and the checksum is only recorded for the files found in local path
+func NewIncrCatalogEntry(ctx context.Context, sto ExternalStorage, localPath, targetUri string, sums *Checksums) (*pb.CatalogEntry, error) {
+	e := &pb.CatalogEntry{
+		SourcePath:  localPath,
+		TargetUri:   targetUri,
+		Incremental: true,
+		Files:       make([]*pb.CatalogFile, 0),
+	}
+	e.SpaceId, e.PartId = ParseSpacePart(localPath)
+
+	files, err := sto.ListFiles(ctx, targetUri)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		cf := &pb.CatalogFile{Path: f.Path, FileSize: f.Size}
+		if hash, err := fileHash(sums, filepath.Join(localPath, f.Path)); err == nil {
+			cf.Sha256 = hash
+		}
+		e.Files = append(e.Files, cf)
+		e.TotalSize += f.Size
+	}
+	return e, nil
+}
+
+// ReadCatalog read the catalog file, return nil if it does not exist
+func ReadCatalog(ctx context.Context, sto ExternalStorage, uri string) (*pb.BackupCatalog, error) {
+	exist, err := sto.ExistFile(ctx, uri)
+	if err != nil || !exist {
+		return nil, err
+	}
+
+	data, err := sto.ReadFile(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("read catalog %s failed: %w", uri, err)
+	}
+	c := &pb.BackupCatalog{}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("parse catalog %s failed: %w", uri, err)
+	}
+	return c, nil
+}
+
+// ReadCatalogs read the catalog of a session, i.e. {dir}/{host}_{session}.catalog.json, or all catalogs
+// in the dir. The entry files of the same session and host are merged into one catalog.
+func ReadCatalogs(ctx context.Context, sto ExternalStorage, uri string) ([]*pb.BackupCatalog, error) {
+	dir, name := uri, ""
+	if strings.HasSuffix(uri, CatalogExt) {
+		i := strings.LastIndex(uri, "/")
+		dir, name = uri[:i], uri[i+1:]
+	}
+
+	files, err := sto.ListFiles(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	catalogs := make([]*pb.BackupCatalog, 0)
+	merged := make(map[string]*pb.BackupCatalog)
+	for _, f := range files {
+		if !strings.HasSuffix(f.Path, CatalogExt) {
+			continue
+		}
+		if name != "" && f.Path != name && !strings.HasPrefix(f.Path, strings.TrimSuffix(name, CatalogExt)+".") {
+			continue
+		}
+		c, err := ReadCatalog(ctx, sto, JoinUri(dir, f.Path))
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			continue
+		}
+
+		k := c.Host + "/" + c.SessionId
+		m, ok := merged[k]
+		if !ok {
+			merged[k] = c
+			catalogs = append(catalogs, c)
+			continue
+		}
+		m.Entries = append(m.Entries, c.Entries...)
+		if c.CreateTime < m.CreateTime {
+			m.CreateTime = c.CreateTime
+		}
+		if c.UpdateTime > m.UpdateTime {
+			m.UpdateTime = c.UpdateTime
+		}
+	}
+	if name != "" && len(catalogs) == 0 {
+		return nil, fmt.Errorf("catalog %s not found", uri)
+	}
+
+	for _, c := range catalogs {
+		sort.SliceStable(c.Entries, func(i, j int) bool { return c.Entries[i].StartTime < c.Entries[j].StartTime })
+	}
+	return catalogs, nil
+}
+
+// WriteCatalog overwrite the catalog file
+func WriteCatalog(ctx context.Context, sto ExternalStorage, uri string, c *pb.BackupCatalog) error {
+	c.UpdateTime = time.Now().Unix()
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = sto.WriteFile(ctx, uri, data); err != nil {
+		return fmt.Errorf("write catalog %s failed: %w", uri, err)
+	}
+	return nil
+}
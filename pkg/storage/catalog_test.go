@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestParseSpacePart(t *testing.T) {
+	assert := assert.New(t)
+
+	s, p := ParseSpacePart("/data/storage/nebula/3/checkpoints/BACKUP_1/wal/12")
+	assert.Equal(int64(3), s)
+	assert.Equal(int64(12), p)
+
+	s, p = ParseSpacePart("/data/storage/nebula/3/7/checkpoints/BACKUP_1/wal")
+	assert.Equal(int64(3), s)
+	assert.Equal(int64(7), p)
+
+	s, p = ParseSpacePart("/data/storage/nebula/3/checkpoints/BACKUP_1/data")
+	assert.Equal(int64(3), s)
+	assert.Equal(int64(0), p)
+
+	s, p = ParseSpacePart("/data/meta/other")
+	assert.Equal(int64(0), s)
+	assert.Equal(int64(0), p)
+}
+
+func TestCatalog(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	root := t.TempDir()
+
+	src := filepath.Join(root, "nebula/1/checkpoints/b/data")
+	assert.Nil(os.MkdirAll(src, 0755))
+	assert.Nil(os.WriteFile(filepath.Join(src, "000001.sst"), []byte("12345"), 0644))
+
+	// the checksum is recorded when uploading
+	sto := &Local{}
+	uctx, sums := WithChecksums(ctx)
+	assert.Nil(sto.Upload(uctx, toExternal(filepath.Join(root, "backup")), src, true))
+	hash, ok := sums.Get(filepath.Join(src, "000001.sst"))
+	assert.True(ok)
+	expected, err := hashFile(filepath.Join(src, "000001.sst"))
+	assert.Nil(err)
+	assert.Equal(expected, hash)
+
+	e, err := NewCatalogEntry(src, toExternal(filepath.Join(root, "backup")), sums)
+	assert.Nil(err)
+	assert.Equal(int64(1), e.SpaceId)
+	assert.Equal(int64(5), e.TotalSize)
+	assert.Equal(expected, e.Files[0].Sha256)
+
+	// each upload has its own entry file, merged when reading
+	dir := toExternal(filepath.Join(root, "catalog"))
+	for i := 2; i > 0; i-- {
+		c := &pb.BackupCatalog{SessionId: "s1", Host: "127.0.0.1:8888", CreateTime: int64(i),
+			Entries: []*pb.CatalogEntry{{SourcePath: src, StartTime: int64(i)}}}
+		assert.Nil(WriteCatalog(ctx, sto, JoinUri(dir, CatalogEntryName(c.Host, c.SessionId)), c))
+	}
+	c := &pb.BackupCatalog{SessionId: "s10", Host: "127.0.0.1:8888", Entries: []*pb.CatalogEntry{e}}
+	assert.Nil(WriteCatalog(ctx, sto, JoinUri(dir, CatalogEntryName(c.Host, c.SessionId)), c))
+
+	catalogs, err := ReadCatalogs(ctx, sto, dir)
+	assert.Nil(err)
+	assert.Equal(2, len(catalogs))
+
+	catalogs, err = ReadCatalogs(ctx, sto, JoinUri(dir, CatalogName("127.0.0.1:8888", "s1")))
+	assert.Nil(err)
+	assert.Equal(1, len(catalogs))
+	assert.Equal(int64(1), catalogs[0].CreateTime)
+	assert.Len(catalogs[0].Entries, 2)
+	assert.Equal(int64(1), catalogs[0].Entries[0].StartTime)
+
+	catalogs, err = ReadCatalogs(ctx, sto, JoinUri(dir, CatalogName("127.0.0.1:8888", "s10")))
+	assert.Nil(err)
+	assert.Equal("000001.sst", catalogs[0].Entries[0].Files[0].Path)
+}
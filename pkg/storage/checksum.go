@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"path/filepath"
+	"sync"
+)
+
+type checksumsKey struct{}
+
+// Checksums collect the sha256 of the local files computed while uploading them,
+// so the files are not read again to build the catalog
+type Checksums struct {
+	mu     sync.Mutex
+	hashes map[string]string
+}
+
+// WithChecksums return the ctx making the uploads with it record the checksums of the files they read
+func WithChecksums(ctx context.Context) (context.Context, *Checksums) {
+	c := &Checksums{hashes: make(map[string]string)}
+	return context.WithValue(ctx, checksumsKey{}, c), c
+}
+
+func checksumsFrom(ctx context.Context) *Checksums {
+	c, _ := ctx.Value(checksumsKey{}).(*Checksums)
+	return c
+}
+
+// Get return the checksum of the file recorded in uploading
+func (c *Checksums) Get(path string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h, ok := c.hashes[filepath.Clean(path)]
+	return h, ok
+}
+
+func (c *Checksums) add(path, hash string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hashes[filepath.Clean(path)] = hash
+}
+
+// tee return the reader hashing what is read from r, the hash should be recorded by
+// record after the whole file is read
+func (c *Checksums) tee(r io.Reader) (io.Reader, hash.Hash) {
+	if c == nil {
+		return r, nil
+	}
+	h := sha256.New()
+	return io.TeeReader(r, h), h
+}
+
+func (c *Checksums) record(path string, h hash.Hash) {
+	if c == nil || h == nil {
+		return
+	}
+	c.add(path, hex.EncodeToString(h.Sum(nil)))
+}
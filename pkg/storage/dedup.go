@@ -0,0 +1,272 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	SstExt              = ".sst"
+	DedupManifestName   = "sst.manifest.json"
+	dedupObjectsDirName = "sst"
+	dedupRefsDirName    = "refs"
+
+	// the refs without manifest younger than it are treated as uploading
+	defaultDedupPruneGrace = 24 * time.Hour
+)
+
+// DedupFile is the sst file in a backup which points to the object in the pool
+type DedupFile struct {
+	Path string `json:"path"` // relative to the backup dir
+	Hash string `json:"hash"`
+	Size int64  `json:"size"`
+}
+
+// DedupManifest is stored in the backup dir in place of the sst files
+type DedupManifest struct {
+	Pool  string       `json:"pool"`
+	Files []*DedupFile `json:"files"`
+}
+
+// dedupRef is stored in the pool for each backup dir, which is used to
+// find the objects still referenced when pruning the pool
+type dedupRef struct {
+	Target string   `json:"target"`
+	Hashes []string `json:"hashes"`
+	// the unix time when the upload started
+	Time int64 `json:"time"`
+}
+
+// Dedup upload the immutable sst files only once into a content-addressed pool:
+//
+//	{pool}/sst/{hash[:2]}/{hash}.sst
+//	{pool}/refs/{sha1(target)}.json
+//
+// and the backup dir only keeps a manifest pointing into the pool.
+// Pruning keeps the refs of uploads in progress, as long as they finish in the grace.
+type Dedup struct {
+	sto   ExternalStorage
+	pool  string
+	grace time.Duration
+}
+
+func NewDedup(sto ExternalStorage, pool string) *Dedup {
+	return &Dedup{
+		sto:   sto,
+		pool:  pool,
+		grace: defaultDedupPruneGrace,
+	}
+}
+
+func (d *Dedup) objectUri(hash string) string {
+	return JoinUri(d.pool, dedupObjectsDirName, hash[:2], hash+SstExt)
+}
+
+func (d *Dedup) refUri(target string) string {
+	sum := sha1.Sum([]byte(strings.TrimRight(target, "/")))
+	return JoinUri(d.pool, dedupRefsDirName, hex.EncodeToString(sum[:])+".json")
+}
+
+func hashFile(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("hash file %s failed: %w", file, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Upload upload the localDir recursively to the externalUri, the sst files go to the pool
+func (d *Dedup) Upload(ctx context.Context, externalUri, localDir string) error {
+	manifest := &DedupManifest{Pool: d.pool, Files: make([]*DedupFile, 0)}
+	others := make([]string, 0)
+	err := filepath.Walk(localDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk to %s failed: %w", path, err)
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(localDir, path)
+		if err != nil {
+			return err
+		}
+		if filepath.Ext(rel) != SstExt {
+			others = append(others, rel)
+			return nil
+		}
+
+		hash, err := hashFile(path)
+		if err != nil {
+			return err
+		}
+		checksumsFrom(ctx).add(path, hash)
+		manifest.Files = append(manifest.Files, &DedupFile{Path: rel, Hash: hash, Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// write the ref before uploading objects, then pruning will not remove them
+	ref := &dedupRef{Target: externalUri, Hashes: make([]string, 0, len(manifest.Files)), Time: time.Now().Unix()}
+	for _, f := range manifest.Files {
+		ref.Hashes = append(ref.Hashes, f.Hash)
+	}
+	if err = d.writeJson(ctx, d.refUri(externalUri), ref); err != nil {
+		return err
+	}
+
+	uploaded := 0
+	for _, f := range manifest.Files {
+		uri := d.objectUri(f.Hash)
+		info, err := d.sto.StatFile(ctx, uri)
+		if err != nil {
+			return fmt.Errorf("check pool object %s failed: %w", uri, err)
+		}
+		if info != nil && info.Size == f.Size {
+			continue
+		}
+		if info != nil {
+			// left by an interrupted upload, the content is addressed by hash so overwriting is safe
+			log.WithField("uri", uri).Warnf("Pool object size %d mismatch, expected %d, upload again.", info.Size, f.Size)
+		}
+
+		if err = d.sto.EnsureDir(ctx, JoinUri(d.pool, dedupObjectsDirName, f.Hash[:2]), true); err != nil {
+			return err
+		}
+		if err = d.sto.Upload(ctx, uri, filepath.Join(localDir, f.Path), false); err != nil {
+			return fmt.Errorf("upload %s to pool failed: %w", f.Path, err)
+		}
+		uploaded++
+	}
+
+	for _, rel := range others {
+		uri := JoinUri(externalUri, filepath.ToSlash(rel))
+		if err = d.sto.EnsureDir(ctx, JoinUri(externalUri, filepath.ToSlash(filepath.Dir(rel))), true); err != nil {
+			return err
+		}
+		if err = d.sto.Upload(ctx, uri, filepath.Join(localDir, rel), false); err != nil {
+			return fmt.Errorf("upload %s failed: %w", rel, err)
+		}
+	}
+
+	log.WithField("uri", externalUri).WithField("pool", d.pool).
+		Debugf("Dedup upload %d sst files, %d new in pool, %d other files.", len(manifest.Files), uploaded, len(others))
+	return d.writeJson(ctx, JoinUri(externalUri, DedupManifestName), manifest)
+}
+
+// Resolve download the sst files pointed by the manifest in the localDir from the pool,
+// the localDir should be downloaded from the dedup backup dir already
+func (d *Dedup) Resolve(ctx context.Context, localDir string) error {
+	mf := filepath.Join(localDir, DedupManifestName)
+	data, err := os.ReadFile(mf)
+	if err != nil {
+		return fmt.Errorf("read dedup manifest %s failed: %w", mf, err)
+	}
+
+	manifest := &DedupManifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return fmt.Errorf("parse dedup manifest %s failed: %w", mf, err)
+	}
+
+	for _, f := range manifest.Files {
+		dst := filepath.Join(localDir, f.Path)
+		if err = os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		if err = d.sto.Download(ctx, dst, d.objectUri(f.Hash), false); err != nil {
+			return fmt.Errorf("download %s from pool failed: %w", f.Path, err)
+		}
+	}
+
+	return os.Remove(mf)
+}
+
+// Prune remove the refs whose backup has been removed, and then the objects not referenced anymore.
+// The refs of uploads started in the grace are kept even if the manifest is not written yet.
+// Return the number of removed objects.
+func (d *Dedup) Prune(ctx context.Context) (int, error) {
+	refs, err := d.sto.ListFiles(ctx, JoinUri(d.pool, dedupRefsDirName))
+	if err != nil {
+		return 0, err
+	}
+
+	live := make(map[string]bool)
+	for _, r := range refs {
+		uri := JoinUri(d.pool, dedupRefsDirName, r.Path)
+		data, err := d.sto.ReadFile(ctx, uri)
+		if err != nil {
+			return 0, fmt.Errorf("read ref %s failed: %w", uri, err)
+		}
+		ref := &dedupRef{}
+		if err = json.Unmarshal(data, ref); err != nil {
+			return 0, fmt.Errorf("parse ref %s failed: %w", uri, err)
+		}
+
+		exist, err := d.sto.ExistFile(ctx, JoinUri(ref.Target, DedupManifestName))
+		if err != nil {
+			return 0, err
+		}
+		if !exist && time.Since(time.Unix(ref.Time, 0)) < d.grace {
+			log.WithField("target", ref.Target).Debug("Backup of dedup ref is uploading, keep the ref.")
+		} else if !exist {
+			log.WithField("target", ref.Target).Info("Backup of dedup ref has been removed, remove the ref.")
+			if err = d.sto.RemoveFile(ctx, uri); err != nil {
+				return 0, fmt.Errorf("remove ref %s failed: %w", uri, err)
+			}
+			continue
+		}
+		for _, h := range ref.Hashes {
+			live[h] = true
+		}
+	}
+
+	objects, err := d.sto.ListFiles(ctx, JoinUri(d.pool, dedupObjectsDirName))
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, o := range objects {
+		hash := strings.TrimSuffix(filepath.Base(o.Path), SstExt)
+		if live[hash] {
+			continue
+		}
+		if err = d.sto.RemoveFile(ctx, JoinUri(d.pool, dedupObjectsDirName, o.Path)); err != nil {
+			return removed, fmt.Errorf("remove pool object %s failed: %w", o.Path, err)
+		}
+		removed++
+	}
+
+	log.WithField("pool", d.pool).Infof("Prune dedup pool, %d objects removed, %d objects alive.", removed, len(objects)-removed)
+	return removed, nil
+}
+
+func (d *Dedup) writeJson(ctx context.Context, uri string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err = d.sto.WriteFile(ctx, uri, data); err != nil {
+		return fmt.Errorf("write %s failed: %w", uri, err)
+	}
+	return nil
+}
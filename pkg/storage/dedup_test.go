@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedup(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	root := t.TempDir()
+
+	// two checkpoints share the 000001.sst
+	data1 := filepath.Join(root, "data1")
+	data2 := filepath.Join(root, "data2")
+	assert.Nil(os.MkdirAll(data1, 0755))
+	assert.Nil(os.MkdirAll(data2, 0755))
+	assert.Nil(os.WriteFile(filepath.Join(data1, "000001.sst"), []byte("shared"), 0644))
+	assert.Nil(os.WriteFile(filepath.Join(data1, "MANIFEST-000001"), []byte("manifest1"), 0644))
+	assert.Nil(os.WriteFile(filepath.Join(data2, "000001.sst"), []byte("shared"), 0644))
+	assert.Nil(os.WriteFile(filepath.Join(data2, "000002.sst"), []byte("only in data2"), 0644))
+
+	sto := &Local{}
+	pool := toExternal(filepath.Join(root, "pool"))
+	backup1 := toExternal(filepath.Join(root, "backup1/data"))
+	backup2 := toExternal(filepath.Join(root, "backup2/data"))
+	d := NewDedup(sto, pool)
+	assert.Nil(d.Upload(ctx, backup1, data1))
+	assert.Nil(d.Upload(ctx, backup2, data2))
+
+	objects, err := sto.ListFiles(ctx, JoinUri(pool, dedupObjectsDirName))
+	assert.Nil(err)
+	assert.Equal(2, len(objects))
+
+	// download and resolve backup2
+	restore := filepath.Join(root, "restore")
+	assert.Nil(sto.Download(ctx, restore, backup2, true))
+	assert.Nil(d.Resolve(ctx, restore))
+	content, err := os.ReadFile(filepath.Join(restore, "000002.sst"))
+	assert.Nil(err)
+	assert.Equal("only in data2", string(content))
+	exist, _ := IsExist(filepath.Join(restore, DedupManifestName))
+	assert.False(exist)
+
+	// remove backup2, its ref is kept in the grace as if it is uploading
+	assert.Nil(sto.RemoveDir(ctx, backup2))
+	removed, err := d.Prune(ctx)
+	assert.Nil(err)
+	assert.Equal(0, removed)
+
+	// then only its own sst could be pruned
+	d.grace = 0
+	removed, err = d.Prune(ctx)
+	assert.Nil(err)
+	assert.Equal(1, removed)
+
+	restore = filepath.Join(root, "restore1")
+	assert.Nil(sto.Download(ctx, restore, backup1, true))
+	assert.Nil(d.Resolve(ctx, restore))
+	content, err = os.ReadFile(filepath.Join(restore, "000001.sst"))
+	assert.Nil(err)
+	assert.Equal("shared", string(content))
+}
+
+func TestDedupTruncatedObject(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	root := t.TempDir()
+
+	data := filepath.Join(root, "data")
+	assert.Nil(os.MkdirAll(data, 0755))
+	assert.Nil(os.WriteFile(filepath.Join(data, "000001.sst"), []byte("complete"), 0644))
+	hash, err := hashFile(filepath.Join(data, "000001.sst"))
+	assert.Nil(err)
+
+	// the object left by an interrupted upload
+	sto := &Local{}
+	d := NewDedup(sto, toExternal(filepath.Join(root, "pool")))
+	assert.Nil(sto.WriteFile(ctx, d.objectUri(hash), []byte("comp")))
+
+	assert.Nil(d.Upload(ctx, toExternal(filepath.Join(root, "backup")), data))
+	content, err := sto.ReadFile(ctx, d.objectUri(hash))
+	assert.Nil(err)
+	assert.Equal("complete", string(content))
+}
@@ -86,9 +86,9 @@ func (g *GS) Upload(ctx context.Context, externalUri, localPath string, recursiv
 	}
 
 	if recursively {
-		return g.uploadPrefix(b.GetGs().Path, localPath)
+		return g.uploadPrefix(ctx, b.GetGs().Path, localPath)
 	} else {
-		return g.uploadToStorage(b.GetGs().Path, localPath)
+		return g.uploadToStorage(ctx, b.GetGs().Path, localPath)
 	}
 }
 
@@ -112,7 +112,7 @@ func (g *GS) IncrUpload(ctx context.Context, externalUri, localPath string, comm
 	}
 
 	// incremental local copy
-	iNames, err := utils.LoadIncrFiles(localPath, commitLogId, lastLogId)
+	iNames, manifest, err := utils.LoadIncrFiles(localPath, commitLogId, lastLogId)
 	if err != nil {
 		return err
 	}
@@ -120,12 +120,19 @@ func (g *GS) IncrUpload(ctx context.Context, externalUri, localPath string, comm
 	for _, iName := range iNames {
 		dst := filepath.Join(b.GetGs().Path, iName)
 		src := filepath.Join(localPath, iName)
-		if err = g.uploadToStorage(dst, src); err != nil {
+		if err = g.uploadToStorage(ctx, dst, src); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	// upload the log ranges manifest along with the wal files
+	mf, err := manifest.WriteTemp()
+	if err != nil {
+		return fmt.Errorf("write wal manifest of %s failed: %w", localPath, err)
+	}
+	defer os.Remove(mf)
+
+	return g.uploadToStorage(ctx, filepath.Join(b.GetGs().Path, utils.WalManifestFileName), mf)
 }
 
 func (g *GS) ExistDir(ctx context.Context, uri string) bool {
@@ -239,7 +246,7 @@ func (g *GS) getObjectSize(bucket, key string) (int64, error) {
 	return attrs.Size, nil
 }
 
-func (g *GS) uploadToStorage(key, file string) error {
+func (g *GS) uploadToStorage(ctx context.Context, key, file string) error {
 	// bucket := "bucket-name"
 	// key := "path/object-name"
 	// file := "local-path/file.txt"
@@ -268,19 +275,23 @@ func (g *GS) uploadToStorage(key, file string) error {
 	//o = o.If(storage.Conditions{GenerationMatch: attrs.Generation})
 	wc := o.NewWriter(context.Background())
 	wc.ChunkSize = defaultUploadChunkSize
-	written, err := io.Copy(wc, f)
+	sums := checksumsFrom(ctx)
+	r, h := sums.tee(f)
+	written, err := io.Copy(wc, r)
 	if err != nil {
 		return fmt.Errorf("io.Copy: %w", err)
 	}
 	if err := wc.Close(); err != nil {
 		return fmt.Errorf("Writer.Close: %w", err)
 	}
+	sums.record(file, h)
 
 	log.Infof("Upload from %s to %s successfully, bytes=%d", file, key, written)
+	observeTransfer(pb.GSType.String(), Upload, written)
 	return nil
 }
 
-func (g *GS) uploadPrefix(prefix, localDir string) error {
+func (g *GS) uploadPrefix(ctx context.Context, prefix, localDir string) error {
 	walker := make(fileWalk)
 	go func() {
 		if err := filepath.Walk(localDir, walker.Walk); err != nil {
@@ -295,7 +306,7 @@ func (g *GS) uploadPrefix(prefix, localDir string) error {
 			return fmt.Errorf("unable to get relative path: %s", path)
 		}
 		key := filepath.Join(prefix, relPath)
-		err = g.uploadToStorage(key, path)
+		err = g.uploadToStorage(ctx, key, path)
 		if err != nil {
 			return fmt.Errorf("upload from %s to %s failed: %w", path, key, err)
 		}
@@ -343,6 +354,7 @@ func (g *GS) downloadToFile(file, key string) error {
 	}
 
 	log.Infof("Download from %s to %s successfully, bytes=%d", key, file, written)
+	observeTransfer(pb.GSType.String(), Download, written)
 	return nil
 }
 
@@ -460,3 +472,112 @@ func getPrefix(path string) string {
 	}
 	return prefix
 }
+
+func (g *GS) ExistFile(ctx context.Context, uri string) (bool, error) {
+	b := g.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return false, fmt.Errorf("ExistFile, check and set uri %s failed: %w", uri, err)
+	}
+
+	_, err := g.client.Bucket(b.GetGs().Bucket).Object(b.GetGs().Path).Attrs(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (g *GS) StatFile(ctx context.Context, uri string) (*FileInfo, error) {
+	b := g.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return nil, fmt.Errorf("StatFile, check and set uri %s failed: %w", uri, err)
+	}
+
+	attrs, err := g.client.Bucket(b.GetGs().Bucket).Object(b.GetGs().Path).Attrs(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &FileInfo{Path: filepath.Base(b.GetGs().Path), Size: attrs.Size}, nil
+}
+
+func (g *GS) ReadFile(ctx context.Context, uri string) ([]byte, error) {
+	rc, err := g.OpenFile(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+func (g *GS) OpenFile(ctx context.Context, uri string) (io.ReadCloser, error) {
+	b := g.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return nil, fmt.Errorf("OpenFile, check and set uri %s failed: %w", uri, err)
+	}
+
+	rc, err := g.client.Bucket(b.GetGs().Bucket).Object(b.GetGs().Path).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Object(%q).NewReader: %w", b.GetGs().Path, err)
+	}
+	return rc, nil
+}
+
+func (g *GS) WriteFile(ctx context.Context, uri string, data []byte) error {
+	b := g.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return fmt.Errorf("WriteFile, check and set uri %s failed: %w", uri, err)
+	}
+
+	wc := g.client.Bucket(b.GetGs().Bucket).Object(b.GetGs().Path).NewWriter(ctx)
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return fmt.Errorf("Writer.Write: %w", err)
+	}
+	if err := wc.Close(); err != nil {
+		return fmt.Errorf("Writer.Close: %w", err)
+	}
+	return nil
+}
+
+func (g *GS) ListFiles(ctx context.Context, uri string) ([]*FileInfo, error) {
+	b := g.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return nil, fmt.Errorf("ListFiles, check and set uri %s failed: %w", uri, err)
+	}
+
+	bucket := b.GetGs().Bucket
+	prefix := getPrefix(b.GetGs().Path)
+	it := g.client.Bucket(bucket).Objects(ctx, &storage.Query{Prefix: prefix})
+
+	files := make([]*FileInfo, 0)
+	for {
+		object, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Bucket(%q).Objects(): %w", bucket, err)
+		}
+		files = append(files, &FileInfo{
+			Path: strings.TrimPrefix(object.Name, prefix),
+			Size: object.Size,
+		})
+	}
+
+	return files, nil
+}
+
+func (g *GS) RemoveFile(ctx context.Context, uri string) error {
+	b := g.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return fmt.Errorf("RemoveFile, check and set uri %s failed: %w", uri, err)
+	}
+
+	return g.client.Bucket(b.GetGs().Bucket).Object(b.GetGs().Path).Delete(ctx)
+}
@@ -21,7 +21,9 @@ import (
 type Local struct {
 }
 
-func (l *Local) copyFile(ctx context.Context, dstPath, srcPath string) (err error) {
+// copyFile copy a file in upload or download direction, it is written to a temp file
+// and renamed, so dstPath is never left truncated
+func (l *Local) copyFile(ctx context.Context, dstPath, srcPath, direction string) (err error) {
 	// Take rate limiter count by file size
 	if limiter.Rate.IsSet() {
 		srcInfo, err := os.Stat(srcPath)
@@ -45,7 +47,7 @@ func (l *Local) copyFile(ctx context.Context, dstPath, srcPath string) (err erro
 	}
 	defer src.Close()
 
-	dst, err := os.Create(dstPath)
+	dst, err := os.CreateTemp(filepath.Dir(dstPath), "."+filepath.Base(dstPath)+".*.tmp")
 	if err != nil {
 		return err
 	}
@@ -54,12 +56,31 @@ func (l *Local) copyFile(ctx context.Context, dstPath, srcPath string) (err erro
 		if err == nil {
 			err = e
 		}
+		if err == nil {
+			err = os.Rename(dst.Name(), dstPath)
+		}
+		if err != nil {
+			os.Remove(dst.Name())
+		}
 	}()
 
-	if _, err = io.Copy(dst, src); err != nil {
+	sums := checksumsFrom(ctx)
+	r, h := sums.tee(src)
+	n, err := io.Copy(dst, r)
+	if err != nil {
+		return
+	}
+	if err = dst.Chmod(0644); err != nil {
+		return
+	}
+	if err = dst.Sync(); err != nil {
 		return
 	}
-	return dst.Sync()
+	if direction == Upload {
+		sums.record(srcPath, h)
+	}
+	observeTransfer(pb.LocalType.String(), direction, n)
+	return nil
 }
 
 func createIfNotExists(dir string, mode os.FileMode) error {
@@ -85,7 +106,7 @@ func IsExist(name string) (bool, error) {
 	return false, err
 }
 
-func (l *Local) copyDir(ctx context.Context, dstDir, srcDir string) error {
+func (l *Local) copyDir(ctx context.Context, dstDir, srcDir, direction string) error {
 	// check context
 	select {
 	case <-ctx.Done():
@@ -116,13 +137,13 @@ func (l *Local) copyDir(ctx context.Context, dstDir, srcDir string) error {
 			if err := createIfNotExists(dstPath, 0755); err != nil {
 				return err
 			}
-			if err := l.copyDir(ctx, dstPath, srcPath); err != nil {
+			if err := l.copyDir(ctx, dstPath, srcPath, direction); err != nil {
 				return err
 			}
 		case os.ModeSymlink:
 			return fmt.Errorf("%s is symbolic link", srcPath)
 		default:
-			if err := l.copyFile(ctx, dstPath, srcPath); err != nil {
+			if err := l.copyFile(ctx, dstPath, srcPath, direction); err != nil {
 				return err
 			}
 		}
@@ -163,9 +184,9 @@ func (l *Local) Upload(ctx context.Context, externalUri, localPath string, recur
 
 	// local copy
 	if srcInfo.IsDir() {
-		err = l.copyDir(ctx, dstPath, localPath)
+		err = l.copyDir(ctx, dstPath, localPath, Upload)
 	} else {
-		err = l.copyFile(ctx, dstPath, localPath)
+		err = l.copyFile(ctx, dstPath, localPath, Upload)
 	}
 
 	if err != nil {
@@ -209,7 +230,7 @@ func (l *Local) IncrUpload(ctx context.Context, externalUri, localPath string, c
 		return err
 	}
 
-	iNames, err := utils.LoadIncrFiles(localPath, commitLogId, lastLogId)
+	iNames, manifest, err := utils.LoadIncrFiles(localPath, commitLogId, lastLogId)
 	if err != nil {
 		return err
 	}
@@ -217,12 +238,19 @@ func (l *Local) IncrUpload(ctx context.Context, externalUri, localPath string, c
 	for _, iName := range iNames {
 		dst := filepath.Join(dstPath, iName)
 		src := filepath.Join(localPath, iName)
-		if err = l.copyFile(ctx, dst, src); err != nil {
+		if err = l.copyFile(ctx, dst, src, Upload); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	// upload the log ranges manifest along with the wal files
+	mf, err := manifest.WriteTemp()
+	if err != nil {
+		return fmt.Errorf("write wal manifest of %s failed: %w", localPath, err)
+	}
+	defer os.Remove(mf)
+
+	return l.copyFile(ctx, filepath.Join(dstPath, utils.WalManifestFileName), mf, Upload)
 }
 
 func (l *Local) Download(ctx context.Context, localPath, externalUri string, recursively bool) error {
@@ -250,9 +278,9 @@ func (l *Local) Download(ctx context.Context, localPath, externalUri string, rec
 
 	// local copy
 	if srcInfo.IsDir() {
-		err = l.copyDir(ctx, localPath, srcPath)
+		err = l.copyDir(ctx, localPath, srcPath, Download)
 	} else {
-		err = l.copyFile(ctx, localPath, srcPath)
+		err = l.copyFile(ctx, localPath, srcPath, Download)
 	}
 
 	if err != nil {
@@ -354,3 +382,90 @@ func (l *Local) RemoveDir(ctx context.Context, uri string) error {
 
 	return os.RemoveAll(p)
 }
+
+func (l *Local) ExistFile(ctx context.Context, uri string) (bool, error) {
+	if pb.ParseType(uri) != pb.LocalType {
+		return false, fmt.Errorf("invalid local uri type: %s", uri)
+	}
+	return IsExist(strings.TrimPrefix(uri, pb.LocalPrefix))
+}
+
+func (l *Local) StatFile(ctx context.Context, uri string) (*FileInfo, error) {
+	if pb.ParseType(uri) != pb.LocalType {
+		return nil, fmt.Errorf("invalid local uri type: %s", uri)
+	}
+	p := strings.TrimPrefix(uri, pb.LocalPrefix)
+	info, err := os.Stat(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &FileInfo{Path: filepath.Base(p), Size: info.Size()}, nil
+}
+
+func (l *Local) ReadFile(ctx context.Context, uri string) ([]byte, error) {
+	if pb.ParseType(uri) != pb.LocalType {
+		return nil, fmt.Errorf("invalid local uri type: %s", uri)
+	}
+	return os.ReadFile(strings.TrimPrefix(uri, pb.LocalPrefix))
+}
+
+func (l *Local) OpenFile(ctx context.Context, uri string) (io.ReadCloser, error) {
+	if pb.ParseType(uri) != pb.LocalType {
+		return nil, fmt.Errorf("invalid local uri type: %s", uri)
+	}
+	return os.Open(strings.TrimPrefix(uri, pb.LocalPrefix))
+}
+
+func (l *Local) WriteFile(ctx context.Context, uri string, data []byte) error {
+	if pb.ParseType(uri) != pb.LocalType {
+		return fmt.Errorf("invalid local uri type: %s", uri)
+	}
+	p := strings.TrimPrefix(uri, pb.LocalPrefix)
+
+	if err := createIfNotExists(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(p, data, 0644)
+}
+
+func (l *Local) ListFiles(ctx context.Context, uri string) ([]*FileInfo, error) {
+	if pb.ParseType(uri) != pb.LocalType {
+		return nil, fmt.Errorf("invalid local uri type: %s", uri)
+	}
+	root := strings.TrimPrefix(uri, pb.LocalPrefix)
+
+	files := make([]*FileInfo, 0)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == root {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, &FileInfo{Path: rel, Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list files in %s failed: %w", uri, err)
+	}
+
+	return files, nil
+}
+
+func (l *Local) RemoveFile(ctx context.Context, uri string) error {
+	if pb.ParseType(uri) != pb.LocalType {
+		return fmt.Errorf("invalid local uri type: %s", uri)
+	}
+	return os.Remove(strings.TrimPrefix(uri, pb.LocalPrefix))
+}
@@ -1,13 +1,17 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -101,6 +105,7 @@ func (s *S3) downloadToFile(file, key string) error {
 		numBytes, err := downloader.Download(fd, req)
 		if err == nil {
 			log.Debugf("Download from %s to %s successfully, bytes=%d.", key, file, numBytes)
+			observeTransfer(pb.S3Type.String(), Download, numBytes)
 			break
 		}
 		log.Errorf("download from %s to %s failed: %v", key, file, err)
@@ -154,7 +159,7 @@ func (s *S3) Download(ctx context.Context, localPath, externalUri string, recurs
 	}
 }
 
-func (s *S3) uploadToStorage(key, file string) error {
+func (s *S3) uploadToStorage(ctx context.Context, key, file string) error {
 	// Take rate limiter count by file size
 	if limiter.Rate.IsSet() {
 		srcInfo, err := os.Stat(file)
@@ -173,14 +178,23 @@ func (s *S3) uploadToStorage(key, file string) error {
 	uploader := s3manager.NewUploader(s.sess, func(u *s3manager.Uploader) {
 		u.PartSize = defaultUploadPartSize
 	})
+	sums := checksumsFrom(ctx)
 	for i := 0; i < maxRetries; i++ {
+		if _, err = fd.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("seek file %s failed: %w when upload", file, err)
+		}
+		body, h := sums.tee(fd)
 		_, err = uploader.Upload(&s3manager.UploadInput{
 			Bucket: aws.String(s.backend.GetS3().Bucket),
 			Key:    aws.String(key),
-			Body:   fd,
+			Body:   body,
 		})
 		if err == nil {
+			sums.record(file, h)
 			log.Debugf("Upload from %s to %s successfully.", file, key)
+			if info, err := fd.Stat(); err == nil {
+				observeTransfer(pb.S3Type.String(), Upload, info.Size())
+			}
 			break
 		}
 		log.Errorf("upload from %s to %s failed: %v", file, key, err)
@@ -201,7 +215,7 @@ func (f fileWalk) Walk(path string, info os.FileInfo, err error) error {
 	return nil
 }
 
-func (s *S3) uploadPrefix(prefix, localDir string) error {
+func (s *S3) uploadPrefix(ctx context.Context, prefix, localDir string) error {
 	walker := make(fileWalk)
 	go func() {
 		// Gather the files to upload by walking the path recursively
@@ -220,7 +234,7 @@ func (s *S3) uploadPrefix(prefix, localDir string) error {
 		}
 		key := filepath.Join(prefix, rel)
 
-		err = s.uploadToStorage(key, path)
+		err = s.uploadToStorage(ctx, key, path)
 		if err != nil {
 			return fmt.Errorf("upload from %s to %s failed: %w", path, key, err)
 		}
@@ -238,9 +252,9 @@ func (s *S3) Upload(ctx context.Context, externalUri, localPath string, recursiv
 	}
 
 	if recursively {
-		return s.uploadPrefix(b.GetS3().Path, localPath)
+		return s.uploadPrefix(ctx, b.GetS3().Path, localPath)
 	} else {
-		return s.uploadToStorage(b.GetS3().Path, localPath)
+		return s.uploadToStorage(ctx, b.GetS3().Path, localPath)
 	}
 }
 
@@ -264,7 +278,7 @@ func (s *S3) IncrUpload(ctx context.Context, externalUri, localPath string, comm
 	}
 
 	// incremental local copy
-	iNames, err := utils.LoadIncrFiles(localPath, commitLogId, lastLogId)
+	iNames, manifest, err := utils.LoadIncrFiles(localPath, commitLogId, lastLogId)
 	if err != nil {
 		return err
 	}
@@ -272,12 +286,19 @@ func (s *S3) IncrUpload(ctx context.Context, externalUri, localPath string, comm
 	for _, iName := range iNames {
 		dst := filepath.Join(b.GetS3().Path, iName)
 		src := filepath.Join(localPath, iName)
-		if err = s.uploadToStorage(dst, src); err != nil {
+		if err = s.uploadToStorage(ctx, dst, src); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	// upload the log ranges manifest along with the wal files
+	mf, err := manifest.WriteTemp()
+	if err != nil {
+		return fmt.Errorf("write wal manifest of %s failed: %w", localPath, err)
+	}
+	defer os.Remove(mf)
+
+	return s.uploadToStorage(ctx, filepath.Join(b.GetS3().Path, utils.WalManifestFileName), mf)
 }
 
 func (s *S3) ExistDir(ctx context.Context, uri string) bool {
@@ -419,3 +440,129 @@ func (s *S3) GetObjectSize(bucket, key string) (int64, error) {
 
 	return *resp.ContentLength, nil
 }
+
+func (s *S3) ExistFile(ctx context.Context, uri string) (bool, error) {
+	b := s.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return false, fmt.Errorf("exist file, check and set s3 uri %s failed: %w", uri, err)
+	}
+
+	_, err := s.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.GetS3().GetBucket()),
+		Key:    aws.String(b.GetS3().GetPath()),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.RequestFailure); ok && aerr.StatusCode() == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *S3) StatFile(ctx context.Context, uri string) (*FileInfo, error) {
+	b := s.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return nil, fmt.Errorf("stat file, check and set s3 uri %s failed: %w", uri, err)
+	}
+
+	out, err := s.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.GetS3().GetBucket()),
+		Key:    aws.String(b.GetS3().GetPath()),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.RequestFailure); ok && aerr.StatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &FileInfo{Path: filepath.Base(b.GetS3().GetPath()), Size: aws.Int64Value(out.ContentLength)}, nil
+}
+
+func (s *S3) ReadFile(ctx context.Context, uri string) ([]byte, error) {
+	rc, err := s.OpenFile(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+func (s *S3) OpenFile(ctx context.Context, uri string) (io.ReadCloser, error) {
+	b := s.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return nil, fmt.Errorf("open file, check and set s3 uri %s failed: %w", uri, err)
+	}
+
+	resp, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.GetS3().GetBucket()),
+		Key:    aws.String(b.GetS3().GetPath()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get object %s failed: %w", uri, err)
+	}
+	return resp.Body, nil
+}
+
+func (s *S3) WriteFile(ctx context.Context, uri string, data []byte) error {
+	b := s.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return fmt.Errorf("write file, check and set s3 uri %s failed: %w", uri, err)
+	}
+
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(b.GetS3().GetBucket()),
+		Key:    aws.String(b.GetS3().GetPath()),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		return fmt.Errorf("put object %s failed: %w", uri, err)
+	}
+	return nil
+}
+
+func (s *S3) ListFiles(ctx context.Context, uri string) ([]*FileInfo, error) {
+	b := s.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return nil, fmt.Errorf("list files, check and set s3 uri %s failed: %w", uri, err)
+	}
+
+	prefix := b.GetS3().GetPath()
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	req := &s3.ListObjectsV2Input{
+		Bucket: aws.String(b.GetS3().GetBucket()),
+		Prefix: aws.String(prefix),
+	}
+
+	files := make([]*FileInfo, 0)
+	err := s.client.ListObjectsV2PagesWithContext(ctx, req, func(p *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range p.Contents {
+			files = append(files, &FileInfo{
+				Path: strings.TrimPrefix(*obj.Key, prefix),
+				Size: aws.Int64Value(obj.Size),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list files in %s failed: %w", uri, err)
+	}
+
+	return files, nil
+}
+
+func (s *S3) RemoveFile(ctx context.Context, uri string) error {
+	b := s.backend.DeepCopy()
+	if err := b.SetUri(uri); err != nil {
+		return fmt.Errorf("remove file, check and set s3 uri %s failed: %w", uri, err)
+	}
+
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.GetS3().GetBucket()),
+		Key:    aws.String(b.GetS3().GetPath()),
+	})
+	return err
+}
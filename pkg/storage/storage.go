@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"io"
 
 	log "github.com/sirupsen/logrus"
 	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
@@ -10,8 +11,21 @@ import (
 
 const (
 	SessionKey = "storage-session-id"
+
+	Upload   = "upload"
+	Download = "download"
 )
 
+// TransferObserver is called with the backend type, the direction and the bytes after each file
+// is transferred, it is nil by default, so the users of the package are not tied to any metrics
+var TransferObserver func(backend, direction string, bytes int64)
+
+func observeTransfer(backend, direction string, bytes int64) {
+	if TransferObserver != nil {
+		TransferObserver(backend, direction, bytes)
+	}
+}
+
 // Downloader download data from externalUri in ExternalStorage to the localPath
 // If localPath not exist, create it;
 // If it exists, overwrite it when it is file, copy to it when it is folder
@@ -39,12 +53,35 @@ type Dir interface {
 	RemoveDir(ctx context.Context, uri string) error
 }
 
+// FileInfo is the file found in the storage, path is relative to the listed uri
+type FileInfo struct {
+	Path string
+	Size int64
+}
+
+// Object means we could handle the single file in the storage directly,
+// which is used for small meta files such as manifests
+type Object interface {
+	ExistFile(ctx context.Context, uri string) (bool, error)
+	// StatFile return the info of the file, nil if it does not exist
+	StatFile(ctx context.Context, uri string) (*FileInfo, error)
+	ReadFile(ctx context.Context, uri string) ([]byte, error)
+	// OpenFile open the file for streaming read, the caller should close it
+	OpenFile(ctx context.Context, uri string) (io.ReadCloser, error)
+	// WriteFile create or overwrite the file with given data
+	WriteFile(ctx context.Context, uri string, data []byte) error
+	// ListFiles list all files in given dir recursively, return empty when dir not exist
+	ListFiles(ctx context.Context, uri string) ([]*FileInfo, error)
+	RemoveFile(ctx context.Context, uri string) error
+}
+
 // ExternalStorage will keep the authentication information and other configuration for the storage
 // Then the functions only need uri as the parameter
 type ExternalStorage interface {
 	Downloader
 	Uploader
 	Dir
+	Object
 }
 
 func New(b *pb.Backend) (ExternalStorage, error) {
@@ -9,3 +9,11 @@ func CheckEndpoint(endpoint string) bool {
 	endpoint = strings.TrimPrefix(endpoint, "https://")
 	return strings.ContainsAny(endpoint, ":")
 }
+
+// JoinUri join the relative path to the external storage uri
+func JoinUri(uri string, elem ...string) string {
+	for _, e := range elem {
+		uri = strings.TrimRight(uri, "/") + "/" + strings.TrimLeft(e, "/")
+	}
+	return uri
+}
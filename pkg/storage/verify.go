@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+// expectedFile is the file which should be in the remote storage according to the catalog
+type expectedFile struct {
+	uri    string
+	size   int64
+	sha256 string
+}
+
+// Verifier audit the remote backup under the prefix against the catalogs written when uploading
+type Verifier struct {
+	sto      ExternalStorage
+	prefix   string
+	catalogs []*pb.BackupCatalog
+	rehash   bool
+
+	// Progress is called after each file is checked
+	Progress func(checked, total int)
+}
+
+func NewVerifier(sto ExternalStorage, prefix string, catalogs []*pb.BackupCatalog, rehash bool) *Verifier {
+	return &Verifier{
+		sto:      sto,
+		prefix:   strings.TrimRight(prefix, "/"),
+		catalogs: catalogs,
+		rehash:   rehash,
+	}
+}
+
+func (v *Verifier) underPrefix(uri string) bool {
+	return uri == v.prefix || strings.HasPrefix(uri, v.prefix+"/")
+}
+
+func (v *Verifier) Verify(ctx context.Context) (*pb.VerifyBackupReport, error) {
+	report := &pb.VerifyBackupReport{}
+
+	files, err := v.sto.ListFiles(ctx, v.prefix)
+	if err != nil {
+		return nil, err
+	}
+	remote := make(map[string]int64, len(files))
+	for _, f := range files {
+		remote[JoinUri(v.prefix, f.Path)] = f.Size
+	}
+	report.RemoteFiles = int64(len(remote))
+
+	expected, err := v.expectedFiles(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+	report.ExpectedFiles = int64(len(expected))
+
+	seen := make(map[string]bool, len(expected))
+	for i, f := range expected {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		seen[f.uri] = true
+		size, ok := remote[f.uri]
+		if !ok && !v.underPrefix(f.uri) {
+			// the dedup pool could be out of the prefix
+			info, err := v.sto.StatFile(ctx, f.uri)
+			if err != nil {
+				return nil, err
+			}
+			if ok = info != nil; ok {
+				size = info.Size
+			}
+		}
+
+		switch {
+		case !ok:
+			report.Missing = append(report.Missing, f.uri)
+		case size != f.size:
+			report.Corrupt = append(report.Corrupt, &pb.CorruptFile{
+				Uri:    f.uri,
+				Reason: fmt.Sprintf("size mismatch, expected %d, got %d", f.size, size),
+			})
+		case v.rehash && f.sha256 != "":
+			hash, err := v.hashRemote(ctx, f.uri)
+			if err != nil {
+				return nil, err
+			}
+			if hash != f.sha256 {
+				report.Corrupt = append(report.Corrupt, &pb.CorruptFile{
+					Uri:    f.uri,
+					Reason: fmt.Sprintf("checksum mismatch, expected %s, got %s", f.sha256, hash),
+				})
+			}
+		}
+
+		report.CheckedFiles++
+		if v.Progress != nil {
+			v.Progress(i+1, len(expected))
+		}
+	}
+
+	for uri := range remote {
+		if seen[uri] || strings.HasSuffix(uri, CatalogExt) {
+			continue
+		}
+		report.Extra = append(report.Extra, uri)
+	}
+
+	log.WithField("prefix", v.prefix).
+		WithField("missing", len(report.Missing)).
+		WithField("extra", len(report.Extra)).
+		WithField("corrupt", len(report.Corrupt)).
+		Infof("Verify backup finished, checked %d files.", report.CheckedFiles)
+	return report, nil
+}
+
+// expectedFiles collect the files of catalog entries under the prefix,
+// the sst files uploaded in dedup mode are resolved to the pool objects
+func (v *Verifier) expectedFiles(ctx context.Context, remote map[string]int64) ([]*expectedFile, error) {
+	expected := make([]*expectedFile, 0)
+	added := make(map[string]bool)
+	add := func(f *expectedFile) {
+		if !added[f.uri] {
+			added[f.uri] = true
+			expected = append(expected, f)
+		}
+	}
+
+	for _, c := range v.catalogs {
+		for _, e := range c.GetEntries() {
+			target := strings.TrimRight(e.GetTargetUri(), "/")
+			if !v.underPrefix(target) {
+				continue
+			}
+
+			dedup := make(map[string]*DedupFile)
+			if _, ok := remote[JoinUri(target, DedupManifestName)]; ok {
+				m, err := v.loadDedupManifest(ctx, JoinUri(target, DedupManifestName))
+				if err != nil {
+					return nil, err
+				}
+				d := NewDedup(v.sto, m.Pool)
+				for _, f := range m.Files {
+					dedup[path.Clean(f.Path)] = f
+					add(&expectedFile{uri: d.objectUri(f.Hash), size: f.Size, sha256: f.Hash})
+				}
+				add(&expectedFile{uri: JoinUri(target, DedupManifestName), size: remote[JoinUri(target, DedupManifestName)]})
+			}
+
+			for _, f := range e.GetFiles() {
+				if _, ok := dedup[path.Clean(f.GetPath())]; ok {
+					continue
+				}
+				uri := target
+				if f.GetPath() != "" {
+					uri = JoinUri(target, f.GetPath())
+				}
+				add(&expectedFile{uri: uri, size: f.GetFileSize(), sha256: f.GetSha256()})
+			}
+		}
+	}
+	return expected, nil
+}
+
+func (v *Verifier) loadDedupManifest(ctx context.Context, uri string) (*DedupManifest, error) {
+	data, err := v.sto.ReadFile(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("read dedup manifest %s failed: %w", uri, err)
+	}
+	m := &DedupManifest{}
+	if err = json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("parse dedup manifest %s failed: %w", uri, err)
+	}
+	return m, nil
+}
+
+func (v *Verifier) hashRemote(ctx context.Context, uri string) (string, error) {
+	rc, err := v.sto.OpenFile(ctx, uri)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, rc); err != nil {
+		return "", fmt.Errorf("hash %s failed: %w", uri, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
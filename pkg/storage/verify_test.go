@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/vesoft-inc/nebula-agent/v3/pkg/proto"
+)
+
+func TestVerify(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	root := t.TempDir()
+
+	data := filepath.Join(root, "data")
+	assert.Nil(os.MkdirAll(data, 0755))
+	assert.Nil(os.WriteFile(filepath.Join(data, "000001.sst"), []byte("sst1"), 0644))
+	assert.Nil(os.WriteFile(filepath.Join(data, "000002.sst"), []byte("sst2"), 0644))
+	assert.Nil(os.WriteFile(filepath.Join(data, "CURRENT"), []byte("current"), 0644))
+
+	sto := &Local{}
+	prefix := toExternal(filepath.Join(root, "backup"))
+	target := JoinUri(prefix, "data")
+	assert.Nil(sto.Upload(ctx, target, data, true))
+	entry, err := NewCatalogEntry(data, target, nil)
+	assert.Nil(err)
+	catalogs := []*pb.BackupCatalog{{Entries: []*pb.CatalogEntry{entry}}}
+
+	report, err := NewVerifier(sto, prefix, catalogs, true).Verify(ctx)
+	assert.Nil(err)
+	assert.EqualValues(3, report.CheckedFiles)
+	assert.Empty(report.Missing)
+	assert.Empty(report.Extra)
+	assert.Empty(report.Corrupt)
+
+	// same size but different content could only be found by rehash
+	backup := filepath.Join(root, "backup", "data")
+	assert.Nil(os.Remove(filepath.Join(backup, "000001.sst")))
+	assert.Nil(os.WriteFile(filepath.Join(backup, "000002.sst"), []byte("sstx"), 0644))
+	assert.Nil(os.WriteFile(filepath.Join(backup, "LOG"), []byte("log"), 0644))
+
+	report, err = NewVerifier(sto, prefix, catalogs, false).Verify(ctx)
+	assert.Nil(err)
+	assert.Equal([]string{JoinUri(target, "000001.sst")}, report.Missing)
+	assert.Equal([]string{JoinUri(target, "LOG")}, report.Extra)
+	assert.Empty(report.Corrupt)
+
+	report, err = NewVerifier(sto, prefix, catalogs, true).Verify(ctx)
+	assert.Nil(err)
+	assert.Equal(1, len(report.Corrupt))
+	assert.Equal(JoinUri(target, "000002.sst"), report.Corrupt[0].Uri)
+}
+
+func TestVerifyDedupPool(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	root := t.TempDir()
+
+	data := filepath.Join(root, "data")
+	assert.Nil(os.MkdirAll(data, 0755))
+	assert.Nil(os.WriteFile(filepath.Join(data, "000001.sst"), []byte("sst1"), 0644))
+
+	sto := &Local{}
+	prefix := toExternal(filepath.Join(root, "backup"))
+	target := JoinUri(prefix, "data")
+	d := NewDedup(sto, toExternal(filepath.Join(root, "pool")))
+	assert.Nil(d.Upload(ctx, target, data))
+	entry, err := NewCatalogEntry(data, target, nil)
+	assert.Nil(err)
+	catalogs := []*pb.BackupCatalog{{Entries: []*pb.CatalogEntry{entry}}}
+
+	report, err := NewVerifier(sto, prefix, catalogs, false).Verify(ctx)
+	assert.Nil(err)
+	assert.Empty(report.Corrupt)
+
+	// the pool object out of the prefix is truncated
+	obj := d.objectUri(entry.Files[0].Sha256)
+	assert.Nil(sto.WriteFile(ctx, obj, []byte("ss")))
+	report, err = NewVerifier(sto, prefix, catalogs, false).Verify(ctx)
+	assert.Nil(err)
+	assert.Equal(1, len(report.Corrupt))
+	assert.Equal(obj, report.Corrupt[0].Uri)
+}